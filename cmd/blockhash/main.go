@@ -235,11 +235,15 @@ func (b *hashBuilder) ftype(structName, s string, expr ast.Expr, directives map[
 			return "uint64(" + s + ".FaceUint8())", 3
 		}
 		return "uint64(" + s + ".Uint8())", 5
-	case "GrindstoneAttachment":
+	case "GrindstoneAttachment", "CauldronLiquid", "BellAttachment", "BambooLeafSize":
 		return "uint64(" + s + ".Uint8())", 2
-	case "WoodType", "FlowerType", "DoubleFlowerType", "Colour":
+	case "WoodType", "FlowerType", "DoubleFlowerType", "Colour", "ButtonType", "PressurePlateType":
 		// Assuming these were all based on metadata, it should be safe to assume a bit size of 4 for this.
 		return "uint64(" + s + ".Uint8())", 4
+	case "RailShape":
+		return "uint64(" + s + ".Uint8())", 4
+	case "ShulkerBoxType":
+		return "uint64(" + s + ".Uint8())", 5
 	case "CoralType":
 		return "uint64(" + s + ".Uint8())", 3
 	case "AnvilType", "SandstoneType", "PrismarineType", "StoneBricksType", "NetherBricksType", "FroglightType", "WallConnectionType", "BlackstoneType", "DeepslateType", "TallGrassType":
@@ -25,6 +25,11 @@ func main() {
 	srv.CloseOnProgramEnd()
 
 	srv.Listen()
+	go func() {
+		if err := srv.ReadCommands(os.Stdin); err != nil {
+			log.Errorf("read console commands: %v", err)
+		}
+	}()
 	for srv.Accept(nil) {
 	}
 }
@@ -12,8 +12,10 @@ type Allower interface {
 	// Allow filters what connections are allowed to connect to the Server. The
 	// address, identity data, and client data of the connection are passed. If
 	// Admit returns false, the connection is closed with the string returned as
-	// the disconnect message. WARNING: Use the client data at your own risk, it
-	// cannot be trusted because it can be freely changed by the player
+	// the disconnect message. The String method of a session.Disconnection,
+	// for example with session.DisconnectReasonBanned, may be used to show a
+	// consistent disconnection screen. WARNING: Use the client data at your own
+	// risk, it cannot be trusted because it can be freely changed by the player
 	// connecting.
 	Allow(addr net.Addr, d login.IdentityData, c login.ClientData) (string, bool)
 }
@@ -11,9 +11,7 @@ type AncientDebris struct {
 
 // BreakInfo ...
 func (a AncientDebris) BreakInfo() BreakInfo {
-	return newBreakInfo(30, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierDiamond.HarvestLevel
-	}, pickaxeEffective, oneOf(a)).withBlastResistance(3600)
+	return newBreakInfo(30, pickaxeHarvestableTier(item.ToolTierDiamond), pickaxeEffective, oneOf(a)).withBlastResistance(3600)
 }
 
 // SmeltInfo ...
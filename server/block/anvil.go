@@ -9,10 +9,34 @@ import (
 	"github.com/go-gl/mathgl/mgl64"
 )
 
+// MaxAnvilCost is the level cost at which an anvil operation becomes too expensive in survival mode. Operations
+// costing MaxAnvilCost levels or more may only be performed by players with a creative inventory.
+const MaxAnvilCost = 40
+
+// NextAnvilCost returns the anvil cost (the prior work penalty) that an item with the anvil cost passed has
+// after being repaired or combined in an anvil. Renaming an item does not increase its anvil cost.
+func NextAnvilCost(cost int) int {
+	return cost*2 + 1
+}
+
+// AnvilLevelCostSource is a world.LevelCostSource used for experience levels spent on repairing, combining or
+// renaming an item in an anvil.
+type AnvilLevelCostSource struct {
+	// Input and Material are the items put in the input and material slots of the anvil. Material may be empty
+	// if the item is only renamed.
+	Input, Material item.Stack
+	// Result is the item that is produced by the anvil.
+	Result item.Stack
+}
+
+// LevelCostSource ...
+func (AnvilLevelCostSource) LevelCostSource() {}
+
 // Anvil is a block that allows players to repair items, rename items, and combine enchantments.
 type Anvil struct {
 	gravityAffected
 	transparent
+	sourceWaterDisplacer
 
 	// Type is the type of anvil.
 	Type AnvilType
@@ -25,6 +49,11 @@ func (a Anvil) Model() world.BlockModel {
 	return model.Anvil{Facing: a.Facing}
 }
 
+// SideClosed ...
+func (Anvil) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
 // BreakInfo ...
 func (a Anvil) BreakInfo() BreakInfo {
 	return newBreakInfo(5, pickaxeHarvestable, pickaxeEffective, oneOf(a)).withBlastResistance(6000)
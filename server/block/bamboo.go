@@ -0,0 +1,177 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Bamboo is a fast-growing plant that grows in tall stalks in jungles. Unlike other plants, bamboo has a thin
+// collision box, allowing entities to pass between stalks.
+type Bamboo struct {
+	transparent
+
+	// Thick specifies if the bamboo stalk is thick. Stalks grow thick once they are at least three blocks tall.
+	Thick bool
+	// LeafSize is the size of the leaves on the bamboo stalk. Only the top three blocks of a stalk have leaves.
+	LeafSize BambooLeafSize
+	// Mature specifies if the bamboo stalk has stopped growing. Mature bamboo does not grow any taller, even if
+	// the stalk has not yet reached its maximum height.
+	Mature bool
+}
+
+// maxBambooHeight is the maximum height in blocks that a bamboo stalk may grow to naturally.
+const maxBambooHeight = 16
+
+// Model ...
+func (Bamboo) Model() world.BlockModel {
+	return model.Bamboo{}
+}
+
+// SideClosed ...
+func (Bamboo) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// UseOnBlock ...
+func (b Bamboo) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used || !b.supported(pos, w) {
+		return false
+	}
+	if below, ok := w.Block(pos.Side(cube.FaceDown)).(Bamboo); ok {
+		// Bamboo placed on top of another stalk continues that stalk.
+		b.Thick = below.Thick
+	}
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// supported checks if bamboo can exist at the position passed. Bamboo must be placed on top of another bamboo
+// stalk or on soil, such as dirt, sand or gravel.
+func (b Bamboo) supported(pos cube.Pos, w *world.World) bool {
+	below := w.Block(pos.Side(cube.FaceDown))
+	if _, ok := below.(Bamboo); ok {
+		return true
+	}
+	return supportsVegetation(b, below)
+}
+
+// NeighbourUpdateTick ...
+func (b Bamboo) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !b.supported(pos, w) {
+		breakUnsupported(pos, b, item.NewStack(Bamboo{}, 1), w)
+	}
+}
+
+// RandomTick ...
+func (b Bamboo) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if r.Intn(3) != 0 || w.Light(pos.Side(cube.FaceUp)) < 9 {
+		return
+	}
+	b.grow(pos, w)
+}
+
+// BoneMeal ...
+func (b Bamboo) BoneMeal(pos cube.Pos, w *world.World) bool {
+	for top, ok := w.Block(pos.Side(cube.FaceUp)).(Bamboo); ok; top, ok = w.Block(pos.Side(cube.FaceUp)).(Bamboo) {
+		pos, b = pos.Side(cube.FaceUp), top
+	}
+	if !b.grow(pos, w) {
+		return false
+	}
+	if top, ok := w.Block(pos.Side(cube.FaceUp)).(Bamboo); ok && rand.Intn(2) == 0 {
+		// Bone meal has a chance to grow bamboo by two blocks at once.
+		top.grow(pos.Side(cube.FaceUp), w)
+	}
+	return true
+}
+
+// grow grows the bamboo stalk at the position passed by one block if it is the top of the stalk, it is not yet
+// mature and it has not reached its maximum height. The leaves of the top three blocks of the stalk are updated
+// as the stalk grows. True is returned if the stalk grew.
+func (b Bamboo) grow(pos cube.Pos, w *world.World) bool {
+	abovePos := pos.Side(cube.FaceUp)
+	if _, ok := w.Block(abovePos).(Air); !ok || b.Mature {
+		return false
+	}
+	height := b.height(pos, w)
+	if height >= maxBambooHeight {
+		return false
+	}
+
+	belowPos := pos.Side(cube.FaceDown)
+	below, belowBamboo := w.Block(belowPos).(Bamboo)
+	below2Pos := belowPos.Side(cube.FaceDown)
+	below2, below2Bamboo := w.Block(below2Pos).(Bamboo)
+
+	grown := Bamboo{Thick: b.Thick || below2Bamboo, LeafSize: BambooSmallLeaves()}
+	if b.LeafSize != BambooNoLeaves() {
+		// The top of the stalk already has leaves, so the new top gets large leaves while the leaves further down
+		// the stalk shrink.
+		grown.LeafSize = BambooLargeLeaves()
+		if belowBamboo {
+			below.LeafSize = BambooSmallLeaves()
+			w.SetBlock(belowPos, below, nil)
+			if below2Bamboo {
+				below2.LeafSize = BambooNoLeaves()
+				w.SetBlock(below2Pos, below2, nil)
+			}
+		}
+	}
+	grown.Mature = height+1 == maxBambooHeight || (height >= 11 && rand.Float64() < 0.25)
+	w.SetBlock(abovePos, grown, nil)
+	return true
+}
+
+// height returns the amount of bamboo blocks in the stalk of the bamboo at the position passed, counting from
+// that position downwards.
+func (Bamboo) height(pos cube.Pos, w *world.World) (n int) {
+	for _, ok := w.Block(pos).(Bamboo); ok; _, ok = w.Block(pos).(Bamboo) {
+		n++
+		pos = pos.Side(cube.FaceDown)
+	}
+	return n
+}
+
+// BreakInfo ...
+func (b Bamboo) BreakInfo() BreakInfo {
+	return newBreakInfo(1, alwaysHarvestable, axeEffective, oneOf(Bamboo{}))
+}
+
+// FuelInfo ...
+func (Bamboo) FuelInfo() item.FuelInfo {
+	return newFuelInfo(time.Second * 5 / 2)
+}
+
+// CompostChance ...
+func (Bamboo) CompostChance() float64 {
+	return 0.3
+}
+
+// EncodeItem ...
+func (Bamboo) EncodeItem() (name string, meta int16) {
+	return "minecraft:bamboo", 0
+}
+
+// EncodeBlock ...
+func (b Bamboo) EncodeBlock() (string, map[string]any) {
+	thickness := "thin"
+	if b.Thick {
+		thickness = "thick"
+	}
+	return "minecraft:bamboo", map[string]any{"age_bit": b.Mature, "bamboo_leaf_size": b.LeafSize.String(), "bamboo_stalk_thickness": thickness}
+}
+
+// allBamboo returns all possible states of a bamboo block.
+func allBamboo() (b []world.Block) {
+	for _, size := range BambooLeafSizes() {
+		b = append(b, Bamboo{LeafSize: size}, Bamboo{LeafSize: size, Thick: true})
+		b = append(b, Bamboo{LeafSize: size, Mature: true}, Bamboo{LeafSize: size, Thick: true, Mature: true})
+	}
+	return
+}
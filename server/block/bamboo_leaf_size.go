@@ -0,0 +1,46 @@
+package block
+
+// BambooLeafSize represents the size of the leaves of a bamboo stalk.
+type BambooLeafSize struct {
+	bambooLeafSize
+}
+
+// BambooNoLeaves returns the bamboo leaf size of a stalk without any leaves.
+func BambooNoLeaves() BambooLeafSize {
+	return BambooLeafSize{0}
+}
+
+// BambooSmallLeaves returns the bamboo leaf size of a stalk with small leaves.
+func BambooSmallLeaves() BambooLeafSize {
+	return BambooLeafSize{1}
+}
+
+// BambooLargeLeaves returns the bamboo leaf size of a stalk with large leaves.
+func BambooLargeLeaves() BambooLeafSize {
+	return BambooLeafSize{2}
+}
+
+// BambooLeafSizes returns all bamboo leaf sizes.
+func BambooLeafSizes() []BambooLeafSize {
+	return []BambooLeafSize{BambooNoLeaves(), BambooSmallLeaves(), BambooLargeLeaves()}
+}
+
+type bambooLeafSize uint8
+
+// Uint8 returns the bamboo leaf size as a uint8.
+func (b bambooLeafSize) Uint8() uint8 {
+	return uint8(b)
+}
+
+// String returns the bamboo leaf size as a string.
+func (b bambooLeafSize) String() string {
+	switch b {
+	case 0:
+		return "no_leaves"
+	case 1:
+		return "small_leaves"
+	case 2:
+		return "large_leaves"
+	}
+	panic("should never happen")
+}
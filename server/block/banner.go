@@ -106,9 +106,17 @@ func (b Banner) DecodeNBT(m map[string]any) any {
 	b.Colour = invertColourID(int16(nbtconv.Int32(m, "Base")))
 	b.Illager = nbtconv.Int32(m, "Type") == 1
 	if patterns := nbtconv.Slice(m, "Patterns"); patterns != nil {
-		b.Patterns = make([]BannerPatternLayer, len(patterns))
-		for i, p := range b.Patterns {
-			b.Patterns[i] = p.DecodeNBT(patterns[i].(map[string]any)).(BannerPatternLayer)
+		b.Patterns = make([]BannerPatternLayer, 0, len(patterns))
+		for _, p := range patterns {
+			data, ok := p.(map[string]any)
+			if !ok {
+				continue
+			}
+			if _, ok := BannerPatternByID(nbtconv.String(data, "Pattern")); !ok {
+				// Layers with unknown patterns are dropped, as they could not be encoded again.
+				continue
+			}
+			b.Patterns = append(b.Patterns, BannerPatternLayer{}.DecodeNBT(data).(BannerPatternLayer))
 		}
 	}
 	return b
@@ -55,13 +55,11 @@ func registerBannerPattern(id string, pattern BannerPatternType) {
 	bannerPatternIDs[pattern] = id
 }
 
-// BannerPatternByID returns a banner pattern by the ID it was registered with.
-func BannerPatternByID(id string) BannerPatternType {
+// BannerPatternByID returns a banner pattern by the ID it was registered with. False is returned if no banner
+// pattern was registered with the ID.
+func BannerPatternByID(id string) (BannerPatternType, bool) {
 	b, ok := bannerPatternsMap[id]
-	if !ok {
-		panic("should never happen")
-	}
-	return b
+	return b, ok
 }
 
 // bannerPatternID returns the ID a banner pattern was registered with.
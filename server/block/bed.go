@@ -0,0 +1,187 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Bed is a block that allows players to sleep through the night and set their respawn point. A bed consists of
+// two halves: A foot and a head. Beds explode when used in dimensions other than the overworld.
+type Bed struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Colour is the colour of the bed.
+	Colour item.Colour
+	// Facing is the direction that the bed is facing. The head of the bed is placed in this direction, relative
+	// to the foot.
+	Facing cube.Direction
+	// Head is true if the block is the head half of the bed.
+	Head bool
+	// Occupied is true if a Sleeper is currently sleeping in the bed.
+	Occupied bool
+}
+
+// MaxCount ...
+func (Bed) MaxCount() int {
+	return 1
+}
+
+// Model ...
+func (Bed) Model() world.BlockModel {
+	return model.Bed{}
+}
+
+// SideClosed ...
+func (Bed) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (b Bed) BreakInfo() BreakInfo {
+	return newBreakInfo(0.2, alwaysHarvestable, nothingEffective, oneOf(Bed{Colour: b.Colour})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		otherPos := b.otherHalf(pos)
+		if other, ok := w.Block(otherPos).(Bed); ok && other.Head != b.Head {
+			w.SetBlock(otherPos, nil, nil)
+		}
+	})
+}
+
+// UseOnBlock places the bed, using two blocks in the direction that the user is facing.
+func (b Bed) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	b.Facing, b.Head, b.Occupied = user.Rotation().Direction(), false, false
+	headPos := pos.Side(b.Facing.Face())
+	if !replaceableWith(w, headPos, b) || !supportsBed(pos, w) || !supportsBed(headPos, w) {
+		return false
+	}
+
+	ctx.IgnoreBBox = true
+	place(w, pos, b, user, ctx)
+	b.Head = true
+	place(w, headPos, b, user, ctx)
+	ctx.SubtractFromCount(1)
+	return placed(ctx)
+}
+
+// supportsBed checks if the block below the position passed is able to support half of a bed.
+func supportsBed(pos cube.Pos, w *world.World) bool {
+	below := pos.Side(cube.FaceDown)
+	return w.Block(below).Model().FaceSolid(below, cube.FaceUp, w)
+}
+
+// NeighbourUpdateTick removes the bed if its other half is no longer present.
+func (b Bed) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if other, ok := w.Block(b.otherHalf(pos)).(Bed); !ok || other.Head == b.Head {
+		w.SetBlock(pos, nil, nil)
+	}
+}
+
+// Activate makes the user sleep in the bed if it is night or thundering, setting its spawn point to the bed.
+// If the bed is not in the overworld, it explodes instead, unless respawn blocks are set not to explode in the world.
+func (b Bed) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	s, ok := u.(world.Sleeper)
+	if !ok {
+		return false
+	}
+	headPos := pos
+	if !b.Head {
+		headPos = b.otherHalf(pos)
+	}
+	if w.Dimension() != world.Overworld {
+		// Beds cannot be used outside the overworld: They explode instead, unless disabled in the world.
+		if !w.RespawnBlocksExplode() {
+			return true
+		}
+		w.SetBlock(pos, nil, nil)
+		w.SetBlock(b.otherHalf(pos), nil, nil)
+		ExplosionConfig{Size: 5, SpawnFire: true}.Explode(w, headPos.Vec3Centre())
+		return true
+	}
+	if _, sleeping := s.Sleeping(); sleeping {
+		return true
+	}
+	if s.Position().Sub(headPos.Vec3Centre()).Len() > 3 && s.Position().Sub(pos.Vec3Centre()).Len() > 3 {
+		bedMessage(u, "tile.bed.tooFar", "You may not rest now; the bed is too far away")
+		return true
+	}
+	if b.Occupied {
+		bedMessage(u, "tile.bed.occupied", "This bed is occupied")
+		return true
+	}
+	if w.PlayerSpawn(s.UUID()) != headPos {
+		w.SetPlayerSpawn(s.UUID(), headPos)
+		bedMessage(u, "tile.bed.respawnSet", "Respawn point set")
+	}
+	if t := w.Time() % 24000; (t < 12542 || t > 23459) && !w.ThunderingAt(headPos) {
+		bedMessage(u, "tile.bed.noSleep", "You can only sleep at night and during thunderstorms")
+		return true
+	}
+	s.Sleep(headPos)
+	return true
+}
+
+// bedMessage sends a translated message to the user passed if it is able to receive one.
+func bedMessage(u item.User, key, fallback string) {
+	if t, ok := u.(chat.Translator); ok {
+		t.MessageTranslation(chat.Translation{Key: key, Fallback: fallback})
+	}
+}
+
+// Occupy sets the Occupied state of both halves of the bed at the position passed.
+func (b Bed) Occupy(pos cube.Pos, w *world.World, occupied bool) {
+	for _, p := range []cube.Pos{pos, b.otherHalf(pos)} {
+		if half, ok := w.Block(p).(Bed); ok {
+			half.Occupied = occupied
+			w.SetBlock(p, half, &world.SetOpts{DisableBlockUpdates: true})
+		}
+	}
+}
+
+// otherHalf returns the position of the other half of the bed at the position passed.
+func (b Bed) otherHalf(pos cube.Pos) cube.Pos {
+	if b.Head {
+		return pos.Side(b.Facing.Opposite().Face())
+	}
+	return pos.Side(b.Facing.Face())
+}
+
+// EncodeItem ...
+func (b Bed) EncodeItem() (name string, meta int16) {
+	return "minecraft:bed", int16(b.Colour.Uint8())
+}
+
+// EncodeBlock ...
+func (b Bed) EncodeBlock() (name string, properties map[string]any) {
+	return "minecraft:bed", map[string]any{"direction": int32(horizontalDirection(b.Facing)), "head_piece_bit": b.Head, "occupied_bit": b.Occupied}
+}
+
+// EncodeNBT ...
+func (b Bed) EncodeNBT() map[string]any {
+	return map[string]any{"id": "Bed", "color": b.Colour.Uint8()}
+}
+
+// DecodeNBT ...
+func (b Bed) DecodeNBT(data map[string]any) any {
+	b.Colour = item.Colours()[nbtconv.Uint8(data, "color")%16]
+	return b
+}
+
+// allBeds returns all possible states of a bed.
+func allBeds() (beds []world.Block) {
+	for _, d := range cube.Directions() {
+		beds = append(beds, Bed{Facing: d})
+		beds = append(beds, Bed{Facing: d, Head: true})
+		beds = append(beds, Bed{Facing: d, Occupied: true})
+		beds = append(beds, Bed{Facing: d, Head: true, Occupied: true})
+	}
+	return
+}
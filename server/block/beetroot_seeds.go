@@ -4,7 +4,6 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
-	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
 	"math/rand"
 )
@@ -70,10 +69,9 @@ func (b BeetrootSeeds) EncodeItem() (name string, meta int16) {
 
 // RandomTick ...
 func (b BeetrootSeeds) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if w.Light(pos) < 8 {
-		w.SetBlock(pos, nil, nil)
-		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
-	} else if b.Growth < 7 && r.Intn(3) > 0 && r.Float64() <= b.CalculateGrowthChance(pos, w) {
+	if w.Light(pos) < cropGrowthLight-1 {
+		b.uproot(pos, w)
+	} else if b.Growth < 7 && b.canGrow(pos, w) && r.Intn(3) > 0 && r.Float64() <= b.CalculateGrowthChance(pos, w) {
 		b.Growth++
 		w.SetBlock(pos, b, nil)
 	}
@@ -0,0 +1,216 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Bell is a block that rings when it is used, powered by redstone or hit by a projectile. Once a bell stops
+// ringing, Revealable entities around it, such as raiders, are revealed.
+type Bell struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Attach represents the attachment type of the Bell.
+	Attach BellAttachment
+	// Facing represents the direction the Bell is facing.
+	Facing cube.Direction
+	// Powered specifies if the bell is currently powered by redstone. A bell rings once when it becomes
+	// powered.
+	Powered bool
+
+	// ringing specifies if the bell is currently ringing, in which case viewers see it swinging.
+	ringing bool
+	// ringUntil is the world tick until which the bell keeps ringing. Ringing the bell again extends it.
+	ringUntil int64
+	// ringDirection is the direction that the bell swings towards while it is ringing.
+	ringDirection cube.Direction
+}
+
+// Revealable represents an entity that may be revealed by a ringing Bell, such as a raider. Revealable entities
+// within 48 blocks of a bell are revealed when the bell stops ringing.
+type Revealable interface {
+	world.Entity
+	// Reveal reveals the entity for the duration passed, for example by making it glow.
+	Reveal(d time.Duration)
+}
+
+// Model ...
+func (b Bell) Model() world.BlockModel {
+	return model.Bell{Standing: b.Attach == StandingBellAttachment()}
+}
+
+// SideClosed ...
+func (Bell) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (b Bell) BreakInfo() BreakInfo {
+	return newBreakInfo(5, alwaysHarvestable, pickaxeEffective, oneOf(Bell{}))
+}
+
+// UseOnBlock ...
+func (b Bell) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	b.Facing = user.Rotation().Direction().Opposite()
+	switch face {
+	case cube.FaceUp:
+		b.Attach = StandingBellAttachment()
+	case cube.FaceDown:
+		b.Attach = HangingBellAttachment()
+	default:
+		b.Attach, b.Facing = WallBellAttachment(), face.Direction()
+		if supportedBy(pos, face, w) {
+			// The bell is placed between two walls, so it is attached to both of them.
+			b.Attach = DoubleWallBellAttachment()
+		}
+	}
+	if !b.supported(pos, w) {
+		return false
+	}
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// supported checks if the bell at the position passed is attached to the blocks it needs to be attached to.
+func (b Bell) supported(pos cube.Pos, w *world.World) bool {
+	switch b.Attach {
+	case StandingBellAttachment():
+		return supportedBy(pos, cube.FaceDown, w)
+	case HangingBellAttachment():
+		return supportedBy(pos, cube.FaceUp, w)
+	case DoubleWallBellAttachment():
+		if supportedBy(pos, b.Facing.Face(), w) {
+			return true
+		}
+	}
+	return supportedBy(pos, b.Facing.Face().Opposite(), w)
+}
+
+// NeighbourUpdateTick ...
+func (b Bell) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !b.supported(pos, w) {
+		breakUnsupported(pos, b, item.NewStack(Bell{}, 1), w)
+		return
+	}
+	b.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate rings the bell when it becomes powered by redstone.
+func (b Bell) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	powered := Powered(pos, w)
+	if powered == b.Powered {
+		return
+	}
+	if b.Powered = powered; powered {
+		b.Ring(pos, b.Facing, w)
+		return
+	}
+	w.SetBlock(pos, b, &world.SetOpts{DisableBlockUpdates: true, DisableLiquidDisplacement: true})
+}
+
+// Activate ...
+func (b Bell) Activate(pos cube.Pos, clickedFace cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	b.Ring(pos, ringDirection(clickedFace, u.Rotation()), w)
+	return true
+}
+
+// ProjectileHit ...
+func (b Bell) ProjectileHit(pos cube.Pos, w *world.World, e world.Entity, face cube.Face) {
+	b.Ring(pos, ringDirection(face, e.Rotation()), w)
+}
+
+// ringDirection returns the direction that a bell swings towards when it is hit on the face passed by an
+// entity with the rotation passed.
+func ringDirection(face cube.Face, rot cube.Rotation) cube.Direction {
+	if face.Axis() == cube.Y {
+		return rot.Direction()
+	}
+	return face.Opposite().Direction()
+}
+
+// bellRingDuration is the duration that a Bell keeps ringing after it was last rung.
+const bellRingDuration = time.Second * 5 / 2
+
+// Ring rings the bell at the position passed, making it swing towards the direction passed. Revealable
+// entities around the bell are revealed once it stops ringing. Ringing a bell that is already ringing extends
+// the time it keeps ringing.
+func (b Bell) Ring(pos cube.Pos, dir cube.Direction, w *world.World) {
+	b.ringing, b.ringDirection = true, dir
+	b.ringUntil = w.CurrentTick() + bellRingDuration.Nanoseconds()/int64(time.Second/20)
+	w.PlaySound(pos.Vec3Centre(), sound.BellRing{})
+	w.SetBlock(pos, b, &world.SetOpts{DisableBlockUpdates: true, DisableLiquidDisplacement: true})
+	w.ScheduleBlockUpdate(pos, bellRingDuration)
+}
+
+// ScheduledTick ...
+func (b Bell) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if !b.ringing {
+		return
+	}
+	if remaining := b.ringUntil - w.CurrentTick(); remaining > 0 {
+		// The bell was rung again after this update was scheduled, so it keeps ringing for a while longer.
+		w.ScheduleBlockUpdate(pos, time.Duration(remaining)*time.Second/20)
+		return
+	}
+	b.ringing = false
+	w.SetBlock(pos, b, &world.SetOpts{DisableBlockUpdates: true, DisableLiquidDisplacement: true})
+
+	area := cube.Box(0, 0, 0, 1, 1, 1).Translate(pos.Vec3()).Grow(48)
+	for _, e := range w.EntitiesWithin(area, nil) {
+		if r, ok := e.(Revealable); ok && e.Position().Sub(pos.Vec3Centre()).Len() <= 48 {
+			r.Reveal(time.Second * 3)
+		}
+	}
+}
+
+// EncodeNBT ...
+func (b Bell) EncodeNBT() map[string]any {
+	return map[string]any{
+		"id":        "Bell",
+		"Ringing":   boolByte(b.ringing),
+		"Direction": int32(horizontalDirection(b.ringDirection)),
+		"Ticks":     int32(0),
+	}
+}
+
+// DecodeNBT ...
+func (b Bell) DecodeNBT(map[string]any) any {
+	// The ringing state is not decoded, as the scheduled update that stops a bell from ringing is not saved.
+	return b
+}
+
+// EncodeItem ...
+func (Bell) EncodeItem() (name string, meta int16) {
+	return "minecraft:bell", 0
+}
+
+// EncodeBlock ...
+func (b Bell) EncodeBlock() (string, map[string]any) {
+	return "minecraft:bell", map[string]any{
+		"attachment": b.Attach.String(),
+		"direction":  int32(horizontalDirection(b.Facing)),
+		"toggle_bit": b.Powered,
+	}
+}
+
+// allBells ...
+func allBells() (bells []world.Block) {
+	for _, a := range BellAttachments() {
+		for _, d := range cube.Directions() {
+			bells = append(bells, Bell{Attach: a, Facing: d})
+			bells = append(bells, Bell{Attach: a, Facing: d, Powered: true})
+		}
+	}
+	return
+}
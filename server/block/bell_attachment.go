@@ -0,0 +1,53 @@
+package block
+
+// BellAttachment represents a type of attachment for a Bell.
+type BellAttachment struct {
+	bellAttachment
+}
+
+// StandingBellAttachment is a type of attachment for a Bell standing on the ground.
+func StandingBellAttachment() BellAttachment {
+	return BellAttachment{0}
+}
+
+// HangingBellAttachment is a type of attachment for a Bell hanging from the ceiling.
+func HangingBellAttachment() BellAttachment {
+	return BellAttachment{1}
+}
+
+// WallBellAttachment is a type of attachment for a Bell attached to a single wall.
+func WallBellAttachment() BellAttachment {
+	return BellAttachment{2}
+}
+
+// DoubleWallBellAttachment is a type of attachment for a Bell attached to walls on both of its sides.
+func DoubleWallBellAttachment() BellAttachment {
+	return BellAttachment{3}
+}
+
+// BellAttachments returns all possible BellAttachments.
+func BellAttachments() []BellAttachment {
+	return []BellAttachment{StandingBellAttachment(), HangingBellAttachment(), WallBellAttachment(), DoubleWallBellAttachment()}
+}
+
+type bellAttachment uint8
+
+// Uint8 returns the BellAttachment as a uint8.
+func (b bellAttachment) Uint8() uint8 {
+	return uint8(b)
+}
+
+// String returns the BellAttachment as a string.
+func (b bellAttachment) String() string {
+	switch b {
+	case 0:
+		return "standing"
+	case 1:
+		return "hanging"
+	case 2:
+		return "side"
+	case 3:
+		return "multiple"
+	}
+	panic("should never happen")
+}
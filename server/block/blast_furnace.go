@@ -59,6 +59,14 @@ func (b BlastFurnace) EncodeBlock() (name string, properties map[string]interfac
 	return "minecraft:blast_furnace", map[string]interface{}{"minecraft:cardinal_direction": b.Facing.String()}
 }
 
+// LightEmissionLevel ...
+func (b BlastFurnace) LightEmissionLevel() uint8 {
+	if b.Lit {
+		return 13
+	}
+	return 0
+}
+
 // UseOnBlock ...
 func (b BlastFurnace) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
 	pos, _, used := firstReplaceable(w, pos, face, b)
@@ -74,6 +74,12 @@ type EntityInsider interface {
 	EntityInside(pos cube.Pos, w *world.World, e world.Entity)
 }
 
+// ProjectileHitter represents a block that reacts to being hit by a projectile, such as an arrow or a snowball.
+type ProjectileHitter interface {
+	// ProjectileHit is called when the projectile passed hits the block on the face passed.
+	ProjectileHit(pos cube.Pos, w *world.World, e world.Entity, face cube.Face)
+}
+
 // Frictional represents a block that may have a custom friction value. Friction is used for entity drag when the
 // entity is on ground. If a block does not implement this interface, it should be assumed that its friction is 0.6.
 type Frictional interface {
@@ -212,11 +218,18 @@ func (g gravityAffected) Solidifies(cube.Pos, *world.World) bool {
 
 // fall spawns a falling block entity at the given position.
 func (g gravityAffected) fall(b world.Block, pos cube.Pos, w *world.World) {
-	_, air := w.Block(pos.Side(cube.FaceDown)).Model().(model.Empty)
-	_, liquid := w.Liquid(pos.Side(cube.FaceDown))
-	if air || liquid {
-		w.SetBlock(pos, nil, nil)
-		w.AddEntity(w.EntityRegistry().Config().FallingBlock(b, pos.Vec3Centre()))
+	below := pos.Side(cube.FaceDown)
+	if below.OutOfBounds(w.Range()) {
+		return
+	}
+	_, air := w.Block(below).Model().(model.Empty)
+	_, liquid := w.Liquid(below)
+	if air || liquid || replaceableWith(w, below, b) {
+		// The block only falls if the entity could be added, so that it is never lost if the World is at its
+		// entity limit.
+		if w.AddEntity(w.EntityRegistry().Config().FallingBlock(b, pos.Vec3Centre())) {
+			w.SetBlock(pos, nil, nil)
+		}
 	}
 }
 
@@ -18,7 +18,8 @@ type Breakable interface {
 }
 
 // BreakDuration returns the base duration that breaking the block passed takes when being broken using the
-// item passed.
+// item passed. It accounts for the hardness of the block, the type and tier of the tool and its Efficiency
+// enchantment, but not for effects or the position of the entity breaking the block.
 func BreakDuration(b world.Block, i item.Stack) time.Duration {
 	breakable, ok := b.(Breakable)
 	if !ok {
@@ -35,13 +36,8 @@ func BreakDuration(b world.Block, i item.Stack) time.Duration {
 		breakTime = info.Hardness * 1.5
 	}
 	if info.Effective(t) {
-		eff := t.BaseMiningEfficiency(b)
-		if e, ok := i.Enchantment(enchantment.Efficiency{}); ok {
-			breakTime += (enchantment.Efficiency{}).Addend(e.Level())
-		}
-		breakTime /= eff
+		breakTime /= miningSpeed(b, i, t)
 	}
-	// TODO: Account for haste etc here.
 	timeInTicksAccurate := math.Round(breakTime/0.05) * 0.05
 
 	return (time.Duration(math.Round(timeInTicksAccurate*20)) * time.Second) / 20
@@ -63,13 +59,18 @@ func BreaksInstantly(b world.Block, i item.Stack) bool {
 		return false
 	}
 
-	// TODO: Account for haste etc here.
-	efficiencyVal := 0.0
+	return miningSpeed(b, i, t) >= hardness*30
+}
+
+// miningSpeed returns the speed with which the block passed is mined using the tool t of the item stack
+// passed, taking into account its Efficiency enchantment. Effects such as Haste are not accounted for, as these
+// depend on the entity breaking the block.
+func miningSpeed(b world.Block, i item.Stack, t item.Tool) float64 {
+	speed := t.BaseMiningEfficiency(b)
 	if e, ok := i.Enchantment(enchantment.Efficiency{}); ok {
-		efficiencyVal += (enchantment.Efficiency{}).Addend(e.Level())
+		speed += (enchantment.Efficiency{}).Addend(e.Level())
 	}
-	hasteVal := 0.0
-	return (t.BaseMiningEfficiency(b)+efficiencyVal)*hasteVal >= hardness*30
+	return speed
 }
 
 // BreakInfo is a struct returned by every block. It holds information on block breaking related data, such as
@@ -78,7 +79,8 @@ type BreakInfo struct {
 	// Hardness is the hardness of the block, which influences the speed with which the block may be mined.
 	Hardness float64
 	// Harvestable is a function called to check if the block is harvestable using the tool passed. If the
-	// item used to break the block is not a tool, a tool.ToolNone is passed.
+	// item used to break the block is not a tool, a tool.ToolNone is passed. A block broken using a tool that
+	// it is not harvestable with is still removed, but drops no items or experience and takes longer to break.
 	Harvestable func(t item.Tool) bool
 	// Effective is a function called to check if the block can be mined more effectively with the tool passed
 	// than with an empty hand.
@@ -89,6 +91,10 @@ type BreakInfo struct {
 	BreakHandler func(pos cube.Pos, w *world.World, u item.User)
 	// XPDrops is the range of XP a block can drop when broken.
 	XPDrops XPDropRange
+	// SilkTouchCancelsXP specifies if the block drops no XP when broken using a tool with silk touch. This is
+	// the case for ores, which drop themselves rather than the items that drop XP, but not for blocks like
+	// furnaces, which drop the XP they store either way.
+	SilkTouchCancelsXP bool
 	// BlastResistance is the blast resistance of the block, which influences the block's ability to withstand an
 	// explosive blast.
 	BlastResistance float64
@@ -112,6 +118,13 @@ func (b BreakInfo) withXPDropRange(min, max int) BreakInfo {
 	return b
 }
 
+// withOreXPDropRange sets the XPDropRange field of the BreakInfo struct to the passed value, and makes sure no XP
+// is dropped if the block is broken using silk touch, like for ores.
+func (b BreakInfo) withOreXPDropRange(min, max int) BreakInfo {
+	b.XPDrops, b.SilkTouchCancelsXP = XPDropRange{min, max}, true
+	return b
+}
+
 // withBlastResistance sets the BlastResistance field of the BreakInfo struct to the passed value.
 func (b BreakInfo) withBlastResistance(res float64) BreakInfo {
 	b.BlastResistance = res
@@ -177,6 +190,14 @@ var neverHarvestable = func(t item.Tool) bool {
 // pickaxeHarvestable is a convenience function for blocks that are harvestable using any kind of pickaxe.
 var pickaxeHarvestable = pickaxeEffective
 
+// pickaxeHarvestableTier returns a function for blocks that are only harvestable using a pickaxe of the tier passed
+// or a higher tier, such as diamond ore, which requires at least an iron pickaxe.
+func pickaxeHarvestableTier(tier item.ToolTier) func(t item.Tool) bool {
+	return func(t item.Tool) bool {
+		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= tier.HarvestLevel
+	}
+}
+
 // simpleDrops returns a drops function that returns the items passed.
 func simpleDrops(s ...item.Stack) func(item.Tool, []item.Enchantment) []item.Stack {
 	return func(item.Tool, []item.Enchantment) []item.Stack {
@@ -205,6 +226,57 @@ func hasSilkTouch(enchantments []item.Enchantment) bool {
 	return false
 }
 
+// fortuneLevel returns the level of the fortune enchantment in the enchantments passed, or 0 if there is none.
+func fortuneLevel(enchantments []item.Enchantment) int {
+	for _, enchant := range enchantments {
+		if _, ok := enchant.Type().(enchantment.Fortune); ok {
+			return enchant.Level()
+		}
+	}
+	return 0
+}
+
+// oreDrops returns a drop function that returns the ore itself when silk touch exists, or between min and max of
+// the normal drop when it does not. The amount dropped is multiplied by a random bonus when fortune is used:
+// With fortune level n, the drops have a 2/(n+2) chance of not being multiplied and an equal chance of being
+// multiplied by each of 2 to n+1.
+func oreDrops(normal, ore world.Item, min, max int) func(item.Tool, []item.Enchantment) []item.Stack {
+	return func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if hasSilkTouch(enchantments) {
+			return []item.Stack{item.NewStack(ore, 1)}
+		}
+		count := min + rand.Intn(max-min+1)
+		if lvl := fortuneLevel(enchantments); lvl > 0 {
+			if bonus := rand.Intn(lvl + 2); bonus > 1 {
+				count *= bonus
+			}
+		}
+		return []item.Stack{item.NewStack(normal, count)}
+	}
+}
+
+// fortuneBonusDrops returns a drop function that returns the silk touch drop when silk touch exists, or between min
+// and max of the normal drop when it does not. Fortune adds 0 to its level to the amount dropped, which is capped
+// to limit if limit is higher than 0.
+func fortuneBonusDrops(normal, silkTouch world.Item, min, max, limit int) func(item.Tool, []item.Enchantment) []item.Stack {
+	return func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if hasSilkTouch(enchantments) {
+			return []item.Stack{item.NewStack(silkTouch, 1)}
+		}
+		count := min + rand.Intn(max-min+1) + rand.Intn(fortuneLevel(enchantments)+1)
+		if limit > 0 && count > limit {
+			count = limit
+		}
+		return []item.Stack{item.NewStack(normal, count)}
+	}
+}
+
+// fortuneChance returns the chance at the fortune level passed from the chances passed, which are ordered by
+// fortune level starting at level 0. Levels higher than the amount of chances use the last chance.
+func fortuneChance(lvl int, chances ...float64) float64 {
+	return chances[min(lvl, len(chances)-1)]
+}
+
 // silkTouchOneOf returns a drop function that returns 1x of the silk touch drop when silk touch exists, or 1x of the
 // normal drop when it does not.
 func silkTouchOneOf(normal, silkTouch world.Item) func(item.Tool, []item.Enchantment) []item.Stack {
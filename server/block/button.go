@@ -0,0 +1,143 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Button is a redstone power source that emits power for a short time after it is pressed. A pressed button
+// strongly powers the block it is attached to.
+type Button struct {
+	empty
+	transparent
+	flowingWaterDisplacer
+
+	// Type is the type of the button.
+	Type ButtonType
+	// Facing is the face of the block that the button is attached to.
+	Facing cube.Face
+	// Pressed is true if the button is pressed and emits redstone power.
+	Pressed bool
+}
+
+// FuelInfo ...
+func (b Button) FuelInfo() item.FuelInfo {
+	if b.Type.wooden() && b.Type.Wood.Flammable() {
+		return newFuelInfo(time.Second * 5)
+	}
+	return item.FuelInfo{}
+}
+
+// BreakInfo ...
+func (b Button) BreakInfo() BreakInfo {
+	effective := pickaxeEffective
+	if b.Type.wooden() {
+		effective = axeEffective
+	}
+	return newBreakInfo(0.5, alwaysHarvestable, effective, oneOf(Button{Type: b.Type})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// HasLiquidDrops ...
+func (Button) HasLiquidDrops() bool {
+	return true
+}
+
+// WeakPower ...
+func (b Button) WeakPower(cube.Pos, cube.Face, *world.World, bool) int {
+	if b.Pressed {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (b Button) StrongPower(_ cube.Pos, face cube.Face, _ *world.World, _ bool) int {
+	if b.Pressed && face == b.Facing.Opposite() {
+		return 15
+	}
+	return 0
+}
+
+// Activate ...
+func (b Button) Activate(pos cube.Pos, _ cube.Face, w *world.World, _ item.User, _ *item.UseContext) bool {
+	if b.Pressed {
+		return true
+	}
+	b.Pressed = true
+	w.SetBlock(pos, b, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+	UpdateRedstone(pos, w)
+	w.ScheduleBlockUpdate(pos, b.pressDuration())
+	return true
+}
+
+// ScheduledTick ...
+func (b Button) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if !b.Pressed {
+		return
+	}
+	b.Pressed = false
+	w.SetBlock(pos, b, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+	UpdateRedstone(pos, w)
+}
+
+// pressDuration returns the duration that the button stays pressed for after being pressed. Wooden buttons stay
+// pressed for longer than stone buttons.
+func (b Button) pressDuration() time.Duration {
+	if b.Type.wooden() {
+		return time.Millisecond * 1500
+	}
+	return time.Second
+}
+
+// UseOnBlock ...
+func (b Button) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, face, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	if !attachedToSolid(pos, face, w) {
+		return false
+	}
+	b.Facing, b.Pressed = face, false
+
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (b Button) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !attachedToSolid(pos, b.Facing, w) {
+		breakUnsupported(pos, b, item.NewStack(Button{Type: b.Type}, 1), w)
+		UpdateRedstone(pos, w)
+	}
+}
+
+// EncodeItem ...
+func (b Button) EncodeItem() (name string, meta int16) {
+	return "minecraft:" + b.Type.String() + "_button", 0
+}
+
+// EncodeBlock ...
+func (b Button) EncodeBlock() (string, map[string]any) {
+	return "minecraft:" + b.Type.String() + "_button", map[string]any{"facing_direction": int32(b.Facing), "button_pressed_bit": boolByte(b.Pressed)}
+}
+
+// allButtons ...
+func allButtons() (buttons []world.Block) {
+	for _, t := range ButtonTypes() {
+		for _, f := range cube.Faces() {
+			buttons = append(buttons, Button{Type: t, Facing: f})
+			buttons = append(buttons, Button{Type: t, Facing: f, Pressed: true})
+		}
+	}
+	return
+}
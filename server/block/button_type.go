@@ -0,0 +1,64 @@
+package block
+
+// ButtonType represents a type of button, such as a stone button or a button made of a type of wood.
+type ButtonType struct {
+	button
+
+	// Wood is the type of wood of the button. It is only used for wooden buttons.
+	Wood WoodType
+}
+
+type button uint8
+
+// StoneButton returns the stone button type.
+func StoneButton() ButtonType {
+	return ButtonType{button: 0}
+}
+
+// PolishedBlackstoneButton returns the polished blackstone button type.
+func PolishedBlackstoneButton() ButtonType {
+	return ButtonType{button: 1}
+}
+
+// WoodButton returns the wooden button type with the wood type passed.
+func WoodButton(w WoodType) ButtonType {
+	return ButtonType{button: 2, Wood: w}
+}
+
+// ButtonTypes returns all button types.
+func ButtonTypes() []ButtonType {
+	types := []ButtonType{StoneButton(), PolishedBlackstoneButton()}
+	for _, w := range WoodTypes() {
+		types = append(types, WoodButton(w))
+	}
+	return types
+}
+
+// Uint8 returns the button type as a uint8.
+func (b ButtonType) Uint8() uint8 {
+	if b.wooden() {
+		return uint8(b.button) + b.Wood.Uint8()
+	}
+	return uint8(b.button)
+}
+
+// wooden checks if the button type is a wooden button type.
+func (b ButtonType) wooden() bool {
+	return b.button == 2
+}
+
+// String returns the button type as a string.
+func (b ButtonType) String() string {
+	switch b.button {
+	case 0:
+		return "stone"
+	case 1:
+		return "polished_blackstone"
+	case 2:
+		if b.Wood == OakWood() {
+			return "wooden"
+		}
+		return b.Wood.String()
+	}
+	panic("unknown button type")
+}
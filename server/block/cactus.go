@@ -43,22 +43,32 @@ func (c Cactus) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 
 // RandomTick ...
 func (c Cactus) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	abovePos := pos.Side(cube.FaceUp)
+	if _, ok := w.Block(abovePos).(Air); !ok || c.height(pos, w) >= 3 {
+		// Only the top block of a cactus grows, and only until the cactus is three blocks tall.
+		return
+	}
 	if c.Age < 15 {
 		c.Age++
-	} else if c.Age == 15 {
-		c.Age = 0
-		if c.canGrowHere(pos.Side(cube.FaceDown), w, false) {
-			for y := 1; y < 3; y++ {
-				if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(Air); ok {
-					w.SetBlock(pos.Add(cube.Pos{0, y}), Cactus{Age: 0}, nil)
-					break
-				} else if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(Cactus); !ok {
-					break
-				}
-			}
-		}
+		w.SetBlock(pos, c, nil)
+		return
+	}
+	w.SetBlock(pos, Cactus{}, nil)
+	w.SetBlock(abovePos, Cactus{}, nil)
+	if !c.canGrowHere(abovePos, w, true) {
+		// Cactus grown next to another block breaks immediately.
+		c.NeighbourUpdateTick(abovePos, pos, w)
+	}
+}
+
+// height returns the amount of cactus blocks in the column of the cactus at the position passed, counting from
+// that position downwards.
+func (Cactus) height(pos cube.Pos, w *world.World) (n int) {
+	for _, ok := w.Block(pos).(Cactus); ok; _, ok = w.Block(pos).(Cactus) {
+		n++
+		pos = pos.Side(cube.FaceDown)
 	}
-	w.SetBlock(pos, c, nil)
+	return n
 }
 
 // canGrowHere implements logic to check if cactus can live/grow here.
@@ -77,7 +87,7 @@ func (c Cactus) canGrowHere(pos cube.Pos, w *world.World, recursive bool) bool {
 // EntityInside ...
 func (c Cactus) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
 	if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
-		l.Hurt(0.5, DamageSource{Block: c})
+		l.Hurt(1, DamageSource{Block: c})
 	}
 }
 
@@ -19,6 +19,11 @@ type Cake struct {
 	Bites int
 }
 
+// ComparatorSignal returns a signal of 14 for a full cake, decreasing by two for every bite taken.
+func (c Cake) ComparatorSignal(cube.Pos, *world.World) int {
+	return (7 - c.Bites) * 2
+}
+
 // SideClosed ...
 func (c Cake) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
 	return false
@@ -0,0 +1,242 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"strconv"
+	"time"
+)
+
+// Campfire is a block that may be used to slowly cook up to four food items at once. Lit campfires damage
+// entities standing on them and are extinguished by water. The smoke rising from a campfire is shown by the
+// client, and rises higher if a hay bale is placed directly below the campfire.
+type Campfire struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Items holds the items cooking on the campfire.
+	Items [4]CampfireItem
+	// Facing is the direction that the campfire is facing.
+	Facing cube.Direction
+	// Extinguished specifies if the campfire was extinguished. Extinguished campfires do not cook items, emit
+	// light or damage entities.
+	Extinguished bool
+	// Type is the type of fire of the campfire.
+	Type FireType
+}
+
+// CampfireItem is an item cooking on a Campfire.
+type CampfireItem struct {
+	// Item is the item cooking on the campfire.
+	Item item.Stack
+	// Time is the time left until the item is cooked.
+	Time time.Duration
+}
+
+// campfireCookTime is the time it takes for a campfire to cook an item.
+const campfireCookTime = time.Second * 30
+
+// Model ...
+func (Campfire) Model() world.BlockModel {
+	return model.Campfire{}
+}
+
+// SideClosed ...
+func (Campfire) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (c Campfire) LightEmissionLevel() uint8 {
+	if c.Extinguished {
+		return 0
+	}
+	return c.Type.LightLevel()
+}
+
+// UseOnBlock ...
+func (c Campfire) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, c)
+	if !used {
+		return false
+	}
+	c.Facing = user.Rotation().Direction().Opposite()
+	c.Extinguished = waterAt(pos, w)
+
+	place(w, pos, c, user, ctx)
+	return placed(ctx)
+}
+
+// Activate ...
+func (c Campfire) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if info, ok := item.SmeltInfoOf(held.Item()); !ok || !info.Food {
+		return false
+	}
+	for i, it := range c.Items {
+		if !it.Item.Empty() {
+			continue
+		}
+		c.Items[i] = CampfireItem{Item: held.Grow(1 - held.Count()), Time: campfireCookTime}
+		w.SetBlock(pos, c, nil)
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	return false
+}
+
+// Ignite ...
+func (c Campfire) Ignite(pos cube.Pos, w *world.World, _ world.Entity) bool {
+	if !c.Extinguished || waterAt(pos, w) {
+		return false
+	}
+	c.Extinguished = false
+	w.PlaySound(pos.Vec3Centre(), sound.Ignite{})
+	w.SetBlock(pos, c, nil)
+	return true
+}
+
+// extinguish extinguishes the campfire at the position passed.
+func (c Campfire) extinguish(pos cube.Pos, w *world.World) {
+	c.Extinguished = true
+	w.PlaySound(pos.Vec3Centre(), sound.FireExtinguish{})
+	w.SetBlock(pos, c, nil)
+}
+
+// waterAt checks if there is water at the position passed.
+func waterAt(pos cube.Pos, w *world.World) bool {
+	liq, ok := w.Liquid(pos)
+	if !ok {
+		return false
+	}
+	_, water := liq.(Water)
+	return water
+}
+
+// NeighbourUpdateTick ...
+func (c Campfire) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !c.Extinguished && waterAt(pos, w) {
+		c.extinguish(pos, w)
+	}
+}
+
+// EntityInside ...
+func (c Campfire) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if c.Extinguished {
+		return
+	}
+	if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+		l.Hurt(c.Type.Damage(), FireDamageSource{})
+	}
+}
+
+// Tick ...
+func (c Campfire) Tick(currentTick int64, pos cube.Pos, w *world.World) {
+	if c.Extinguished || currentTick%20 != 0 {
+		return
+	}
+	cooking := false
+	for i, it := range c.Items {
+		if it.Item.Empty() {
+			continue
+		}
+		cooking = true
+		if it.Time -= time.Second; it.Time > 0 {
+			c.Items[i] = it
+			continue
+		}
+		c.Items[i] = CampfireItem{}
+		if info, ok := item.SmeltInfoOf(it.Item.Item()); ok {
+			dropItem(w, info.Product, pos.Vec3Middle())
+		}
+	}
+	if cooking {
+		w.SetBlock(pos, c, nil)
+	}
+}
+
+// BreakInfo ...
+func (c Campfire) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, axeEffective, func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		var drops []item.Stack
+		if hasSilkTouch(enchantments) {
+			drops = append(drops, item.NewStack(Campfire{Type: c.Type}, 1))
+		} else if c.Type == SoulFire() {
+			drops = append(drops, item.NewStack(SoulSoil{}, 1))
+		} else {
+			drops = append(drops, item.NewStack(item.Charcoal{}, 2))
+		}
+		for _, it := range c.Items {
+			if !it.Item.Empty() {
+				drops = append(drops, it.Item)
+			}
+		}
+		return drops
+	})
+}
+
+// EncodeNBT ...
+func (c Campfire) EncodeNBT() map[string]any {
+	m := map[string]any{"id": "Campfire"}
+	for i, it := range c.Items {
+		if it.Item.Empty() {
+			continue
+		}
+		n := strconv.Itoa(i + 1)
+		m["Item"+n] = nbtconv.WriteItem(it.Item, true)
+		m["ItemTime"+n] = int32((campfireCookTime - it.Time).Milliseconds() / 50)
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (c Campfire) DecodeNBT(data map[string]any) any {
+	for i := range c.Items {
+		n := strconv.Itoa(i + 1)
+		c.Items[i] = CampfireItem{Item: nbtconv.MapItem(data, "Item"+n)}
+		if !c.Items[i].Item.Empty() {
+			c.Items[i].Time = campfireCookTime - nbtconv.TickDuration[int32](data, "ItemTime"+n)
+		}
+	}
+	return c
+}
+
+// EncodeItem ...
+func (c Campfire) EncodeItem() (name string, meta int16) {
+	switch c.Type {
+	case NormalFire():
+		return "minecraft:campfire", 0
+	case SoulFire():
+		return "minecraft:soul_campfire", 0
+	}
+	panic("invalid fire type")
+}
+
+// EncodeBlock ...
+func (c Campfire) EncodeBlock() (name string, properties map[string]any) {
+	switch c.Type {
+	case NormalFire():
+		name = "minecraft:campfire"
+	case SoulFire():
+		name = "minecraft:soul_campfire"
+	default:
+		panic("invalid fire type")
+	}
+	return name, map[string]any{"extinguished": c.Extinguished, "minecraft:cardinal_direction": c.Facing.String()}
+}
+
+// allCampfires ...
+func allCampfires() (campfires []world.Block) {
+	for _, f := range FireTypes() {
+		for _, d := range cube.Directions() {
+			campfires = append(campfires, Campfire{Type: f, Facing: d})
+			campfires = append(campfires, Campfire{Type: f, Facing: d, Extinguished: true})
+		}
+	}
+	return
+}
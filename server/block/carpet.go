@@ -50,8 +50,7 @@ func (Carpet) HasLiquidDrops() bool {
 // NeighbourUpdateTick ...
 func (c Carpet) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	if _, ok := w.Block(pos.Side(cube.FaceDown)).(Air); ok {
-		w.SetBlock(pos, nil, nil)
-		dropItem(w, item.NewStack(c, 1), pos.Vec3Centre())
+		breakUnsupported(pos, c, item.NewStack(c, 1), w)
 	}
 }
 
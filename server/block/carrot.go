@@ -4,7 +4,6 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
-	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
 	"math/rand"
 	"time"
@@ -84,10 +83,9 @@ func (c Carrot) EncodeItem() (name string, meta int16) {
 
 // RandomTick ...
 func (c Carrot) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if w.Light(pos) < 8 {
-		w.SetBlock(pos, nil, nil)
-		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: c})
-	} else if c.Growth < 7 && r.Float64() <= c.CalculateGrowthChance(pos, w) {
+	if w.Light(pos) < cropGrowthLight-1 {
+		c.uproot(pos, w)
+	} else if c.Growth < 7 && c.canGrow(pos, w) && r.Float64() <= c.CalculateGrowthChance(pos, w) {
 		c.Growth++
 		w.SetBlock(pos, c, nil)
 	}
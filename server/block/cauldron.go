@@ -0,0 +1,298 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"image/color"
+	"slices"
+	"time"
+)
+
+// Cauldron is a block that can hold water, lava or powder snow. Water in a cauldron may be dyed and used to dye
+// or wash leather armour and to wash banners. Burning entities inside a cauldron filled with water or powder
+// snow are extinguished.
+type Cauldron struct {
+	transparent
+
+	// Liquid is the liquid held by the cauldron. It has no effect if Level is 0.
+	Liquid CauldronLiquid
+	// Level is the level of the liquid in the cauldron, ranging from 0 (empty) to 6 (full). A bucket fills or
+	// empties the entire cauldron, while a bottle holds two levels.
+	Level int
+	// Colour is the colour of the water held by the cauldron. If the colour is zero, the water is not dyed.
+	Colour color.RGBA
+}
+
+// Model ...
+func (c Cauldron) Model() world.BlockModel {
+	return model.Cauldron{}
+}
+
+// ComparatorSignal returns the level of the liquid in the cauldron, scaled to a range of 0-3.
+func (c Cauldron) ComparatorSignal(cube.Pos, *world.World) int {
+	return c.Level / 2
+}
+
+// SideClosed ...
+func (c Cauldron) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (c Cauldron) BreakInfo() BreakInfo {
+	return newBreakInfo(2, pickaxeHarvestable, pickaxeEffective, oneOf(Cauldron{}))
+}
+
+// Activate ...
+func (c Cauldron) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	water := c.Level > 0 && c.Liquid == CauldronWater()
+
+	switch it := held.Item().(type) {
+	case item.Bucket:
+		if it.Empty() {
+			return c.emptyIntoBucket(pos, w, ctx)
+		}
+		return c.fillFromBucket(pos, it, w, ctx)
+	case item.GlassBottle:
+		if !water || c.Level < 2 {
+			return false
+		}
+		c.Level -= 2
+		w.SetBlock(pos, c.normalised(), nil)
+		w.PlaySound(pos.Vec3Centre(), sound.BottleFill{})
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = item.NewStack(item.Potion{Type: potion.Water()}, 1)
+		return true
+	case item.Potion:
+		if it.Type != potion.Water() || (c.Level > 0 && !water) || c.Level > 4 {
+			return false
+		}
+		c.Liquid, c.Level = CauldronWater(), c.Level+2
+		w.SetBlock(pos, c, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.BottleEmpty{})
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = item.NewStack(item.GlassBottle{}, 1)
+		return true
+	case item.Dye:
+		if !water {
+			return false
+		}
+		c.Colour = mixCauldronColour(c.Colour, it.Colour.RGBA())
+		w.SetBlock(pos, c, nil)
+		ctx.SubtractFromCount(1)
+		return true
+	case Banner:
+		if !water || c.Colour != (color.RGBA{}) || len(it.Patterns) == 0 {
+			return false
+		}
+		// Washing a banner removes the pattern that was added to it last.
+		it.Patterns = slices.Clone(it.Patterns[:len(it.Patterns)-1])
+		c.useLevel(pos, w, held.WithItem(it), ctx)
+		return true
+	}
+	if !water {
+		return false
+	}
+	armour, colour, ok := leatherArmour(held.Item())
+	if !ok {
+		return false
+	}
+	if colour == c.Colour {
+		// Undyed armour cannot be washed and armour cannot be dyed the colour it already has.
+		return false
+	}
+	c.useLevel(pos, w, held.WithItem(armour(c.Colour)), ctx)
+	return true
+}
+
+// emptyIntoBucket fills the empty bucket used on the cauldron with the liquid or powder snow it holds, if it is
+// full.
+func (c Cauldron) emptyIntoBucket(pos cube.Pos, w *world.World, ctx *item.UseContext) bool {
+	if c.Level != 6 {
+		return false
+	}
+	if c.Liquid == CauldronPowderSnow() {
+		w.SetBlock(pos, Cauldron{}, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.PowderSnowBucketFill{})
+		ctx.NewItem = item.NewStack(item.Bucket{Content: item.PowderSnowBucketContent()}, 1)
+		ctx.NewItemSurvivalOnly = true
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	var liquid world.Liquid = Water{Still: true, Depth: 8}
+	if c.Liquid == CauldronLava() {
+		liquid = Lava{Still: true, Depth: 8}
+	}
+	w.SetBlock(pos, Cauldron{}, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.BucketFill{Liquid: liquid})
+
+	ctx.NewItem = item.NewStack(item.Bucket{Content: item.LiquidBucketContent(liquid)}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// fillFromBucket fills the cauldron entirely with the liquid or powder snow in the bucket passed, replacing any
+// liquid it held before.
+func (c Cauldron) fillFromBucket(pos cube.Pos, b item.Bucket, w *world.World, ctx *item.UseContext) bool {
+	var s world.Sound
+	if b.Content.PowderSnow() {
+		c.Liquid, s = CauldronPowderSnow(), sound.PowderSnowBucketEmpty{}
+	} else {
+		liquid, ok := b.Content.Liquid()
+		if !ok {
+			return false
+		}
+		switch liquid.(type) {
+		case Water:
+			c.Liquid = CauldronWater()
+		case Lava:
+			c.Liquid = CauldronLava()
+		default:
+			return false
+		}
+		s = sound.BucketEmpty{Liquid: liquid}
+	}
+	next := Cauldron{Liquid: c.Liquid, Level: 6}
+	if next == c {
+		return false
+	}
+	w.SetBlock(pos, next, nil)
+	w.PlaySound(pos.Vec3Centre(), s)
+
+	ctx.NewItem = item.NewStack(item.Bucket{}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// useLevel removes a single level of water from the cauldron to wash or dye the item held. The item is replaced
+// with the stack passed.
+func (c Cauldron) useLevel(pos cube.Pos, w *world.World, res item.Stack, ctx *item.UseContext) {
+	c.Level--
+	w.SetBlock(pos, c.normalised(), nil)
+	ctx.SubtractFromCount(1)
+	ctx.NewItem = res.Grow(1 - res.Count())
+}
+
+// normalised returns the cauldron with its liquid and colour reset if it is empty.
+func (c Cauldron) normalised() Cauldron {
+	if c.Level <= 0 {
+		return Cauldron{}
+	}
+	return c
+}
+
+// EntityInside ...
+func (c Cauldron) EntityInside(pos cube.Pos, w *world.World, e world.Entity) {
+	if c.Level == 0 {
+		return
+	}
+	surface := 0.25 + float64(c.Level)/6*0.6875
+	inner := cube.Box(0.125, 0.25, 0.125, 0.875, surface, 0.875).Translate(pos.Vec3())
+	if !e.Type().BBox(e).Translate(e.Position()).IntersectsWith(inner) {
+		return
+	}
+	flammable, ok := e.(flammableEntity)
+	if !ok {
+		return
+	}
+	if c.Liquid == CauldronLava() {
+		if fallEntity, ok := e.(fallDistanceEntity); ok {
+			fallEntity.ResetFallDistance()
+		}
+		if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+			l.Hurt(4, LavaDamageSource{})
+		}
+		flammable.SetOnFire(15 * time.Second)
+		return
+	}
+	if flammable.OnFireDuration() <= 0 {
+		return
+	}
+	flammable.Extinguish()
+	w.PlaySound(pos.Vec3Centre(), sound.FireExtinguish{})
+	c.Level--
+	w.SetBlock(pos, c.normalised(), nil)
+}
+
+// EncodeItem ...
+func (c Cauldron) EncodeItem() (name string, meta int16) {
+	return "minecraft:cauldron", 0
+}
+
+// EncodeBlock ...
+func (c Cauldron) EncodeBlock() (string, map[string]any) {
+	return "minecraft:cauldron", map[string]any{"fill_level": int32(c.Level), "cauldron_liquid": c.Liquid.String()}
+}
+
+// EncodeNBT ...
+func (c Cauldron) EncodeNBT() map[string]any {
+	m := map[string]any{"id": "Cauldron", "PotionId": int16(-1), "PotionType": int16(-1)}
+	if c.Colour != (color.RGBA{}) {
+		m["CustomColor"] = nbtconv.Int32FromRGBA(c.Colour)
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (c Cauldron) DecodeNBT(data map[string]any) any {
+	if v, ok := data["CustomColor"].(int32); ok {
+		c.Colour = nbtconv.RGBAFromInt32(v)
+	}
+	return c
+}
+
+// mixCauldronColour mixes the colour of the dye passed into the colour of the water in a cauldron. If the water
+// was not yet dyed, it takes the colour of the dye.
+func mixCauldronColour(water, dye color.RGBA) color.RGBA {
+	if water == (color.RGBA{}) {
+		return dye
+	}
+	return color.RGBA{
+		R: uint8((int(water.R) + int(dye.R)) / 2),
+		G: uint8((int(water.G) + int(dye.G)) / 2),
+		B: uint8((int(water.B) + int(dye.B)) / 2),
+		A: 0xff,
+	}
+}
+
+// leatherArmour checks if the item passed is a piece of leather armour. If so, its current colour is returned
+// with a function that returns the same piece of armour with a different colour.
+func leatherArmour(it world.Item) (func(color.RGBA) world.Item, color.RGBA, bool) {
+	switch a := it.(type) {
+	case item.Helmet:
+		if t, ok := a.Tier.(item.ArmourTierLeather); ok {
+			return func(c color.RGBA) world.Item { a.Tier = item.ArmourTierLeather{Colour: c}; return a }, t.Colour, true
+		}
+	case item.Chestplate:
+		if t, ok := a.Tier.(item.ArmourTierLeather); ok {
+			return func(c color.RGBA) world.Item { a.Tier = item.ArmourTierLeather{Colour: c}; return a }, t.Colour, true
+		}
+	case item.Leggings:
+		if t, ok := a.Tier.(item.ArmourTierLeather); ok {
+			return func(c color.RGBA) world.Item { a.Tier = item.ArmourTierLeather{Colour: c}; return a }, t.Colour, true
+		}
+	case item.Boots:
+		if t, ok := a.Tier.(item.ArmourTierLeather); ok {
+			return func(c color.RGBA) world.Item { a.Tier = item.ArmourTierLeather{Colour: c}; return a }, t.Colour, true
+		}
+	}
+	return nil, color.RGBA{}, false
+}
+
+// allCauldrons ...
+func allCauldrons() (cauldrons []world.Block) {
+	for _, l := range CauldronLiquids() {
+		for i := 0; i <= 6; i++ {
+			cauldrons = append(cauldrons, Cauldron{Liquid: l, Level: i})
+		}
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package block
+
+// CauldronLiquid represents a liquid that may be held by a Cauldron.
+type CauldronLiquid struct {
+	cauldronLiquid
+}
+
+type cauldronLiquid uint8
+
+// CauldronWater returns the water cauldron liquid. Water in a cauldron may be dyed.
+func CauldronWater() CauldronLiquid {
+	return CauldronLiquid{0}
+}
+
+// CauldronLava returns the lava cauldron liquid.
+func CauldronLava() CauldronLiquid {
+	return CauldronLiquid{1}
+}
+
+// CauldronPowderSnow returns the powder snow cauldron liquid.
+func CauldronPowderSnow() CauldronLiquid {
+	return CauldronLiquid{2}
+}
+
+// Uint8 returns the cauldron liquid as a uint8.
+func (c cauldronLiquid) Uint8() uint8 {
+	return uint8(c)
+}
+
+// String ...
+func (c cauldronLiquid) String() string {
+	switch c {
+	case 0:
+		return "water"
+	case 1:
+		return "lava"
+	case 2:
+		return "powder_snow"
+	}
+	panic("unknown cauldron liquid")
+}
+
+// CauldronLiquids returns all cauldron liquids.
+func CauldronLiquids() []CauldronLiquid {
+	return []CauldronLiquid{CauldronWater(), CauldronLava(), CauldronPowderSnow()}
+}
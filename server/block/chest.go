@@ -15,7 +15,8 @@ import (
 )
 
 // Chest is a container block which may be used to store items. Chests may also be paired to create a bigger
-// single container.
+// single container: A chest placed next to another chest facing the same direction is automatically paired
+// with it, forming a double chest with 54 slots.
 // The empty value of Chest is not valid. It must be created using block.NewChest().
 type Chest struct {
 	chest
@@ -32,6 +33,15 @@ type Chest struct {
 	inventory *inventory.Inventory
 	viewerMu  *sync.RWMutex
 	viewers   map[ContainerViewer]struct{}
+
+	paired       bool
+	pairX, pairZ int
+	// half holds the 27 slots of the double chest inventory that belong to this chest. half is nil if the
+	// chest is not paired or if the pairing has not yet been restored after loading the chest.
+	half *inventory.Inventory
+	// offset is the first slot of the double chest inventory that belongs to this chest: 0 for the left chest
+	// and 27 for the right chest.
+	offset int
 }
 
 // NewChest creates a new initialised chest. The inventory is properly initialised.
@@ -57,6 +67,140 @@ func (c Chest) Inventory() *inventory.Inventory {
 	return c.inventory
 }
 
+// Paired checks if the chest is paired with another chest to form a double chest.
+func (c Chest) Paired() bool {
+	return c.paired
+}
+
+// pairPos returns the position of the chest that this chest is paired with. The position returned is only
+// valid if the chest is paired.
+func (c Chest) pairPos(pos cube.Pos) cube.Pos {
+	return cube.Pos{c.pairX, pos[1], c.pairZ}
+}
+
+// pair pairs the chest at pos with the chest at pairPos, merging their inventories into one double chest
+// inventory. The chests returned should be set to the world at their respective positions. False is returned
+// if the chests could not be paired.
+func (c Chest) pair(w *world.World, pos, pairPos cube.Pos) (ch, pair Chest, ok bool) {
+	pair, ok = w.Block(pairPos).(Chest)
+	if !ok || c.Facing != pair.Facing || pos[1] != pairPos[1] || pos.Sub(pairPos).Vec3().Len() != 1 {
+		return c, pair, false
+	}
+	if (c.paired && c.pairPos(pos) != pairPos) || (pair.paired && pair.pairPos(pairPos) != pos) {
+		// One of the chests is already paired with another chest.
+		return c, pair, false
+	}
+	c.half, pair.half = inventory.New(27, nil), inventory.New(27, nil)
+	for slot, it := range c.inventory.Slots() {
+		_ = c.half.SetItem(slot, it)
+	}
+	for slot, it := range pair.inventory.Slots() {
+		_ = pair.half.SetItem(slot, it)
+	}
+
+	left, right := c.half, pair.half
+	c.offset, pair.offset = 0, 27
+	if pos.Side(c.Facing.RotateRight().Face()) == pairPos {
+		left, right = right, left
+		c.offset, pair.offset = 27, 0
+	}
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	double := inventory.New(54, func(slot int, _, item item.Stack) {
+		if slot < 27 {
+			_ = left.SetItem(slot, item)
+		} else {
+			_ = right.SetItem(slot-27, item)
+		}
+		m.RLock()
+		defer m.RUnlock()
+		for viewer := range v {
+			viewer.ViewSlotChange(slot, item)
+		}
+	})
+	for slot, it := range append(left.Slots(), right.Slots()...) {
+		_ = double.SetItem(slot, it)
+	}
+
+	c.inventory, pair.inventory = double, double
+	c.viewerMu, pair.viewerMu = m, m
+	c.viewers, pair.viewers = v, v
+	c.paired, c.pairX, c.pairZ = true, pairPos[0], pairPos[2]
+	pair.paired, pair.pairX, pair.pairZ = true, pos[0], pos[2]
+	return c, pair, true
+}
+
+// unpair unpairs the chest from the chest it was paired with. The chest returned holds only its own half of
+// the double chest inventory and should be set to the world.
+func (c Chest) unpair(w *world.World, pos cube.Pos) Chest {
+	if c.viewerMu != nil {
+		c.viewerMu.RLock()
+		if len(c.viewers) != 0 {
+			c.close(w, pos)
+		}
+		c.viewerMu.RUnlock()
+	}
+	half := c.half
+	facing, customName := c.Facing, c.CustomName
+	//noinspection GoAssignmentToReceiver
+	c = NewChest()
+	c.Facing, c.CustomName = facing, customName
+	if half != nil {
+		for slot, it := range half.Slots() {
+			_ = c.inventory.SetItem(slot, it)
+		}
+	}
+	return c
+}
+
+// clearContents clears the slots of the inventory that belong to the chest and returns the items that were in
+// them. For a double chest, only the 27 slots of this chest are cleared, so that the chest it is paired with keeps
+// its items.
+func (c Chest) clearContents() []item.Stack {
+	if !c.paired || c.half == nil {
+		return c.inventory.Clear()
+	}
+	var items []item.Stack
+	for slot, it := range c.half.Slots() {
+		if !it.Empty() {
+			items = append(items, it)
+			_ = c.inventory.SetItem(c.offset+slot, item.Stack{})
+		}
+	}
+	return items
+}
+
+// restorePair restores the pairing of a chest that was loaded as paired, but that has not had its inventory
+// merged with the chest it is paired with. If the chest it was paired with is no longer present, the chest is
+// unpaired instead.
+func (c Chest) restorePair(w *world.World, pos cube.Pos) Chest {
+	if !c.paired || c.half != nil {
+		return c
+	}
+	pairPos := c.pairPos(pos)
+	ch, pair, ok := c.pair(w, pos, pairPos)
+	if !ok {
+		c.paired = false
+		w.SetBlock(pos, c, nil)
+		return c
+	}
+	w.SetBlock(pos, ch, nil)
+	w.SetBlock(pairPos, pair, nil)
+	return ch
+}
+
+// NeighbourUpdateTick ...
+func (c Chest) NeighbourUpdateTick(pos, changedNeighbour cube.Pos, w *world.World) {
+	if !c.paired || c.half == nil || changedNeighbour != c.pairPos(pos) {
+		return
+	}
+	if pair, ok := w.Block(changedNeighbour).(Chest); ok && pair.paired && pair.pairPos(changedNeighbour) == pos {
+		return
+	}
+	// The chest that this chest was paired with was removed, so the chest is no longer a double chest.
+	w.SetBlock(pos, c.unpair(w, pos), nil)
+}
+
 // WithName returns the chest after applying a specific name to the block.
 func (c Chest) WithName(a ...any) world.Item {
 	c.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
@@ -72,6 +216,9 @@ func (Chest) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
 func (c Chest) open(w *world.World, pos cube.Pos) {
 	for _, v := range w.Viewers(pos.Vec3()) {
 		v.ViewBlockAction(pos, OpenAction{})
+		if c.paired {
+			v.ViewBlockAction(c.pairPos(pos), OpenAction{})
+		}
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.ChestOpen{})
 }
@@ -80,6 +227,9 @@ func (c Chest) open(w *world.World, pos cube.Pos) {
 func (c Chest) close(w *world.World, pos cube.Pos) {
 	for _, v := range w.Viewers(pos.Vec3()) {
 		v.ViewBlockAction(pos, CloseAction{})
+		if c.paired {
+			v.ViewBlockAction(c.pairPos(pos), CloseAction{})
+		}
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.ChestClose{})
 }
@@ -111,7 +261,13 @@ func (c Chest) RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
 // Activate ...
 func (c Chest) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
 	if opener, ok := u.(ContainerOpener); ok {
-		if d, ok := w.Block(pos.Side(cube.FaceUp)).(LightDiffuser); ok && d.LightDiffusionLevel() <= 2 {
+		//noinspection GoAssignmentToReceiver
+		c = c.restorePair(w, pos)
+		if c.paired && !c.openable(w, c.pairPos(pos)) {
+			// Double chests cannot be opened if either of the chests is obstructed.
+			return true
+		}
+		if c.openable(w, pos) {
 			opener.OpenBlockContainer(pos)
 		}
 		return true
@@ -119,6 +275,12 @@ func (c Chest) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User,
 	return false
 }
 
+// openable checks if the chest at the position passed is not obstructed by the block above it.
+func (c Chest) openable(w *world.World, pos cube.Pos) bool {
+	d, ok := w.Block(pos.Side(cube.FaceUp)).(LightDiffuser)
+	return ok && d.LightDiffusionLevel() <= 2
+}
+
 // UseOnBlock ...
 func (c Chest) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
 	pos, _, used = firstReplaceable(w, pos, face, c)
@@ -130,12 +292,45 @@ func (c Chest) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.W
 	c.Facing = user.Rotation().Direction().Opposite()
 
 	place(w, pos, c, user, ctx)
+	if placed(ctx) {
+		c.pairNeighbour(w, pos)
+	}
 	return placed(ctx)
 }
 
+// pairNeighbour pairs the chest placed at the position passed with an unpaired chest next to it that faces
+// the same direction, if one is present.
+func (c Chest) pairNeighbour(w *world.World, pos cube.Pos) {
+	//noinspection GoAssignmentToReceiver
+	c, ok := w.Block(pos).(Chest)
+	if !ok || c.paired {
+		return
+	}
+	for _, face := range []cube.Face{c.Facing.RotateLeft().Face(), c.Facing.RotateRight().Face()} {
+		pairPos := pos.Side(face)
+		if pair, ok := w.Block(pairPos).(Chest); !ok || pair.paired {
+			continue
+		}
+		if ch, pair, ok := c.pair(w, pos, pairPos); ok {
+			w.SetBlock(pos, ch, nil)
+			w.SetBlock(pairPos, pair, nil)
+			return
+		}
+	}
+}
+
 // BreakInfo ...
 func (c Chest) BreakInfo() BreakInfo {
-	return newBreakInfo(2.5, alwaysHarvestable, axeEffective, oneOf(c))
+	return newBreakInfo(2.5, alwaysHarvestable, axeEffective, oneOf(c)).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		if !c.paired || c.half == nil {
+			return
+		}
+		// The chest that this chest was paired with is left with only its own half of the double chest inventory.
+		pairPos := c.pairPos(pos)
+		if pair, ok := w.Block(pairPos).(Chest); ok && pair.paired && pair.pairPos(pairPos) == pos {
+			w.SetBlock(pairPos, pair.unpair(w, pairPos), nil)
+		}
+	})
 }
 
 // FuelInfo ...
@@ -156,6 +351,12 @@ func (c Chest) DecodeNBT(data map[string]any) any {
 	c.Facing = facing
 	c.CustomName = nbtconv.String(data, "CustomName")
 	nbtconv.InvFromNBT(c.inventory, nbtconv.Slice(data, "Items"))
+	if _, ok := data["pairx"]; ok {
+		// The pairing is only restored once the chest is used, because the chest it is paired with might not
+		// be loaded yet.
+		c.paired = true
+		c.pairX, c.pairZ = int(nbtconv.Int32(data, "pairx")), int(nbtconv.Int32(data, "pairz"))
+	}
 	return c
 }
 
@@ -167,13 +368,21 @@ func (c Chest) EncodeNBT() map[string]any {
 		c = NewChest()
 		c.Facing, c.CustomName = facing, customName
 	}
+	inv := c.inventory
+	if c.half != nil {
+		// Only the half of the double chest inventory that belongs to this chest is saved.
+		inv = c.half
+	}
 	m := map[string]any{
-		"Items": nbtconv.InvToNBT(c.inventory),
+		"Items": nbtconv.InvToNBT(inv),
 		"id":    "Chest",
 	}
 	if c.CustomName != "" {
 		m["CustomName"] = c.CustomName
 	}
+	if c.paired {
+		m["pairx"], m["pairz"] = int32(c.pairX), int32(c.pairZ)
+	}
 	return m
 }
 
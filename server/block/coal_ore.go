@@ -13,7 +13,7 @@ type CoalOre struct {
 
 // BreakInfo ...
 func (c CoalOre) BreakInfo() BreakInfo {
-	i := newBreakInfo(c.Type.Hardness(), pickaxeHarvestable, pickaxeEffective, silkTouchOneOf(item.Coal{}, c)).withXPDropRange(0, 2)
+	i := newBreakInfo(c.Type.Hardness(), pickaxeHarvestable, pickaxeEffective, oreDrops(item.Coal{}, c, 1, 1)).withOreXPDropRange(0, 2)
 	if c.Type == DeepslateOre() {
 		i = i.withBlastResistance(9)
 	}
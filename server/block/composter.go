@@ -27,6 +27,11 @@ func (c Composter) Model() world.BlockModel {
 	return model.Composter{Level: c.Level}
 }
 
+// ComparatorSignal returns the level of compost in the composter.
+func (c Composter) ComparatorSignal(cube.Pos, *world.World) int {
+	return c.Level
+}
+
 // FuelInfo ...
 func (c Composter) FuelInfo() item.FuelInfo {
 	return newFuelInfo(time.Second * 15)
@@ -27,13 +27,18 @@ func (c ConcretePowder) Solidifies(pos cube.Pos, w *world.World) bool {
 func (c ConcretePowder) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	for i := cube.Face(0); i < 6; i++ {
 		if _, ok := w.Block(pos.Side(i)).(Water); ok {
-			w.SetBlock(pos, Concrete{Colour: c.Colour}, nil)
+			w.SetBlock(pos, c.Harden(), nil)
 			return
 		}
 	}
 	c.fall(c, pos, w)
 }
 
+// Harden returns the Concrete that the ConcretePowder turns into when it comes into contact with water.
+func (c ConcretePowder) Harden() world.Block {
+	return Concrete{Colour: c.Colour}
+}
+
 // BreakInfo ...
 func (c ConcretePowder) BreakInfo() BreakInfo {
 	return newBreakInfo(0.5, alwaysHarvestable, shovelEffective, oneOf(c))
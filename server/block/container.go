@@ -28,3 +28,13 @@ type Container interface {
 	RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos)
 	Inventory() *inventory.Inventory
 }
+
+// ClearContainer clears the inventory of the Container passed and returns the items that were in it, so that they
+// may be dropped when the Container is broken. A paired Chest only has the slots of its own half cleared, so that
+// the chest it was paired with keeps its items.
+func ClearContainer(c Container) []item.Stack {
+	if ch, ok := c.(Chest); ok {
+		return ch.clearContents()
+	}
+	return c.Inventory().Clear()
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
 )
 
 // Crop is an interface for all crops that are grown on farmland. A crop has a random chance to grow during random ticks.
@@ -23,19 +24,34 @@ type crop struct {
 	Growth int
 }
 
+// cropGrowthLight is the minimum light level required for a crop to grow. Crops in a light level below
+// cropGrowthLight-1 are uprooted during random ticks.
+const cropGrowthLight = 9
+
 // NeighbourUpdateTick ...
 func (c crop) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	if _, ok := w.Block(pos.Side(cube.FaceDown)).(Farmland); !ok {
-		b := w.Block(pos)
-		w.SetBlock(pos, nil, nil)
-		if breakable, ok := b.(Breakable); ok {
-			for _, drop := range breakable.BreakInfo().Drops(item.ToolNone{}, []item.Enchantment{}) {
-				dropItem(w, drop, pos.Vec3Centre())
-			}
+		c.uproot(pos, w)
+	}
+}
+
+// uproot breaks the crop at the position passed, dropping the items that it would drop if broken by hand.
+func (c crop) uproot(pos cube.Pos, w *world.World) {
+	b := w.Block(pos)
+	w.SetBlock(pos, nil, nil)
+	w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	if breakable, ok := b.(Breakable); ok {
+		for _, drop := range breakable.BreakInfo().Drops(item.ToolNone{}, []item.Enchantment{}) {
+			dropItem(w, drop, pos.Vec3Centre())
 		}
 	}
 }
 
+// canGrow checks if the light level at the position of the crop is high enough for it to grow.
+func (c crop) canGrow(pos cube.Pos, w *world.World) bool {
+	return w.Light(pos) >= cropGrowthLight
+}
+
 // HasLiquidDrops ...
 func (c crop) HasLiquidDrops() bool {
 	return true
@@ -11,9 +11,7 @@ type Diamond struct {
 
 // BreakInfo ...
 func (d Diamond) BreakInfo() BreakInfo {
-	return newBreakInfo(5, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierIron.HarvestLevel
-	}, pickaxeEffective, oneOf(d)).withBlastResistance(30)
+	return newBreakInfo(5, pickaxeHarvestableTier(item.ToolTierIron), pickaxeEffective, oneOf(d)).withBlastResistance(30)
 }
 
 // PowersBeacon ...
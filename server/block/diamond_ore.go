@@ -15,9 +15,7 @@ type DiamondOre struct {
 
 // BreakInfo ...
 func (d DiamondOre) BreakInfo() BreakInfo {
-	i := newBreakInfo(d.Type.Hardness(), func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierIron.HarvestLevel
-	}, pickaxeEffective, silkTouchOneOf(item.Diamond{}, d)).withXPDropRange(3, 7)
+	i := newBreakInfo(d.Type.Hardness(), pickaxeHarvestableTier(item.ToolTierIron), pickaxeEffective, oreDrops(item.Diamond{}, d, 1, 1)).withOreXPDropRange(3, 7)
 	if d.Type == DeepslateOre() {
 		i = i.withBlastResistance(9)
 	}
@@ -5,6 +5,7 @@ import (
 	"github.com/df-mc/dragonfly/server/block/model"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
+	"math/rand"
 )
 
 // EnchantingTable is a block that allows players to spend their experience point levels to enchant tools, weapons,
@@ -44,6 +45,67 @@ func (EnchantingTable) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u ite
 	return false
 }
 
+// Bookshelves returns the amount of bookshelves that boost an enchanting table at the position passed, capped
+// at 15. A bookshelf only counts if it is two blocks away from the enchanting table with air in between.
+func (EnchantingTable) Bookshelves(pos cube.Pos, w *world.World) (shelves int) {
+	for x := -1; x <= 1; x++ {
+		for z := -1; z <= 1; z++ {
+			for y := 0; y <= 1; y++ {
+				if x == 0 && z == 0 {
+					// Ignore the center block.
+					continue
+				}
+				if _, ok := w.Block(pos.Add(cube.Pos{x, y, z})).(Air); !ok {
+					// There must be a one block space between the bookshelf and the enchanting table.
+					continue
+				}
+
+				// Check for a bookshelf two blocks away.
+				if _, ok := w.Block(pos.Add(cube.Pos{x * 2, y, z * 2})).(Bookshelf); ok {
+					shelves++
+				}
+				if x != 0 && z != 0 {
+					// Check for a bookshelf two blocks away on the X axis.
+					if _, ok := w.Block(pos.Add(cube.Pos{x * 2, y, z})).(Bookshelf); ok {
+						shelves++
+					}
+					// Check for a bookshelf two blocks away on the Z axis.
+					if _, ok := w.Block(pos.Add(cube.Pos{x, y, z * 2})).(Bookshelf); ok {
+						shelves++
+					}
+				}
+
+				if shelves >= 15 {
+					// We've found enough bookshelves.
+					return 15
+				}
+			}
+		}
+	}
+	return shelves
+}
+
+// EnchantingCosts returns the level requirements of the three enchantment options of an enchanting table,
+// boosted by the amount of bookshelves passed, as computed by vanilla. The random source is typically created
+// using the enchantment seed of a player, rand.New(rand.NewSource(seed)), and two values are read from it. The
+// number of levels and lapis lazuli actually spent on an option is equal to its index plus one.
+func EnchantingCosts(r *rand.Rand, bookshelves int) [3]int {
+	base := r.Intn(8) + 1 + (bookshelves >> 1) + r.Intn(bookshelves+1)
+	return [3]int{max(base/3, 1), base*2/3 + 1, max(base, bookshelves*2)}
+}
+
+// EnchantingLevelCostSource is a world.LevelCostSource used for experience levels spent on enchanting an item in
+// an enchanting table.
+type EnchantingLevelCostSource struct {
+	// Input is the item that is being enchanted.
+	Input item.Stack
+	// Enchantments are the enchantments that are applied to the item.
+	Enchantments []item.Enchantment
+}
+
+// LevelCostSource ...
+func (EnchantingLevelCostSource) LevelCostSource() {}
+
 // EncodeItem ...
 func (EnchantingTable) EncodeItem() (name string, meta int16) {
 	return "minecraft:enchanting_table", 0
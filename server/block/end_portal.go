@@ -0,0 +1,107 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+)
+
+// EndPortal is the block that makes up end portals. Entities entering an end portal in the Overworld are
+// transported to the obsidian platform in the End, while entities entering one in the End are transported back
+// to their spawn in the Overworld.
+type EndPortal struct {
+	transparent
+	empty
+}
+
+// EndPortalTraveller represents an entity that can travel to another world.World through an EndPortal.
+type EndPortalTraveller interface {
+	world.Entity
+	// Teleport teleports the entity to the position passed.
+	Teleport(pos mgl64.Vec3)
+}
+
+// CreditsViewer represents an entity, such as a player, that is shown the credits the first time it leaves the End
+// through an EndPortal. The entity only leaves the End once it finished watching the credits.
+type CreditsViewer interface {
+	// SeenCredits checks if the entity finished watching the end credits before.
+	SeenCredits() bool
+	// ShowCredits shows the end credits to the entity and calls the function passed once the entity finished
+	// watching them. ShowCredits does nothing if the entity is already watching the credits.
+	ShowCredits(f func())
+}
+
+// endPlatformPos is the position in the End of the centre of the obsidian platform that entities arrive on.
+var endPlatformPos = cube.Pos{100, 48, 0}
+
+// EntityInside ...
+func (EndPortal) EntityInside(_ cube.Pos, w *world.World, e world.Entity) {
+	t, ok := e.(EndPortalTraveller)
+	if !ok || e.World() != w {
+		return
+	}
+	dest := w.PortalDestination(world.End)
+	if dest == w {
+		return
+	}
+	if v, ok := e.(CreditsViewer); ok && w.Dimension() == world.End && !v.SeenCredits() {
+		// The entity stays in the End until it finished watching the credits, after which it leaves the End,
+		// unless it left the End in a different way in the meantime.
+		v.ShowCredits(func() {
+			if e.World() == w {
+				travelEndPortal(t, w, dest)
+			}
+		})
+		return
+	}
+	travelEndPortal(t, w, dest)
+}
+
+// travelEndPortal transports the EndPortalTraveller passed from the world.World it is in, w, to dest. Travellers
+// leaving the End are transported to their spawn, while travellers entering it arrive on the obsidian platform.
+func travelEndPortal(t EndPortalTraveller, w, dest *world.World) {
+	var pos mgl64.Vec3
+	if w.Dimension() == world.End {
+		spawn := dest.Spawn()
+		if u, ok := t.(interface{ UUID() uuid.UUID }); ok {
+			spawn = dest.PlayerSpawn(u.UUID())
+		}
+		pos = spawn.Vec3Middle()
+	} else {
+		buildEndPlatform(dest)
+		pos = endPlatformPos.Side(cube.FaceUp).Vec3Middle()
+	}
+	if !dest.AddEntity(t) {
+		return
+	}
+	t.Teleport(pos)
+}
+
+// buildEndPlatform builds the 5x5 obsidian platform that entities arrive on in the End and clears the space above
+// it, so that entities never arrive inside of blocks.
+func buildEndPlatform(w *world.World) {
+	for x := -2; x <= 2; x++ {
+		for z := -2; z <= 2; z++ {
+			w.SetBlock(endPlatformPos.Add(cube.Pos{x, 0, z}), Obsidian{}, nil)
+			for y := 1; y <= 3; y++ {
+				w.SetBlock(endPlatformPos.Add(cube.Pos{x, y, z}), nil, nil)
+			}
+		}
+	}
+}
+
+// PistonImmovable always returns true. End portals cannot be moved or broken by pistons.
+func (EndPortal) PistonImmovable() bool {
+	return true
+}
+
+// LightEmissionLevel ...
+func (EndPortal) LightEmissionLevel() uint8 {
+	return 15
+}
+
+// EncodeBlock ...
+func (EndPortal) EncodeBlock() (string, map[string]any) {
+	return "minecraft:end_portal", nil
+}
@@ -26,6 +26,9 @@ type ExplosionConfig struct {
 	// DisableItemDrops, when set to true, will prevent any item entities from dropping as a result of blocks being
 	// destroyed.
 	DisableItemDrops bool
+	// Owner is the entity responsible for the explosion, such as the entity that ignited a TNT block. Damage
+	// dealt by the explosion is attributed to the Owner. Owner may be nil.
+	Owner world.Entity
 
 	// Sound is the sound to play when the explosion is created. If set to nil, this will default to the sound of a
 	// regular explosion.
@@ -105,6 +108,14 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 		}
 	}
 
+	if w.Immutable() || w.Griefing().DisableExplosions {
+		// Blocks in the world cannot be modified by the explosion, so there's no need to calculate the blocks
+		// affected.
+		w.AddParticle(explosionPos, c.Particle)
+		w.PlaySound(explosionPos, c.Sound)
+		return
+	}
+
 	affectedBlocks := make([]cube.Pos, 0, 32)
 	for _, ray := range rays {
 		pos := explosionPos
@@ -134,7 +145,8 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 			explodable.Explode(explosionPos, pos, w, c)
 		} else if breakable, ok := bl.(Breakable); ok {
 			w.SetBlock(pos, nil, nil)
-			if !c.DisableItemDrops && 1/c.Size > r.Float64() {
+			// Shulker boxes always drop, so that the items stored in them are never lost to an explosion.
+			if _, shulker := bl.(ShulkerBox); !c.DisableItemDrops && (shulker || 1/c.Size > r.Float64()) {
 				for _, drop := range breakable.BreakInfo().Drops(item.ToolNone{}, nil) {
 					dropItem(w, drop, pos.Vec3Centre())
 				}
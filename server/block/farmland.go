@@ -12,15 +12,15 @@ type Farmland struct {
 	tilledGrass
 
 	// Hydration is how much moisture the farmland block has. Hydration starts at 0 & caps at 7. During a random tick
-	// update, if there is water within 4 blocks from the farmland block, hydration is set to 7. Otherwise, it
-	// decrements until it turns into dirt.
+	// update, if there is water within 4 blocks from the farmland block or if it is raining on it, hydration is set
+	// to 7. Otherwise, it decrements until it turns into dirt.
 	Hydration int
 }
 
 // SoilFor ...
 func (f Farmland) SoilFor(block world.Block) bool {
 	switch block.(type) {
-	case TallGrass, DoubleTallGrass, Flower, DoubleFlower, NetherSprouts:
+	case TallGrass, DoubleTallGrass, Flower, DoubleFlower, NetherSprouts, Sapling:
 		return true
 	}
 	return false
@@ -35,24 +35,29 @@ func (f Farmland) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 
 // RandomTick ...
 func (f Farmland) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
-	if !f.hydrated(pos, w) {
-		if f.Hydration > 0 {
-			f.Hydration--
+	if f.hydrated(pos, w) {
+		if f.Hydration != 7 {
+			f.Hydration = 7
 			w.SetBlock(pos, f, nil)
-		} else {
-			blockAbove := w.Block(pos.Side(cube.FaceUp))
-			if _, cropAbove := blockAbove.(Crop); !cropAbove {
-				w.SetBlock(pos, Dirt{}, nil)
-			}
 		}
-	} else {
-		f.Hydration = 7
+		return
+	}
+	if f.Hydration > 0 {
+		f.Hydration--
 		w.SetBlock(pos, f, nil)
+		return
+	}
+	if _, cropAbove := w.Block(pos.Side(cube.FaceUp)).(Crop); !cropAbove {
+		w.SetBlock(pos, Dirt{}, nil)
 	}
 }
 
-// hydrated checks for water within 4 blocks in each direction from the farmland.
+// hydrated checks for water within 4 blocks horizontally and up to 1 block above the farmland, or if it is
+// raining on the farmland.
 func (f Farmland) hydrated(pos cube.Pos, w *world.World) bool {
+	if w.RainingAt(pos.Side(cube.FaceUp)) {
+		return true
+	}
 	posX, posY, posZ := pos.X(), pos.Y(), pos.Z()
 	for y := 0; y <= 1; y++ {
 		for x := -4; x <= 4; x++ {
@@ -68,12 +73,21 @@ func (f Farmland) hydrated(pos cube.Pos, w *world.World) bool {
 	return false
 }
 
-// EntityLand ...
+// EntityLand tramples the farmland, turning it into dirt, if a living entity such as a player or a mob lands on
+// it. The further the entity fell, the more likely it is that the farmland is trampled: Farmland is never
+// trampled by entities falling less than half a block, and always by those falling more than 1.5 blocks. Crops on
+// top of the farmland break as a result. Mobs only trample farmland if mob griefing is enabled in the world.
+// Players, which are recognised by having a game mode, always trample farmland. Farmland trampled by a player is
+// changed using World.SetBlock directly: It bypasses World.SetBlockByEntity, so the world.Handler is not called
+// and trampling by players cannot be cancelled.
 func (f Farmland) EntityLand(pos cube.Pos, w *world.World, e world.Entity, distance *float64) {
-	if living, ok := e.(livingEntity); ok {
-		if fall, ok := living.(fallDistanceEntity); ok && rand.Float64() < fall.FallDistance()-0.5 {
-			w.SetBlock(pos, Dirt{}, nil)
-		}
+	if _, ok := e.(livingEntity); !ok || rand.Float64() >= *distance-0.5 {
+		return
+	}
+	if _, ok := e.(interface{ GameMode() world.GameMode }); ok {
+		w.SetBlock(pos, Dirt{}, nil)
+	} else if !w.Griefing().DisableMobs {
+		w.SetBlockByEntity(e, pos, Dirt{})
 	}
 }
 
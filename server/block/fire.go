@@ -63,13 +63,16 @@ func infinitelyBurning(pos cube.Pos, w *world.World) bool {
 
 // burn attempts to burn a block.
 func (f Fire) burn(from, to cube.Pos, w *world.World, r *rand.Rand, chanceBound int) {
+	if w.Griefing().DisableFire {
+		return
+	}
 	if flammable, ok := w.Block(to).(Flammable); ok && r.Intn(chanceBound) < flammable.FlammabilityInfo().Flammability {
 		if r.Intn(f.Age+10) < 5 && !rainingAround(to, w) {
 			f.spread(from, to, w, r)
 			return
 		}
 		if t, ok := flammable.(TNT); ok {
-			t.Ignite(to, w)
+			t.Ignite(to, w, nil)
 			return
 		}
 		w.SetBlock(to, nil, nil)
@@ -90,7 +93,7 @@ func rainingAround(pos cube.Pos, w *world.World) bool {
 
 // tick ...
 func (f Fire) tick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if f.Type == SoulFire() {
+	if f.Type == SoulFire() || !w.FireTick() {
 		return
 	}
 	infinitelyBurns := infinitelyBurning(pos, w)
@@ -58,6 +58,14 @@ func (f Furnace) EncodeBlock() (name string, properties map[string]interface{})
 	return "minecraft:furnace", map[string]interface{}{"minecraft:cardinal_direction": f.Facing.String()}
 }
 
+// LightEmissionLevel ...
+func (f Furnace) LightEmissionLevel() uint8 {
+	if f.Lit {
+		return 13
+	}
+	return 0
+}
+
 // UseOnBlock ...
 func (f Furnace) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
 	pos, _, used := firstReplaceable(w, pos, face, f)
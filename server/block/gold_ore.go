@@ -15,9 +15,7 @@ type GoldOre struct {
 
 // BreakInfo ...
 func (g GoldOre) BreakInfo() BreakInfo {
-	i := newBreakInfo(g.Type.Hardness(), func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierIron.HarvestLevel
-	}, pickaxeEffective, silkTouchOneOf(item.RawGold{}, g))
+	i := newBreakInfo(g.Type.Hardness(), pickaxeHarvestableTier(item.ToolTierIron), pickaxeEffective, oreDrops(item.RawGold{}, g, 1, 1))
 	if g.Type == DeepslateOre() {
 		i = i.withBlastResistance(9)
 	}
@@ -14,6 +14,12 @@ type Gravel struct {
 	snare
 }
 
+// SoilFor ...
+func (Gravel) SoilFor(block world.Block) bool {
+	_, ok := block.(Bamboo)
+	return ok
+}
+
 // NeighbourUpdateTick ...
 func (g Gravel) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	g.fall(g, pos, w)
@@ -22,7 +28,7 @@ func (g Gravel) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 // BreakInfo ...
 func (g Gravel) BreakInfo() BreakInfo {
 	return newBreakInfo(0.6, alwaysHarvestable, shovelEffective, func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
-		if !hasSilkTouch(enchantments) && rand.Float64() < 0.1 {
+		if !hasSilkTouch(enchantments) && rand.Float64() < fortuneChance(fortuneLevel(enchantments), 0.1, 1.0/7, 0.25, 1) {
 			return []item.Stack{item.NewStack(item.Flint{}, 1)}
 		}
 		return []item.Stack{item.NewStack(g, 1)}
@@ -13,6 +13,7 @@ import (
 // weaponsmith's job site block.
 type Grindstone struct {
 	transparent
+	sourceWaterDisplacer
 
 	// Attach represents the attachment type of the Grindstone.
 	Attach GrindstoneAttachment
@@ -20,6 +21,11 @@ type Grindstone struct {
 	Facing cube.Direction
 }
 
+// SideClosed ...
+func (Grindstone) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
 // BreakInfo ...
 func (g Grindstone) BreakInfo() BreakInfo {
 	return newBreakInfo(2, pickaxeHarvestable, pickaxeEffective, oneOf(g)).withBlastResistance(30)
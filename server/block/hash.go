@@ -8,24 +8,30 @@ const (
 	hashAncientDebris
 	hashAndesite
 	hashAnvil
+	hashBamboo
 	hashBanner
 	hashBarrel
 	hashBarrier
 	hashBasalt
 	hashBeacon
+	hashBed
 	hashBedrock
 	hashBeetrootSeeds
+	hashBell
 	hashBlackstone
 	hashBlastFurnace
 	hashBlueIce
 	hashBone
 	hashBookshelf
 	hashBricks
+	hashButton
 	hashCactus
 	hashCake
 	hashCalcite
+	hashCampfire
 	hashCarpet
 	hashCarrot
+	hashCauldron
 	hashChain
 	hashChest
 	hashChiseledQuartz
@@ -56,11 +62,11 @@ const (
 	hashDragonEgg
 	hashDriedKelp
 	hashDripstone
-	hashDropper
 	hashEmerald
 	hashEmeraldOre
 	hashEnchantingTable
 	hashEndBricks
+	hashEndPortal
 	hashEndStone
 	hashEnderChest
 	hashFarmland
@@ -81,6 +87,8 @@ const (
 	hashGrindstone
 	hashHayBale
 	hashHoneycomb
+	hashHopper
+	hashIce
 	hashInvisibleBedrock
 	hashIron
 	hashIronBars
@@ -95,13 +103,17 @@ const (
 	hashLava
 	hashLeaves
 	hashLectern
+	hashLever
 	hashLight
 	hashLitPumpkin
+	hashLodestone
 	hashLog
 	hashLoom
 	hashMelon
 	hashMelonSeeds
+	hashMobSpawner
 	hashMossCarpet
+	hashMoving
 	hashMud
 	hashMudBricks
 	hashMuddyMangroveRoots
@@ -118,10 +130,14 @@ const (
 	hashObsidian
 	hashPackedIce
 	hashPackedMud
+	hashPiston
+	hashPistonArmCollision
 	hashPlanks
 	hashPodzol
 	hashPolishedBlackstoneBrick
 	hashPotato
+	hashPowderSnow
+	hashPressurePlate
 	hashPrismarine
 	hashPumpkin
 	hashPumpkinSeeds
@@ -130,21 +146,29 @@ const (
 	hashQuartz
 	hashQuartzBricks
 	hashQuartzPillar
+	hashRail
 	hashRawCopper
 	hashRawGold
 	hashRawIron
+	hashRedstoneBlock
+	hashRedstoneComparator
+	hashRedstoneRepeater
+	hashRedstoneWire
 	hashReinforcedDeepslate
 	hashSand
 	hashSandstone
+	hashSapling
 	hashSeaLantern
 	hashSeaPickle
 	hashShroomlight
+	hashShulkerBox
 	hashSign
 	hashSkull
 	hashSlab
 	hashSmithingTable
 	hashSmoker
 	hashSnow
+	hashSnowLayer
 	hashSoulSand
 	hashSoulSoil
 	hashSponge
@@ -208,6 +232,11 @@ func (a Anvil) Hash() uint64 {
 	return hashAnvil | uint64(a.Type.Uint8())<<8 | uint64(a.Facing)<<10
 }
 
+// Hash ...
+func (b Bamboo) Hash() uint64 {
+	return hashBamboo | uint64(boolByte(b.Thick))<<8 | uint64(b.LeafSize.Uint8())<<9 | uint64(boolByte(b.Mature))<<11
+}
+
 // Hash ...
 func (b Banner) Hash() uint64 {
 	return hashBanner | uint64(b.Attach.Uint8())<<8
@@ -233,6 +262,11 @@ func (Beacon) Hash() uint64 {
 	return hashBeacon
 }
 
+// Hash ...
+func (b Bed) Hash() uint64 {
+	return hashBed | uint64(b.Facing)<<8 | uint64(boolByte(b.Head))<<10 | uint64(boolByte(b.Occupied))<<11
+}
+
 // Hash ...
 func (b Bedrock) Hash() uint64 {
 	return hashBedrock | uint64(boolByte(b.InfiniteBurning))<<8
@@ -243,6 +277,11 @@ func (b BeetrootSeeds) Hash() uint64 {
 	return hashBeetrootSeeds | uint64(b.Growth)<<8
 }
 
+// Hash ...
+func (b Bell) Hash() uint64 {
+	return hashBell | uint64(b.Attach.Uint8())<<8 | uint64(b.Facing)<<10 | uint64(boolByte(b.Powered))<<12
+}
+
 // Hash ...
 func (b Blackstone) Hash() uint64 {
 	return hashBlackstone | uint64(b.Type.Uint8())<<8
@@ -273,6 +312,11 @@ func (Bricks) Hash() uint64 {
 	return hashBricks
 }
 
+// Hash ...
+func (b Button) Hash() uint64 {
+	return hashButton | uint64(b.Type.Uint8())<<8 | uint64(b.Facing)<<12 | uint64(boolByte(b.Pressed))<<15
+}
+
 // Hash ...
 func (c Cactus) Hash() uint64 {
 	return hashCactus | uint64(c.Age)<<8
@@ -288,6 +332,11 @@ func (Calcite) Hash() uint64 {
 	return hashCalcite
 }
 
+// Hash ...
+func (c Campfire) Hash() uint64 {
+	return hashCampfire | uint64(c.Facing)<<8 | uint64(boolByte(c.Extinguished))<<10 | uint64(c.Type.Uint8())<<11
+}
+
 // Hash ...
 func (c Carpet) Hash() uint64 {
 	return hashCarpet | uint64(c.Colour.Uint8())<<8
@@ -298,6 +347,11 @@ func (c Carrot) Hash() uint64 {
 	return hashCarrot | uint64(c.Growth)<<8
 }
 
+// Hash ...
+func (c Cauldron) Hash() uint64 {
+	return hashCauldron | uint64(c.Liquid.Uint8())<<8 | uint64(c.Level)<<10
+}
+
 // Hash ...
 func (c Chain) Hash() uint64 {
 	return hashChain | uint64(c.Axis)<<8
@@ -468,6 +522,11 @@ func (EndBricks) Hash() uint64 {
 	return hashEndBricks
 }
 
+// Hash ...
+func (EndPortal) Hash() uint64 {
+	return hashEndPortal
+}
+
 // Hash ...
 func (EndStone) Hash() uint64 {
 	return hashEndStone
@@ -568,6 +627,16 @@ func (Honeycomb) Hash() uint64 {
 	return hashHoneycomb
 }
 
+// Hash ...
+func (h Hopper) Hash() uint64 {
+	return hashHopper | uint64(h.Facing)<<8 | uint64(boolByte(h.Powered))<<11
+}
+
+// Hash ...
+func (Ice) Hash() uint64 {
+	return hashIce
+}
+
 // Hash ...
 func (InvisibleBedrock) Hash() uint64 {
 	return hashInvisibleBedrock
@@ -635,7 +704,12 @@ func (l Leaves) Hash() uint64 {
 
 // Hash ...
 func (l Lectern) Hash() uint64 {
-	return hashLectern | uint64(l.Facing)<<8
+	return hashLectern | uint64(l.Facing)<<8 | uint64(boolByte(l.Powered))<<11
+}
+
+// Hash ...
+func (l Lever) Hash() uint64 {
+	return hashLever | uint64(boolByte(l.Powered))<<8 | uint64(l.Facing)<<9 | uint64(l.Direction)<<12
 }
 
 // Hash ...
@@ -648,6 +722,11 @@ func (l LitPumpkin) Hash() uint64 {
 	return hashLitPumpkin | uint64(l.Facing)<<8
 }
 
+// Hash ...
+func (Lodestone) Hash() uint64 {
+	return hashLodestone
+}
+
 // Hash ...
 func (l Log) Hash() uint64 {
 	return hashLog | uint64(l.Wood.Uint8())<<8 | uint64(boolByte(l.Stripped))<<12 | uint64(l.Axis)<<13
@@ -668,11 +747,21 @@ func (m MelonSeeds) Hash() uint64 {
 	return hashMelonSeeds | uint64(m.Growth)<<8 | uint64(m.Direction)<<16
 }
 
+// Hash ...
+func (MobSpawner) Hash() uint64 {
+	return hashMobSpawner
+}
+
 // Hash ...
 func (MossCarpet) Hash() uint64 {
 	return hashMossCarpet
 }
 
+// Hash ...
+func (Moving) Hash() uint64 {
+	return hashMoving
+}
+
 // Hash ...
 func (Mud) Hash() uint64 {
 	return hashMud
@@ -753,6 +842,16 @@ func (PackedMud) Hash() uint64 {
 	return hashPackedMud
 }
 
+// Hash ...
+func (p Piston) Hash() uint64 {
+	return hashPiston | uint64(p.Facing)<<8 | uint64(boolByte(p.Sticky))<<11
+}
+
+// Hash ...
+func (a PistonArmCollision) Hash() uint64 {
+	return hashPistonArmCollision | uint64(a.Facing)<<8 | uint64(boolByte(a.Sticky))<<11
+}
+
 // Hash ...
 func (p Planks) Hash() uint64 {
 	return hashPlanks | uint64(p.Wood.Uint8())<<8
@@ -773,6 +872,16 @@ func (p Potato) Hash() uint64 {
 	return hashPotato | uint64(p.Growth)<<8
 }
 
+// Hash ...
+func (PowderSnow) Hash() uint64 {
+	return hashPowderSnow
+}
+
+// Hash ...
+func (p PressurePlate) Hash() uint64 {
+	return hashPressurePlate | uint64(p.Type.Uint8())<<8 | uint64(p.Power)<<12
+}
+
 // Hash ...
 func (p Prismarine) Hash() uint64 {
 	return hashPrismarine | uint64(p.Type.Uint8())<<8
@@ -813,6 +922,11 @@ func (q QuartzPillar) Hash() uint64 {
 	return hashQuartzPillar | uint64(q.Axis)<<8
 }
 
+// Hash ...
+func (r Rail) Hash() uint64 {
+	return hashRail | uint64(r.Shape.Uint8())<<8
+}
+
 // Hash ...
 func (RawCopper) Hash() uint64 {
 	return hashRawCopper
@@ -828,6 +942,26 @@ func (RawIron) Hash() uint64 {
 	return hashRawIron
 }
 
+// Hash ...
+func (RedstoneBlock) Hash() uint64 {
+	return hashRedstoneBlock
+}
+
+// Hash ...
+func (c RedstoneComparator) Hash() uint64 {
+	return hashRedstoneComparator | uint64(c.Facing)<<8 | uint64(boolByte(c.Subtract))<<10 | uint64(boolByte(c.Powered))<<11
+}
+
+// Hash ...
+func (r RedstoneRepeater) Hash() uint64 {
+	return hashRedstoneRepeater | uint64(r.Facing)<<8 | uint64(r.Delay)<<10 | uint64(boolByte(r.Powered))<<18
+}
+
+// Hash ...
+func (r RedstoneWire) Hash() uint64 {
+	return hashRedstoneWire | uint64(r.Power)<<8
+}
+
 // Hash ...
 func (ReinforcedDeepslate) Hash() uint64 {
 	return hashReinforcedDeepslate
@@ -843,6 +977,11 @@ func (s Sandstone) Hash() uint64 {
 	return hashSandstone | uint64(s.Type.Uint8())<<8 | uint64(boolByte(s.Red))<<10
 }
 
+// Hash ...
+func (s Sapling) Hash() uint64 {
+	return hashSapling | uint64(s.Wood.Uint8())<<8 | uint64(boolByte(s.Ready))<<12
+}
+
 // Hash ...
 func (SeaLantern) Hash() uint64 {
 	return hashSeaLantern
@@ -858,6 +997,11 @@ func (Shroomlight) Hash() uint64 {
 	return hashShroomlight
 }
 
+// Hash ...
+func (s ShulkerBox) Hash() uint64 {
+	return hashShulkerBox | uint64(s.Type.Uint8())<<8
+}
+
 // Hash ...
 func (s Sign) Hash() uint64 {
 	return hashSign | uint64(s.Wood.Uint8())<<8 | uint64(s.Attach.Uint8())<<12
@@ -888,6 +1032,11 @@ func (Snow) Hash() uint64 {
 	return hashSnow
 }
 
+// Hash ...
+func (s SnowLayer) Hash() uint64 {
+	return hashSnowLayer | uint64(s.Height)<<8 | uint64(boolByte(s.Covered))<<16
+}
+
 // Hash ...
 func (SoulSand) Hash() uint64 {
 	return hashSoulSand
@@ -0,0 +1,300 @@
+package block
+
+import (
+	"fmt"
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+	"sync"
+)
+
+// hopperTransferCooldown is the amount of ticks that a hopper waits after transferring an item before it transfers
+// another item. This is equal to four redstone ticks.
+const hopperTransferCooldown = 8
+
+// Hopper is a block used to collect item entities above it, and to move items from one container to another.
+type Hopper struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Facing is the direction that the hopper is facing. Items are pushed into the container in this direction.
+	// Facing is either cube.FaceDown or one of the horizontal faces.
+	Facing cube.Face
+	// Powered specifies if the hopper is powered by redstone. A powered hopper is locked: It does not pull or
+	// push any items.
+	Powered bool
+	// CustomName is the custom name of the hopper. This name is displayed when the hopper is opened, and may
+	// include colour codes.
+	CustomName string
+
+	inventory    *inventory.Inventory
+	viewerMu     *sync.RWMutex
+	viewers      map[ContainerViewer]struct{}
+	lastTransfer *atomic.Int64
+}
+
+// NewHopper creates a new initialised hopper. The inventory is properly initialised.
+func NewHopper() Hopper {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	return Hopper{
+		inventory: inventory.New(5, func(slot int, _, item item.Stack) {
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot, item)
+			}
+		}),
+		viewerMu:     m,
+		viewers:      v,
+		lastTransfer: atomic.NewInt64(0),
+	}
+}
+
+// Model ...
+func (Hopper) Model() world.BlockModel {
+	return model.Hopper{}
+}
+
+// BreakInfo ...
+func (h Hopper) BreakInfo() BreakInfo {
+	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, oneOf(Hopper{})).withBlastResistance(24).withBreakHandler(func(pos cube.Pos, w *world.World, u item.User) {
+		for _, i := range h.Inventory().Clear() {
+			dropItem(w, i, pos.Vec3())
+		}
+	})
+}
+
+// Inventory returns the inventory of the hopper. The size of the inventory will be 5.
+func (h Hopper) Inventory() *inventory.Inventory {
+	return h.inventory
+}
+
+// WithName returns the hopper after applying a specific name to the block.
+func (h Hopper) WithName(a ...any) world.Item {
+	h.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return h
+}
+
+// AddViewer adds a viewer to the hopper, so that it is updated whenever the inventory of the hopper is changed.
+func (h Hopper) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	h.viewerMu.Lock()
+	defer h.viewerMu.Unlock()
+	h.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the hopper, so that slot updates in the inventory are no longer sent to
+// it.
+func (h Hopper) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	h.viewerMu.Lock()
+	defer h.viewerMu.Unlock()
+	delete(h.viewers, v)
+}
+
+// Activate ...
+func (Hopper) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (h Hopper) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, h)
+	if !used {
+		return
+	}
+
+	//noinspection GoAssignmentToReceiver
+	h = NewHopper()
+	h.Facing = cube.FaceDown
+	if face != cube.FaceDown && face != cube.FaceUp {
+		// The hopper faces the block it was placed against.
+		h.Facing = face.Opposite()
+	}
+	h.Powered = Powered(pos, w)
+
+	place(w, pos, h, user, ctx)
+	return placed(ctx)
+}
+
+// Tick ...
+func (h Hopper) Tick(currentTick int64, pos cube.Pos, w *world.World) {
+	if h.Powered || h.inventory == nil || currentTick-h.lastTransfer.Load() < hopperTransferCooldown {
+		return
+	}
+	pushed := h.push(pos, w)
+	pulled := h.pull(pos, w)
+	if pushed || pulled {
+		h.lastTransfer.Store(currentTick)
+	}
+}
+
+// NeighbourUpdateTick ...
+func (h Hopper) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	h.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate locks the hopper if it is powered by redstone and unlocks it if it is no longer powered.
+func (h Hopper) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if powered := Powered(pos, w); powered != h.Powered {
+		h.Powered = powered
+		w.SetBlock(pos, h, nil)
+	}
+}
+
+// Collect collects as much of the item stack passed as possible into the inventory of the hopper, for example
+// when an item entity is above the hopper. The amount of items collected is returned. A powered hopper does not
+// collect any items.
+func (h Hopper) Collect(stack item.Stack) (n int) {
+	if h.Powered || h.inventory == nil {
+		return 0
+	}
+	n, _ = h.inventory.AddItem(stack)
+	return n
+}
+
+// push pushes a single item from the inventory of the hopper into the container that the hopper is facing.
+func (h Hopper) push(pos cube.Pos, w *world.World) bool {
+	destPos := pos.Side(h.Facing)
+	dest, ok := w.Block(destPos).(Container)
+	if !ok {
+		return false
+	}
+	for slot, it := range h.inventory.Slots() {
+		if it.Empty() {
+			continue
+		}
+		if insertSingle(dest, it.Grow(1-it.Count()), h.Facing.Opposite()) {
+			_ = h.inventory.SetItem(slot, it.Grow(-1))
+			return true
+		}
+	}
+	return false
+}
+
+// pull pulls a single item from the container above the hopper into the inventory of the hopper.
+func (h Hopper) pull(pos cube.Pos, w *world.World) bool {
+	src, ok := w.Block(pos.Side(cube.FaceUp)).(Container)
+	if !ok {
+		return false
+	}
+	inv := src.Inventory()
+	for _, slot := range extractableSlots(src) {
+		it, _ := inv.Item(slot)
+		if it.Empty() {
+			continue
+		}
+		if n, _ := h.inventory.AddItem(it.Grow(1 - it.Count())); n == 1 {
+			_ = inv.SetItem(slot, it.Grow(-1))
+			return true
+		}
+	}
+	return false
+}
+
+// extractableSlots returns the slots of the container passed that hoppers may extract items from. For smelters,
+// only the output slot may be extracted from.
+func extractableSlots(c Container) []int {
+	switch c.(type) {
+	case Furnace, BlastFurnace, Smoker:
+		return []int{2}
+	}
+	slots := make([]int, c.Inventory().Size())
+	for i := range slots {
+		slots[i] = i
+	}
+	return slots
+}
+
+// insertSingle inserts a single item into the container passed. The face is the face of the container that the
+// item is inserted through. True is returned if the item was inserted.
+func insertSingle(c Container, it item.Stack, face cube.Face) bool {
+	inv := c.Inventory()
+	switch c.(type) {
+	case ShulkerBox:
+		if _, ok := it.Item().(ShulkerBox); ok {
+			// Shulker boxes cannot be placed inside other shulker boxes.
+			return false
+		}
+	case Furnace, BlastFurnace, Smoker:
+		// Items inserted from above go into the input slot, while items inserted from the side go into the fuel
+		// slot.
+		slot := 0
+		if face != cube.FaceUp {
+			if _, fuel := it.Item().(item.Fuel); !fuel {
+				return false
+			}
+			slot = 1
+		}
+		existing, _ := inv.Item(slot)
+		if existing.Empty() {
+			return inv.SetItem(slot, it) == nil
+		}
+		if !existing.Comparable(it) || existing.Count() >= existing.MaxCount() {
+			return false
+		}
+		return inv.SetItem(slot, existing.Grow(1)) == nil
+	}
+	n, _ := inv.AddItem(it)
+	return n == 1
+}
+
+// DecodeNBT ...
+func (h Hopper) DecodeNBT(data map[string]any) any {
+	facing, powered := h.Facing, h.Powered
+	//noinspection GoAssignmentToReceiver
+	h = NewHopper()
+	h.Facing, h.Powered = facing, powered
+	h.CustomName = nbtconv.String(data, "CustomName")
+	nbtconv.InvFromNBT(h.inventory, nbtconv.Slice(data, "Items"))
+	return h
+}
+
+// EncodeNBT ...
+func (h Hopper) EncodeNBT() map[string]any {
+	if h.inventory == nil {
+		facing, powered, customName := h.Facing, h.Powered, h.CustomName
+		//noinspection GoAssignmentToReceiver
+		h = NewHopper()
+		h.Facing, h.Powered, h.CustomName = facing, powered, customName
+	}
+	m := map[string]any{
+		"Items": nbtconv.InvToNBT(h.inventory),
+		"id":    "Hopper",
+	}
+	if h.CustomName != "" {
+		m["CustomName"] = h.CustomName
+	}
+	return m
+}
+
+// EncodeItem ...
+func (Hopper) EncodeItem() (name string, meta int16) {
+	return "minecraft:hopper", 0
+}
+
+// EncodeBlock ...
+func (h Hopper) EncodeBlock() (string, map[string]any) {
+	return "minecraft:hopper", map[string]any{"facing_direction": int32(h.Facing), "toggle_bit": boolByte(h.Powered)}
+}
+
+// allHoppers ...
+func allHoppers() (hoppers []world.Block) {
+	for _, f := range cube.Faces() {
+		if f == cube.FaceUp {
+			continue
+		}
+		hoppers = append(hoppers, Hopper{Facing: f})
+		hoppers = append(hoppers, Hopper{Facing: f, Powered: true})
+	}
+	return hoppers
+}
@@ -0,0 +1,73 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"math/rand"
+)
+
+// Ice is a translucent solid block that melts into water when it is exposed to bright light from blocks, such as
+// torches. Ice broken without silk touch turns into water if it was above a solid block or a liquid.
+type Ice struct {
+	solid
+}
+
+// Instrument ...
+func (Ice) Instrument() sound.Instrument {
+	return sound.Chimes()
+}
+
+// Friction ...
+func (Ice) Friction() float64 {
+	return 0.98
+}
+
+// LightDiffusionLevel ...
+func (Ice) LightDiffusionLevel() uint8 {
+	return 2
+}
+
+// RandomTick ...
+func (i Ice) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if w.BlockLight(pos) > 11 {
+		i.melt(pos, w)
+	}
+}
+
+// melt melts the ice at the position passed, turning it into water. In dimensions where water evaporates, the
+// ice is removed instead.
+func (Ice) melt(pos cube.Pos, w *world.World) {
+	if w.Dimension().WaterEvaporates() {
+		w.SetBlock(pos, nil, nil)
+		return
+	}
+	w.SetBlock(pos, Water{Depth: 8, Still: true}, nil)
+}
+
+// BreakInfo ...
+func (i Ice) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, pickaxeEffective, silkTouchOnlyDrop(i)).withBreakHandler(func(pos cube.Pos, w *world.World, u item.User) {
+		if g, ok := u.(interface{ GameMode() world.GameMode }); ok && g.GameMode().CreativeInventory() {
+			return
+		}
+		if held, _ := u.HeldItems(); hasSilkTouch(held.Enchantments()) {
+			return
+		}
+		below := pos.Side(cube.FaceDown)
+		if _, liquid := w.Liquid(below); liquid || w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
+			i.melt(pos, w)
+		}
+	})
+}
+
+// EncodeItem ...
+func (Ice) EncodeItem() (name string, meta int16) {
+	return "minecraft:ice", 0
+}
+
+// EncodeBlock ...
+func (Ice) EncodeBlock() (string, map[string]any) {
+	return "minecraft:ice", nil
+}
@@ -15,9 +15,7 @@ type IronOre struct {
 
 // BreakInfo ...
 func (i IronOre) BreakInfo() BreakInfo {
-	b := newBreakInfo(i.Type.Hardness(), func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
-	}, pickaxeEffective, silkTouchOneOf(item.RawIron{}, i))
+	b := newBreakInfo(i.Type.Hardness(), pickaxeHarvestableTier(item.ToolTierStone), pickaxeEffective, oreDrops(item.RawIron{}, i, 1, 1))
 	if i.Type == DeepslateOre() {
 		b = b.withBlastResistance(9)
 	}
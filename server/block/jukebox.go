@@ -54,7 +54,7 @@ func (j Jukebox) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User
 		w.PlaySound(pos.Vec3Centre(), sound.MusicDiscEnd{})
 	} else if held, _ := u.HeldItems(); !held.Empty() {
 		if m, ok := held.Item().(item.MusicDisc); ok {
-			j.Item = held
+			j.Item = held.Grow(1 - held.Count())
 
 			w.SetBlock(pos, j, nil)
 			w.PlaySound(pos.Vec3Centre(), sound.MusicDiscEnd{})
@@ -79,6 +79,48 @@ func (j Jukebox) Disc() (sound.DiscType, bool) {
 	return sound.DiscType{}, false
 }
 
+// ComparatorSignal returns a signal that depends on the music disc played by the jukebox, ranging from 1 for
+// the disc "13" to 15 for the disc "5". If the jukebox holds no disc, 0 is returned.
+func (j Jukebox) ComparatorSignal(cube.Pos, *world.World) int {
+	d, ok := j.Disc()
+	if !ok {
+		return 0
+	}
+	switch d {
+	case sound.Disc13():
+		return 1
+	case sound.DiscCat():
+		return 2
+	case sound.DiscBlocks():
+		return 3
+	case sound.DiscChirp():
+		return 4
+	case sound.DiscFar():
+		return 5
+	case sound.DiscMall():
+		return 6
+	case sound.DiscMellohi():
+		return 7
+	case sound.DiscStal():
+		return 8
+	case sound.DiscStrad():
+		return 9
+	case sound.DiscWard():
+		return 10
+	case sound.Disc11():
+		return 11
+	case sound.DiscWait():
+		return 12
+	case sound.DiscPigstep():
+		return 13
+	case sound.DiscOtherside(), sound.DiscRelic():
+		return 14
+	case sound.Disc5():
+		return 15
+	}
+	panic("should never happen")
+}
+
 // EncodeNBT ...
 func (j Jukebox) EncodeNBT() map[string]any {
 	m := map[string]any{"id": "Jukebox"}
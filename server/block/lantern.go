@@ -28,16 +28,11 @@ func (l Lantern) Model() world.BlockModel {
 func (l Lantern) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	if l.Hanging {
 		up := pos.Side(cube.FaceUp)
-		if _, ok := w.Block(up).(Chain); !ok && !w.Block(up).Model().FaceSolid(up, cube.FaceDown, w) {
-			w.SetBlock(pos, nil, nil)
-			dropItem(w, item.NewStack(l, 1), pos.Vec3Centre())
-		}
-	} else {
-		down := pos.Side(cube.FaceDown)
-		if !w.Block(down).Model().FaceSolid(down, cube.FaceUp, w) {
-			w.SetBlock(pos, nil, nil)
-			dropItem(w, item.NewStack(l, 1), pos.Vec3Centre())
+		if _, ok := w.Block(up).(Chain); !ok && !supportedBy(pos, cube.FaceUp, w) {
+			breakUnsupported(pos, l, item.NewStack(l, 1), w)
 		}
+	} else if !supportedBy(pos, cube.FaceDown, w) {
+		breakUnsupported(pos, l, item.NewStack(l, 1), w)
 	}
 }
 
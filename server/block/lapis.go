@@ -11,9 +11,7 @@ type Lapis struct {
 
 // BreakInfo ...
 func (l Lapis) BreakInfo() BreakInfo {
-	return newBreakInfo(3, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
-	}, pickaxeEffective, oneOf(l))
+	return newBreakInfo(3, pickaxeHarvestableTier(item.ToolTierStone), pickaxeEffective, oneOf(l))
 }
 
 // EncodeItem ...
@@ -50,6 +50,9 @@ func (l Lava) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
 
 // RandomTick ...
 func (l Lava) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if !w.FireTick() {
+		return
+	}
 	i := r.Intn(3)
 	if i > 0 {
 		for j := 0; j < i; j++ {
@@ -66,7 +66,12 @@ func (l Leaves) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
 			l.ShouldUpdate = false
 			w.SetBlock(pos, l, nil)
 		} else {
+			// No log is within range of the leaves anymore, so they decay and drop what they would drop when
+			// broken by hand.
 			w.SetBlock(pos, nil, nil)
+			for _, drop := range l.drops(0) {
+				dropItem(w, drop, pos.Vec3Centre())
+			}
 		}
 	}
 }
@@ -92,15 +97,31 @@ func (l Leaves) BreakInfo() BreakInfo {
 		if t.ToolType() == item.TypeShears || hasSilkTouch(enchantments) {
 			return []item.Stack{item.NewStack(l, 1)}
 		}
-		var drops []item.Stack
-		if (l.Wood == OakWood() || l.Wood == DarkOakWood()) && rand.Float64() < 0.005 {
-			drops = append(drops, item.NewStack(item.Apple{}, 1))
-		}
-		// TODO: Saplings and sticks can drop along with apples
-		return drops
+		return l.drops(fortuneLevel(enchantments))
 	})
 }
 
+// drops returns the items dropped by the leaves when they are broken without shears or silk touch, or when
+// they decay. Leaves have a chance to drop a sapling of their wood type, sticks and, for oak and dark oak
+// leaves, an apple. These chances increase with the fortune level passed.
+func (l Leaves) drops(fortune int) []item.Stack {
+	var drops []item.Stack
+	saplingChance := fortuneChance(fortune, 1.0/20, 1.0/16, 1.0/12, 1.0/10)
+	if l.Wood == JungleWood() {
+		saplingChance = fortuneChance(fortune, 1.0/40, 1.0/36, 1.0/32, 1.0/24)
+	}
+	if saplingWood(l.Wood) && rand.Float64() < saplingChance {
+		drops = append(drops, item.NewStack(Sapling{Wood: l.Wood}, 1))
+	}
+	if rand.Float64() < fortuneChance(fortune, 1.0/50, 1.0/45, 1.0/40, 1.0/30) {
+		drops = append(drops, item.NewStack(item.Stick{}, rand.Intn(2)+1))
+	}
+	if (l.Wood == OakWood() || l.Wood == DarkOakWood()) && rand.Float64() < fortuneChance(fortune, 1.0/200, 1.0/180, 1.0/160, 1.0/120) {
+		drops = append(drops, item.NewStack(item.Apple{}, 1))
+	}
+	return drops
+}
+
 // CompostChance ...
 func (Leaves) CompostChance() float64 {
 	return 0.3
@@ -6,15 +6,18 @@ import (
 	"github.com/df-mc/dragonfly/server/block/model"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
 	"time"
 )
 
 // Lectern is a librarian's job site block found in villages. It is used to hold books for multiple players to read in
-// multiplayer.
-// TODO: Redstone functionality.
+// multiplayer. A lectern emits a short redstone pulse when a book is placed on it or when a page is turned, and a
+// comparator measuring it outputs a signal depending on the page that is open.
 type Lectern struct {
 	bass
 	sourceWaterDisplacer
@@ -25,6 +28,9 @@ type Lectern struct {
 	Book item.Stack
 	// Page is the page the Lectern is currently on in the book.
 	Page int
+	// Powered specifies if the Lectern is currently emitting a redstone pulse because a book was placed on it or
+	// a page was turned.
+	Powered bool
 }
 
 // Model ...
@@ -84,26 +90,35 @@ func (l Lectern) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User
 		return false
 	}
 
-	l.Book, l.Page = held, 0
-	w.SetBlock(pos, l, nil)
+	l.Book, l.Page = held.Grow(1-held.Count()), 0
+	l.pulse(pos, w)
 
 	w.PlaySound(pos.Vec3Centre(), sound.LecternBookPlace{})
 	ctx.SubtractFromCount(1)
 	return true
 }
 
-// Punch ...
-func (l Lectern) Punch(pos cube.Pos, _ cube.Face, w *world.World, _ item.User) {
+// Punch removes the book from the lectern. The book is added to the inventory of the user that punched the lectern,
+// or dropped if it does not fit.
+func (l Lectern) Punch(pos cube.Pos, _ cube.Face, w *world.World, u item.User) {
 	if l.Book.Empty() {
 		// We can't remove a book from the lectern if there isn't one.
 		return
 	}
 
-	dropItem(w, l.Book, pos.Side(cube.FaceUp).Vec3Middle())
+	book := l.Book
+	if holder, ok := u.(interface{ Inventory() *inventory.Inventory }); ok {
+		n, _ := holder.Inventory().AddItem(book)
+		book = book.Grow(-n)
+	}
+	if !book.Empty() {
+		dropItem(w, book, pos.Side(cube.FaceUp).Vec3Middle())
+	}
 
-	l.Book = item.Stack{}
+	l.Book, l.Page = item.Stack{}, 0
 	w.SetBlock(pos, l, nil)
 	w.PlaySound(pos.Vec3Centre(), sound.Attack{})
+	UpdateRedstone(pos, w)
 }
 
 // TurnPage updates the page the lectern is currently on to the page given.
@@ -119,10 +134,59 @@ func (l Lectern) TurnPage(pos cube.Pos, w *world.World, page int) error {
 		return fmt.Errorf("page number %d is out of bounds", page)
 	}
 	l.Page = page
-	w.SetBlock(pos, l, nil)
+	l.pulse(pos, w)
 	return nil
 }
 
+// pulse stores the lectern at the position passed and makes it emit a redstone pulse. The pulse ends after two
+// redstone ticks.
+func (l Lectern) pulse(pos cube.Pos, w *world.World) {
+	l.Powered = true
+	w.SetBlock(pos, l, nil)
+	UpdateRedstone(pos, w)
+	w.ScheduleBlockUpdate(pos, time.Millisecond*200)
+}
+
+// ScheduledTick ...
+func (l Lectern) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if !l.Powered {
+		return
+	}
+	l.Powered = false
+	w.SetBlock(pos, l, nil)
+	UpdateRedstone(pos, w)
+}
+
+// WeakPower ...
+func (l Lectern) WeakPower(cube.Pos, cube.Face, *world.World, bool) int {
+	if l.Powered {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (l Lectern) StrongPower(_ cube.Pos, face cube.Face, _ *world.World, _ bool) int {
+	if l.Powered && face == cube.FaceDown {
+		return 15
+	}
+	return 0
+}
+
+// ComparatorSignal returns a signal depending on how far the book on the lectern is opened, ranging from 1 on the
+// first page to 15 on the last page. If the lectern has no book, 0 is returned.
+func (l Lectern) ComparatorSignal(cube.Pos, *world.World) int {
+	r, ok := l.Book.Item().(readableBook)
+	if !ok {
+		return 0
+	}
+	progress := 1.0
+	if pages := r.TotalPages(); pages > 1 {
+		progress = float64(l.Page) / float64(pages-1)
+	}
+	return int(math.Floor(progress*14)) + 1
+}
+
 // EncodeNBT ...
 func (l Lectern) EncodeNBT() map[string]any {
 	m := map[string]any{
@@ -153,7 +217,7 @@ func (Lectern) EncodeItem() (name string, meta int16) {
 func (l Lectern) EncodeBlock() (string, map[string]any) {
 	return "minecraft:lectern", map[string]any{
 		"minecraft:cardinal_direction": l.Facing.String(),
-		"powered_bit":                  uint8(0), // We don't support redstone, anyway.
+		"powered_bit":                  boolByte(l.Powered),
 	}
 }
 
@@ -161,6 +225,7 @@ func (l Lectern) EncodeBlock() (string, map[string]any) {
 func allLecterns() (lecterns []world.Block) {
 	for _, f := range cube.HorizontalFaces() {
 		lecterns = append(lecterns, Lectern{Facing: f})
+		lecterns = append(lecterns, Lectern{Facing: f, Powered: true})
 	}
 	return
 }
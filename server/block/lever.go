@@ -0,0 +1,131 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Lever is a redstone power source that may be switched on and off by using it. A powered lever strongly powers
+// the block it is attached to.
+type Lever struct {
+	empty
+	transparent
+	flowingWaterDisplacer
+
+	// Powered is true if the lever is switched on and emits redstone power.
+	Powered bool
+	// Facing is the face of the block that the lever is attached to.
+	Facing cube.Face
+	// Direction is the direction that the lever points towards when it is switched off. It is only used for
+	// levers attached to the top or bottom face of a block, and only the axis of the direction is relevant.
+	Direction cube.Direction
+}
+
+// BreakInfo ...
+func (l Lever) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, nothingEffective, oneOf(Lever{})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// HasLiquidDrops ...
+func (Lever) HasLiquidDrops() bool {
+	return true
+}
+
+// WeakPower ...
+func (l Lever) WeakPower(cube.Pos, cube.Face, *world.World, bool) int {
+	if l.Powered {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (l Lever) StrongPower(_ cube.Pos, face cube.Face, _ *world.World, _ bool) int {
+	if l.Powered && face == l.Facing.Opposite() {
+		return 15
+	}
+	return 0
+}
+
+// Activate ...
+func (l Lever) Activate(pos cube.Pos, _ cube.Face, w *world.World, _ item.User, _ *item.UseContext) bool {
+	l.Powered = !l.Powered
+	w.SetBlock(pos, l, nil)
+	if l.Powered {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+	} else {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+	}
+	UpdateRedstone(pos, w)
+	return true
+}
+
+// UseOnBlock ...
+func (l Lever) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, face, used := firstReplaceable(w, pos, face, l)
+	if !used {
+		return false
+	}
+	if !attachedToSolid(pos, face, w) {
+		return false
+	}
+	l.Facing, l.Powered = face, false
+	l.Direction = user.Rotation().Direction()
+
+	place(w, pos, l, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (l Lever) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !attachedToSolid(pos, l.Facing, w) {
+		breakUnsupported(pos, l, item.NewStack(Lever{}, 1), w)
+		UpdateRedstone(pos, w)
+	}
+}
+
+// EncodeItem ...
+func (Lever) EncodeItem() (name string, meta int16) {
+	return "minecraft:lever", 0
+}
+
+// EncodeBlock ...
+func (l Lever) EncodeBlock() (string, map[string]any) {
+	direction := l.Facing.String()
+	switch l.Facing {
+	case cube.FaceDown, cube.FaceUp:
+		if l.Direction.Face().Axis() == cube.X {
+			direction += "_east_west"
+		} else {
+			direction += "_north_south"
+		}
+	}
+	return "minecraft:lever", map[string]any{"lever_direction": direction, "open_bit": boolByte(l.Powered)}
+}
+
+// allLevers ...
+func allLevers() (levers []world.Block) {
+	for _, f := range cube.Faces() {
+		directions := []cube.Direction{cube.North}
+		if f == cube.FaceDown || f == cube.FaceUp {
+			directions = append(directions, cube.East)
+		}
+		for _, d := range directions {
+			levers = append(levers, Lever{Facing: f, Direction: d})
+			levers = append(levers, Lever{Facing: f, Direction: d, Powered: true})
+		}
+	}
+	return
+}
+
+// attachedToSolid checks if a block at the position passed facing the face passed is attached to a solid face of
+// the block behind it, such as for levers and buttons.
+func attachedToSolid(pos cube.Pos, face cube.Face, w *world.World) bool {
+	behind := pos.Side(face.Opposite())
+	return w.Block(behind).Model().FaceSolid(behind, face, w)
+}
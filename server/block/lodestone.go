@@ -0,0 +1,45 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+)
+
+// Lodestone is a block that compasses may be bound to by using them on it. A compass bound to a lodestone
+// becomes an item.LodestoneCompass, which points towards the lodestone.
+type Lodestone struct {
+	solid
+}
+
+// Activate ...
+func (Lodestone) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	switch held.Item().(type) {
+	case item.Compass, item.LodestoneCompass:
+	default:
+		return false
+	}
+	compass := item.LodestoneCompass{Pos: pos, Dimension: w.Dimension()}
+	w.PlaySound(pos.Vec3Centre(), sound.LodestoneCompassLink{})
+
+	ctx.SubtractFromCount(1)
+	ctx.NewItem = item.NewStack(compass, 1).WithCustomName(held.CustomName()).WithLore(held.Lore()...).WithEnchantments(held.Enchantments()...)
+	return true
+}
+
+// BreakInfo ...
+func (l Lodestone) BreakInfo() BreakInfo {
+	return newBreakInfo(3.5, pickaxeHarvestable, pickaxeEffective, oneOf(l))
+}
+
+// EncodeItem ...
+func (Lodestone) EncodeItem() (name string, meta int16) {
+	return "minecraft:lodestone", 0
+}
+
+// EncodeBlock ...
+func (Lodestone) EncodeBlock() (string, map[string]any) {
+	return "minecraft:lodestone", nil
+}
@@ -2,7 +2,6 @@ package block
 
 import (
 	"github.com/df-mc/dragonfly/server/item"
-	"math/rand"
 )
 
 // Melon is a fruit block that grows from melon stems.
@@ -12,7 +11,7 @@ type Melon struct {
 
 // BreakInfo ...
 func (m Melon) BreakInfo() BreakInfo {
-	return newBreakInfo(1, alwaysHarvestable, axeEffective, silkTouchDrop(item.NewStack(item.MelonSlice{}, rand.Intn(5)+3), item.NewStack(m, 1)))
+	return newBreakInfo(1, alwaysHarvestable, axeEffective, fortuneBonusDrops(item.MelonSlice{}, m, 3, 7, 9))
 }
 
 // CompostChance ...
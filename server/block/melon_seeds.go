@@ -38,7 +38,7 @@ func (m MelonSeeds) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 
 // RandomTick ...
 func (m MelonSeeds) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if r.Float64() <= m.CalculateGrowthChance(pos, w) && w.Light(pos) >= 8 {
+	if r.Float64() <= m.CalculateGrowthChance(pos, w) && m.canGrow(pos, w) {
 		if m.Growth < 7 {
 			m.Growth++
 			w.SetBlock(pos, m, nil)
@@ -0,0 +1,242 @@
+package block
+
+import (
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// MobSpawner is a block that spawns entities of a specific type around it while a player is nearby. The
+// entity spawned may be changed by using a spawn egg on the spawner. A MobSpawner should be created using
+// NewMobSpawner, so that its delays, counts and ranges are set to their defaults.
+type MobSpawner struct {
+	transparent
+	sourceWaterDisplacer
+
+	// EntityType is the type of the entities spawned by the spawner. If nil, the spawner does not spawn any
+	// entities. EntityType must implement world.SaveableEntityType for entities to be spawned.
+	EntityType world.EntityType
+	// MinSpawnDelay and MaxSpawnDelay are the bounds of the random delay between two spawn attempts.
+	MinSpawnDelay, MaxSpawnDelay time.Duration
+	// SpawnCount is the maximum amount of entities spawned in a single spawn attempt.
+	SpawnCount int
+	// SpawnRange is the horizontal distance in blocks from the spawner within which entities are spawned.
+	SpawnRange int
+	// MaxNearbyEntities is the maximum amount of entities of the EntityType around the spawner. No entities
+	// are spawned while this amount is reached.
+	MaxNearbyEntities int
+	// RequiredPlayerRange is the distance in blocks within which a player must be for the spawner to be
+	// active.
+	RequiredPlayerRange int
+
+	// delay is the amount of ticks left until the next spawn attempt.
+	delay *atomic.Int64
+	// entityID is the identifier of the EntityType decoded from NBT. It is resolved using the EntityRegistry
+	// of the World the spawner is in the first time it is ticked.
+	entityID string
+}
+
+// NewMobSpawner creates a new initialised mob spawner that spawns entities of the type passed, using the
+// default delays, counts and ranges.
+func NewMobSpawner(t world.EntityType) MobSpawner {
+	return MobSpawner{
+		EntityType:          t,
+		MinSpawnDelay:       time.Second * 10,
+		MaxSpawnDelay:       time.Second * 40,
+		SpawnCount:          4,
+		SpawnRange:          4,
+		MaxNearbyEntities:   6,
+		RequiredPlayerRange: 16,
+		delay:               atomic.NewInt64(20),
+	}
+}
+
+// Model ...
+func (MobSpawner) Model() world.BlockModel {
+	return model.Solid{}
+}
+
+// Tick ...
+func (s MobSpawner) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if s.delay == nil || (s.EntityType == nil && s.entityID != "") {
+		// The spawner was created without NewMobSpawner or was decoded from NBT, so it must be initialised.
+		if s.delay == nil {
+			s.delay = atomic.NewInt64(s.nextDelay())
+		}
+		if s.EntityType == nil {
+			s.EntityType, _ = w.EntityRegistry().Lookup(s.entityID)
+		}
+		s.entityID = ""
+		w.SetBlock(pos, s, nil)
+		return
+	}
+	if s.EntityType == nil || !s.playerNearby(pos, w) {
+		return
+	}
+	if s.delay.Dec() > 0 {
+		return
+	}
+	s.delay.Store(s.nextDelay())
+	s.spawn(pos, w)
+}
+
+// spawn attempts to spawn up to SpawnCount entities of the EntityType around the spawner, stopping once
+// MaxNearbyEntities entities of the type are around it.
+func (s MobSpawner) spawn(pos cube.Pos, w *world.World) {
+	r := float64(s.SpawnRange)
+	area := cube.Box(0, 0, 0, 1, 1, 1).Translate(pos.Vec3()).GrowVec3(mgl64.Vec3{r, 4, r})
+	nearby := len(w.EntitiesWithin(area, func(e world.Entity) bool {
+		return e.Type().EncodeEntity() != s.EntityType.EncodeEntity()
+	}))
+	for i := 0; i < s.SpawnCount && nearby < s.MaxNearbyEntities; i++ {
+		spawnPos := pos.Vec3Middle().Add(mgl64.Vec3{
+			(rand.Float64() - rand.Float64()) * r,
+			float64(rand.Intn(3) - 1),
+			(rand.Float64() - rand.Float64()) * r,
+		})
+		spawnPos[1] = math.Floor(spawnPos[1])
+		if !s.canSpawnAt(cube.PosFromVec3(spawnPos), w) {
+			continue
+		}
+		e, ok := world.NewEntity(s.EntityType, spawnPos, cube.Rotation{rand.Float64() * 360})
+		if !ok {
+			return
+		}
+		if !s.freeSpace(e, spawnPos, w) {
+			_ = e.Close()
+			continue
+		}
+		w.AddEntity(e)
+		nearby++
+	}
+}
+
+// canSpawnAt checks if the requirements for an entity of the EntityType to spawn at the position passed are
+// met. Monsters only spawn at positions with a light level of 11 or lower.
+func (s MobSpawner) canSpawnAt(pos cube.Pos, w *world.World) bool {
+	if pos.OutOfBounds(w.Range()) {
+		return false
+	}
+	if t, ok := s.EntityType.(world.CategorisedEntityType); ok && t.EntityCategory() == world.EntityCategoryMonster {
+		return w.Light(pos) <= 11
+	}
+	return true
+}
+
+// freeSpace checks if the Entity passed would not collide with any blocks at the position passed.
+func (s MobSpawner) freeSpace(e world.Entity, pos mgl64.Vec3, w *world.World) bool {
+	box := e.Type().BBox(e).Translate(pos)
+	minPos, maxPos := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+	for x := minPos[0]; x <= maxPos[0]; x++ {
+		for y := minPos[1]; y <= maxPos[1]; y++ {
+			for z := minPos[2]; z <= maxPos[2]; z++ {
+				bpos := cube.Pos{x, y, z}
+				for _, bb := range w.Block(bpos).Model().BBox(bpos, w) {
+					if bb.Translate(bpos.Vec3()).IntersectsWith(box) {
+						return false
+					}
+				}
+			}
+		}
+	}
+	return true
+}
+
+// playerNearby checks if a player is within the RequiredPlayerRange of the spawner.
+func (s MobSpawner) playerNearby(pos cube.Pos, w *world.World) bool {
+	centre, r := pos.Vec3Centre(), float64(s.RequiredPlayerRange)
+	area := cube.Box(0, 0, 0, 1, 1, 1).Translate(pos.Vec3()).Grow(r)
+	for _, e := range w.EntitiesWithin(area, nil) {
+		if e.Type().EncodeEntity() == "minecraft:player" && e.Position().Sub(centre).Len() <= r {
+			return true
+		}
+	}
+	return false
+}
+
+// nextDelay returns a random delay in ticks between MinSpawnDelay and MaxSpawnDelay.
+func (s MobSpawner) nextDelay() int64 {
+	minDelay, maxDelay := s.MinSpawnDelay.Milliseconds()/50, s.MaxSpawnDelay.Milliseconds()/50
+	if maxDelay <= minDelay {
+		if minDelay < 1 {
+			return 1
+		}
+		return minDelay
+	}
+	return minDelay + rand.Int63n(maxDelay-minDelay+1)
+}
+
+// Activate ...
+func (s MobSpawner) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	egg, ok := held.Item().(item.SpawnEgg)
+	if !ok {
+		return false
+	}
+	s.EntityType, s.entityID = egg.Type, ""
+	w.SetBlock(pos, s, nil)
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// BreakInfo ...
+func (s MobSpawner) BreakInfo() BreakInfo {
+	return newBreakInfo(5, pickaxeHarvestable, pickaxeEffective, simpleDrops()).withXPDropRange(15, 43)
+}
+
+// EncodeItem ...
+func (MobSpawner) EncodeItem() (name string, meta int16) {
+	return "minecraft:mob_spawner", 0
+}
+
+// EncodeBlock ...
+func (MobSpawner) EncodeBlock() (string, map[string]any) {
+	return "minecraft:mob_spawner", nil
+}
+
+// EncodeNBT ...
+func (s MobSpawner) EncodeNBT() map[string]any {
+	m := map[string]any{
+		"id":                  "MobSpawner",
+		"MinSpawnDelay":       int16(s.MinSpawnDelay.Milliseconds() / 50),
+		"MaxSpawnDelay":       int16(s.MaxSpawnDelay.Milliseconds() / 50),
+		"SpawnCount":          int16(s.SpawnCount),
+		"SpawnRange":          int16(s.SpawnRange),
+		"MaxNearbyEntities":   int16(s.MaxNearbyEntities),
+		"RequiredPlayerRange": int16(s.RequiredPlayerRange),
+		"EntityIdentifier":    s.entityID,
+	}
+	if s.EntityType != nil {
+		m["EntityIdentifier"] = s.EntityType.EncodeEntity()
+	}
+	if s.delay != nil {
+		m["Delay"] = int16(s.delay.Load())
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (s MobSpawner) DecodeNBT(data map[string]any) any {
+	//noinspection GoAssignmentToReceiver
+	s = NewMobSpawner(nil)
+	s.entityID = nbtconv.String(data, "EntityIdentifier")
+	if _, ok := data["MinSpawnDelay"]; ok {
+		s.MinSpawnDelay = nbtconv.TickDuration[int16](data, "MinSpawnDelay")
+		s.MaxSpawnDelay = nbtconv.TickDuration[int16](data, "MaxSpawnDelay")
+		s.SpawnCount = int(nbtconv.Int16(data, "SpawnCount"))
+		s.SpawnRange = int(nbtconv.Int16(data, "SpawnRange"))
+		s.MaxNearbyEntities = int(nbtconv.Int16(data, "MaxNearbyEntities"))
+		s.RequiredPlayerRange = int(nbtconv.Int16(data, "RequiredPlayerRange"))
+	}
+	if _, ok := data["Delay"]; ok {
+		s.delay.Store(int64(nbtconv.Int16(data, "Delay")))
+	}
+	return s
+}
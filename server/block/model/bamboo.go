@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Bamboo is the model for a bamboo stalk. Its collision box is a thin column in the centre of the block, no matter
+// the thickness of the stalk.
+type Bamboo struct{}
+
+// BBox returns a physics.BBox that is only 3/16th of a block wide.
+func (Bamboo) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{cube.Box(0.40625, 0, 0.40625, 0.59375, 1, 0.59375)}
+}
+
+// FaceSolid always returns false.
+func (Bamboo) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Bed is a model used for beds. It is slightly higher than half a block.
+type Bed struct{}
+
+// BBox returns a BBox that is 0.5625 blocks high.
+func (Bed) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{cube.Box(0, 0, 0, 1, 0.5625, 1)}
+}
+
+// FaceSolid always returns false.
+func (Bed) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Bell is a model used by bells.
+type Bell struct {
+	// Standing specifies if the bell is standing on the ground, in which case its frame reaches the ground.
+	Standing bool
+}
+
+// BBox ...
+func (b Bell) BBox(cube.Pos, *world.World) []cube.BBox {
+	if b.Standing {
+		return []cube.BBox{cube.Box(0.25, 0, 0.25, 0.75, 1, 0.75)}
+	}
+	return []cube.BBox{cube.Box(0.25, 0.25, 0.25, 0.75, 1, 0.75)}
+}
+
+// FaceSolid always returns false.
+func (Bell) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Diode is a model used by redstone repeaters and comparators, which are thin blocks with a height of 0.125.
+type Diode struct{}
+
+// BBox ...
+func (Diode) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{cube.Box(0, 0, 0, 1, 0.125, 1)}
+}
+
+// FaceSolid only returns true for the bottom face of the diode.
+func (Diode) FaceSolid(_ cube.Pos, face cube.Face, _ *world.World) bool {
+	return face == cube.FaceDown
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Piston is a model used by pistons. An extended piston only occupies the back three quarters of the block.
+type Piston struct {
+	// Facing is the face that the piston is pushing towards.
+	Facing cube.Face
+	// Extended specifies if the arm of the piston is extended.
+	Extended bool
+}
+
+// BBox ...
+func (p Piston) BBox(cube.Pos, *world.World) []cube.BBox {
+	if !p.Extended {
+		return []cube.BBox{full}
+	}
+	return []cube.BBox{full.Stretch(p.Facing.Axis(), -0.125).TranslateTowards(p.Facing, -0.125)}
+}
+
+// FaceSolid returns true for all faces of a retracted piston, and only for the back face of an extended piston.
+func (p Piston) FaceSolid(_ cube.Pos, face cube.Face, _ *world.World) bool {
+	return !p.Extended || face == p.Facing.Opposite()
+}
+
+// PistonArm is a model used by the arm of an extended piston. It consists of the head of the piston and the
+// rod connecting it to the base.
+type PistonArm struct {
+	// Facing is the face that the piston of the arm is pushing towards.
+	Facing cube.Face
+}
+
+// BBox ...
+func (p PistonArm) BBox(cube.Pos, *world.World) []cube.BBox {
+	axis := p.Facing.Axis()
+	return []cube.BBox{
+		full.Stretch(axis, -0.375).TranslateTowards(p.Facing, 0.375),
+		cube.Box(0.375, 0.375, 0.375, 0.625, 0.625, 0.625).Stretch(axis, 0.375),
+	}
+}
+
+// FaceSolid only returns true for the face of the head of the piston arm.
+func (p PistonArm) FaceSolid(_ cube.Pos, face cube.Face, _ *world.World) bool {
+	return face == p.Facing
+}
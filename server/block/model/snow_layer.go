@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// SnowLayer is a model used by snow layers, which may be stacked on top of each other up to a full block.
+type SnowLayer struct {
+	// Height is the height of the snow layer, ranging from 0 for a single layer to 7 for eight layers.
+	Height int
+}
+
+// BBox returns a BBox that is one layer lower than the snow layer itself, so that a single layer of snow has no
+// collision at all.
+func (s SnowLayer) BBox(cube.Pos, *world.World) []cube.BBox {
+	if s.Height == 0 {
+		return nil
+	}
+	return []cube.BBox{cube.Box(0, 0, 0, 1, float64(s.Height)/8, 1)}
+}
+
+// FaceSolid returns true for the bottom face, and for the top face if the snow layer is a full block.
+func (s SnowLayer) FaceSolid(_ cube.Pos, face cube.Face, _ *world.World) bool {
+	return face == cube.FaceDown || (face == cube.FaceUp && s.Height == 7)
+}
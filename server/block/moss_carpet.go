@@ -27,7 +27,7 @@ func (MossCarpet) HasLiquidDrops() bool {
 // NeighbourUpdateTick ...
 func (MossCarpet) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	if _, ok := w.Block(pos.Side(cube.FaceDown)).(Air); ok {
-		w.SetBlock(pos, nil, nil)
+		breakUnsupported(pos, MossCarpet{}, item.NewStack(MossCarpet{}, 1), w)
 	}
 }
 
@@ -0,0 +1,74 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/world"
+	"math/rand"
+)
+
+// Moving is a block that is in the process of being moved by a piston. It is shown to viewers as the block it
+// holds moving along with the arm of the piston, and is replaced with that block once the piston finishes moving.
+type Moving struct {
+	empty
+	transparent
+
+	// Moving is the block that is being moved.
+	Moving world.Block
+	// Piston is the position of the piston that is moving the block.
+	Piston cube.Pos
+}
+
+// PistonImmovable ...
+func (Moving) PistonImmovable() bool {
+	return true
+}
+
+// ScheduledTick replaces the Moving block with the block that it holds.
+func (m Moving) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	w.SetBlock(pos, m.Moving, nil)
+	if _, ok := m.Moving.(PowerSource); ok {
+		UpdateRedstone(pos, w)
+	}
+	if p, ok := m.Moving.(Powerable); ok {
+		p.RedstoneUpdate(pos, w)
+	}
+}
+
+// EncodeBlock ...
+func (Moving) EncodeBlock() (string, map[string]any) {
+	return "minecraft:moving_block", nil
+}
+
+// EncodeNBT ...
+func (m Moving) EncodeNBT() map[string]any {
+	b := m.Moving
+	if b == nil {
+		b = Air{}
+	}
+	data := map[string]any{
+		"id":               "MovingBlock",
+		"isMovable":        uint8(1),
+		"movingBlock":      nbtconv.WriteBlock(b),
+		"movingBlockExtra": nbtconv.WriteBlock(Air{}),
+		"pistonPosX":       int32(m.Piston.X()),
+		"pistonPosY":       int32(m.Piston.Y()),
+		"pistonPosZ":       int32(m.Piston.Z()),
+	}
+	if nbt, ok := b.(world.NBTer); ok {
+		data["movingEntity"] = nbt.EncodeNBT()
+	}
+	return data
+}
+
+// DecodeNBT ...
+func (m Moving) DecodeNBT(data map[string]any) any {
+	m.Moving = nbtconv.Block(data, "movingBlock")
+	if nbt, ok := m.Moving.(world.NBTer); ok {
+		if entity, ok := data["movingEntity"].(map[string]any); ok {
+			m.Moving = nbt.DecodeNBT(entity).(world.Block)
+		}
+	}
+	m.Piston = cube.Pos{int(nbtconv.Int32(data, "pistonPosX")), int(nbtconv.Int32(data, "pistonPosY")), int(nbtconv.Int32(data, "pistonPosZ"))}
+	return m
+}
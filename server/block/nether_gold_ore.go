@@ -2,7 +2,6 @@ package block
 
 import (
 	"github.com/df-mc/dragonfly/server/item"
-	"math/rand"
 )
 
 // NetherGoldOre is a variant of gold ore found exclusively in The Nether.
@@ -12,7 +11,7 @@ type NetherGoldOre struct {
 
 // BreakInfo ...
 func (n NetherGoldOre) BreakInfo() BreakInfo {
-	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, silkTouchDrop(item.NewStack(item.GoldNugget{}, rand.Intn(4)+2), item.NewStack(n, 1))).withXPDropRange(0, 1)
+	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, oreDrops(item.GoldNugget{}, n, 2, 5)).withOreXPDropRange(0, 1)
 }
 
 // SmeltInfo ...
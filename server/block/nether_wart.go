@@ -53,9 +53,9 @@ func (n NetherWart) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 
 // BreakInfo ...
 func (n NetherWart) BreakInfo() BreakInfo {
-	return newBreakInfo(0, alwaysHarvestable, nothingEffective, func(item.Tool, []item.Enchantment) []item.Stack {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, func(_ item.Tool, enchantments []item.Enchantment) []item.Stack {
 		if n.Age == 3 {
-			return []item.Stack{item.NewStack(n, rand.Intn(3)+2)}
+			return []item.Stack{item.NewStack(n, rand.Intn(3)+2+rand.Intn(fortuneLevel(enchantments)+1))}
 		}
 		return []item.Stack{item.NewStack(n, 1)}
 	})
@@ -12,9 +12,7 @@ type Netherite struct {
 
 // BreakInfo ...
 func (n Netherite) BreakInfo() BreakInfo {
-	return newBreakInfo(50, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierDiamond.HarvestLevel
-	}, pickaxeEffective, oneOf(n)).withBlastResistance(3600)
+	return newBreakInfo(50, pickaxeHarvestableTier(item.ToolTierDiamond), pickaxeEffective, oneOf(n)).withBlastResistance(3600)
 }
 
 // PowersBeacon ...
@@ -17,12 +17,16 @@ type Note struct {
 
 	// Pitch is the current pitch the note block is set to. Value ranges from 0-24.
 	Pitch int
+	// Powered specifies if the note block is currently powered by redstone. A note block plays its note once
+	// when it becomes powered.
+	Powered bool
 }
 
 // playNote ...
 func (n Note) playNote(pos cube.Pos, w *world.World) {
-	w.PlaySound(pos.Vec3(), sound.Note{Instrument: n.instrument(pos, w), Pitch: n.Pitch})
-	w.AddParticle(pos.Vec3(), particle.Note{Instrument: n.Instrument(), Pitch: n.Pitch})
+	instrument := n.instrument(pos, w)
+	w.PlaySound(pos.Vec3(), sound.Note{Instrument: instrument, Pitch: n.Pitch})
+	w.AddParticle(pos.Vec3(), particle.Note{Instrument: instrument, Pitch: n.Pitch})
 }
 
 // updateInstrument ...
@@ -38,12 +42,32 @@ func (n Note) instrument(pos cube.Pos, w *world.World) sound.Instrument {
 // DecodeNBT ...
 func (n Note) DecodeNBT(data map[string]any) any {
 	n.Pitch = int(nbtconv.Uint8(data, "note"))
+	n.Powered = nbtconv.Bool(data, "powered")
 	return n
 }
 
 // EncodeNBT ...
 func (n Note) EncodeNBT() map[string]any {
-	return map[string]any{"note": byte(n.Pitch)}
+	return map[string]any{"note": byte(n.Pitch), "powered": boolByte(n.Powered)}
+}
+
+// NeighbourUpdateTick ...
+func (n Note) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	n.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate plays the note of the note block when it becomes powered by redstone.
+func (n Note) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	powered := Powered(pos, w)
+	if powered == n.Powered {
+		return
+	}
+	if n.Powered = powered; powered {
+		if _, ok := w.Block(pos.Side(cube.FaceUp)).(Air); ok {
+			n.playNote(pos, w)
+		}
+	}
+	w.SetBlock(pos, n, &world.SetOpts{DisableBlockUpdates: true, DisableLiquidDisplacement: true})
 }
 
 // Punch ...
@@ -37,9 +37,12 @@ func (o Obsidian) EncodeBlock() (string, map[string]any) {
 	return "minecraft:obsidian", nil
 }
 
+// PistonImmovable always returns true. Obsidian cannot be moved by pistons.
+func (Obsidian) PistonImmovable() bool {
+	return true
+}
+
 // BreakInfo ...
 func (o Obsidian) BreakInfo() BreakInfo {
-	return newBreakInfo(35, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierDiamond.HarvestLevel
-	}, pickaxeEffective, oneOf(o)).withBlastResistance(6000)
+	return newBreakInfo(35, pickaxeHarvestableTier(item.ToolTierDiamond), pickaxeEffective, oneOf(o)).withBlastResistance(6000)
 }
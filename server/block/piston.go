@@ -0,0 +1,393 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// maxPistonPush is the maximum amount of blocks that a piston is able to push at once.
+const maxPistonPush = 12
+
+// pistonMoveDuration is the time it takes for a piston to fully extend or retract.
+const pistonMoveDuration = time.Millisecond * 100
+
+// Piston is a block that pushes the blocks in front of it when it receives redstone power, and retracts when it no
+// longer does. A piston pushes up to 12 blocks at once. Sticky pistons additionally pull the block in front of
+// them back when they retract.
+type Piston struct {
+	transparent
+
+	// Facing is the face that the piston pushes blocks towards.
+	Facing cube.Face
+	// Sticky specifies if the piston is a sticky piston, which pulls back the block in front of it when it
+	// retracts.
+	Sticky bool
+
+	state                  pistonState
+	progress, lastProgress float64
+}
+
+// pistonState is the state of the arm of a piston.
+type pistonState uint8
+
+const (
+	pistonRetracted pistonState = iota
+	pistonExtending
+	pistonExtended
+	pistonRetracting
+)
+
+// PistonImmovable represents a block that can never be moved by a piston, or that can only be moved under certain
+// conditions. If implemented, PistonImmovable takes precedence over the default rules that decide if a piston can
+// move a block.
+type PistonImmovable interface {
+	// PistonImmovable returns true if the block cannot be moved by a piston.
+	PistonImmovable() bool
+}
+
+// Extended returns true if the arm of the piston is extended or in the process of extending.
+func (p Piston) Extended() bool {
+	return p.state == pistonExtending || p.state == pistonExtended
+}
+
+// Model ...
+func (p Piston) Model() world.BlockModel {
+	return model.Piston{Facing: p.Facing, Extended: p.state != pistonRetracted}
+}
+
+// BreakInfo ...
+func (p Piston) BreakInfo() BreakInfo {
+	return newBreakInfo(1.5, alwaysHarvestable, pickaxeEffective, oneOf(Piston{Sticky: p.Sticky})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		if _, ok := w.Block(pos.Side(p.Facing)).(PistonArmCollision); ok {
+			w.SetBlock(pos.Side(p.Facing), nil, nil)
+		}
+	})
+}
+
+// PistonImmovable only returns false if the piston is retracted. Pistons cannot be moved while they are extended or
+// moving.
+func (p Piston) PistonImmovable() bool {
+	return p.state != pistonRetracted
+}
+
+// UseOnBlock ...
+func (p Piston) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, p)
+	if !used {
+		return false
+	}
+	p.Facing, p.state, p.progress, p.lastProgress = calculateFace(user, pos), pistonRetracted, 0, 0
+
+	place(w, pos, p, user, ctx)
+	if placed(ctx) {
+		// The piston might be placed next to a power source, in which case it should extend immediately.
+		p.update(pos, w)
+	}
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (p Piston) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	p.update(pos, w)
+}
+
+// RedstoneUpdate ...
+func (p Piston) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	p.update(pos, w)
+}
+
+// ScheduledTick advances the extending or retracting of the arm of the piston.
+func (p Piston) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	switch p.state {
+	case pistonExtending:
+		if p.lastProgress, p.progress = p.progress, p.progress+0.5; p.progress >= 1 {
+			p.state, p.progress = pistonExtended, 1
+		}
+	case pistonRetracting:
+		if p.lastProgress, p.progress = p.progress, p.progress-0.5; p.progress <= 0 {
+			p.state, p.progress = pistonRetracted, 0
+		}
+	default:
+		return
+	}
+	w.SetBlock(pos, p, &world.SetOpts{DisableBlockUpdates: true})
+	if p.state == pistonExtending || p.state == pistonRetracting {
+		w.ScheduleBlockUpdate(pos, pistonMoveDuration/2)
+		return
+	}
+	// The power of the piston might have changed while the arm was moving.
+	p.update(pos, w)
+}
+
+// update extends or retracts the piston depending on the redstone power it receives.
+func (p Piston) update(pos cube.Pos, w *world.World) {
+	head := pos.Side(p.Facing)
+	if _, ok := w.Block(head).(PistonArmCollision); !ok && p.state == pistonExtended {
+		// The arm of the piston was removed, for example by an explosion.
+		p.state, p.progress, p.lastProgress = pistonRetracted, 0, 0
+		w.SetBlock(pos, p, nil)
+	}
+
+	powered := p.powered(pos, w)
+	if powered && p.state == pistonRetracted {
+		p.push(pos, w)
+	} else if !powered && p.state == pistonExtended {
+		p.pull(pos, w)
+	}
+}
+
+// powered checks if the piston receives redstone power from any of its sides other than the side that it is
+// facing.
+func (p Piston) powered(pos cube.Pos, w *world.World) bool {
+	for _, f := range cube.Faces() {
+		if f != p.Facing && emittedPower(pos.Side(f), f.Opposite(), w, true) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// push attempts to extend the arm of the piston, moving the blocks in front of it. If the blocks in front of the
+// piston cannot be moved, the piston does not extend.
+func (p Piston) push(pos cube.Pos, w *world.World) {
+	head := pos.Side(p.Facing)
+	var moved []cube.Pos
+	for current := head; ; current = current.Side(p.Facing) {
+		if current.OutOfBounds(w.Range()) {
+			return
+		}
+		reaction := pushReactionOf(current, w)
+		if reaction == pushImmovable {
+			return
+		}
+		if reaction == pushBreak {
+			breakPushed(current, w)
+			break
+		}
+		if reaction == pushFree {
+			break
+		}
+		if len(moved) == maxPistonPush || current.Side(p.Facing).OutOfBounds(w.Range()) {
+			return
+		}
+		moved = append(moved, current)
+	}
+	// Move the blocks starting with the block furthest away from the piston, so that no block is overwritten
+	// before it is moved.
+	var sources []cube.Pos
+	for i := len(moved) - 1; i >= 0; i-- {
+		if moveBlock(moved[i], moved[i].Side(p.Facing), pos, w) {
+			sources = append(sources, moved[i])
+		}
+	}
+	w.SetBlock(head, PistonArmCollision{Facing: p.Facing, Sticky: p.Sticky}, nil)
+
+	p.state, p.progress, p.lastProgress = pistonExtending, 0, 0
+	w.SetBlock(pos, p, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.PistonExtend{})
+	w.ScheduleBlockUpdate(pos, pistonMoveDuration/2)
+	if len(sources) > 0 {
+		updateRedstone(sources, w, nil)
+	}
+}
+
+// pull retracts the arm of the piston. If the piston is sticky, the block in front of the arm is pulled back with
+// it if it can be moved.
+func (p Piston) pull(pos cube.Pos, w *world.World) {
+	head := pos.Side(p.Facing)
+	if _, ok := w.Block(head).(PistonArmCollision); ok {
+		w.SetBlock(head, nil, nil)
+	}
+	source := false
+	front := head.Side(p.Facing)
+	if p.Sticky && !front.OutOfBounds(w.Range()) && pushReactionOf(front, w) == pushMove {
+		source = moveBlock(front, head, pos, w)
+	}
+
+	p.state, p.progress, p.lastProgress = pistonRetracting, 1, 1
+	w.SetBlock(pos, p, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.PistonRetract{})
+	w.ScheduleBlockUpdate(pos, pistonMoveDuration/2)
+	if source {
+		UpdateRedstone(front, w)
+	}
+}
+
+// EncodeItem ...
+func (p Piston) EncodeItem() (name string, meta int16) {
+	if p.Sticky {
+		return "minecraft:sticky_piston", 0
+	}
+	return "minecraft:piston", 0
+}
+
+// EncodeBlock ...
+func (p Piston) EncodeBlock() (string, map[string]any) {
+	if p.Sticky {
+		return "minecraft:sticky_piston", map[string]any{"facing_direction": int32(p.Facing)}
+	}
+	return "minecraft:piston", map[string]any{"facing_direction": int32(p.Facing)}
+}
+
+// EncodeNBT ...
+func (p Piston) EncodeNBT() map[string]any {
+	return map[string]any{
+		"id":           "PistonArm",
+		"isMovable":    boolByte(p.state == pistonRetracted),
+		"Sticky":       boolByte(p.Sticky),
+		"State":        uint8(p.state),
+		"NewState":     uint8(p.state),
+		"Progress":     float32(p.progress),
+		"LastProgress": float32(p.lastProgress),
+	}
+}
+
+// DecodeNBT ...
+func (p Piston) DecodeNBT(data map[string]any) any {
+	p.state = pistonState(nbtconv.Uint8(data, "State"))
+	p.progress = float64(nbtconv.Float32(data, "Progress"))
+	p.lastProgress = float64(nbtconv.Float32(data, "LastProgress"))
+	return p
+}
+
+// allPistons ...
+func allPistons() (pistons []world.Block) {
+	for _, f := range cube.Faces() {
+		pistons = append(pistons, Piston{Facing: f})
+		pistons = append(pistons, Piston{Facing: f, Sticky: true})
+	}
+	return
+}
+
+// PistonArmCollision is the arm of an extended piston. It is removed when the piston retracts, and breaking it
+// breaks the piston too.
+type PistonArmCollision struct {
+	transparent
+
+	// Facing is the face that the piston of the arm pushes blocks towards.
+	Facing cube.Face
+	// Sticky specifies if the arm belongs to a sticky piston.
+	Sticky bool
+}
+
+// Model ...
+func (a PistonArmCollision) Model() world.BlockModel {
+	return model.PistonArm{Facing: a.Facing}
+}
+
+// BreakInfo ...
+func (a PistonArmCollision) BreakInfo() BreakInfo {
+	return newBreakInfo(1.5, alwaysHarvestable, pickaxeEffective, oneOf(Piston{Sticky: a.Sticky})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		if _, ok := w.Block(pos.Side(a.Facing.Opposite())).(Piston); ok {
+			w.SetBlock(pos.Side(a.Facing.Opposite()), nil, nil)
+		}
+	})
+}
+
+// PistonImmovable ...
+func (PistonArmCollision) PistonImmovable() bool {
+	return true
+}
+
+// NeighbourUpdateTick ...
+func (a PistonArmCollision) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if p, ok := w.Block(pos.Side(a.Facing.Opposite())).(Piston); !ok || p.Facing != a.Facing || !p.Extended() {
+		// The piston that the arm belongs to was removed.
+		w.SetBlock(pos, nil, nil)
+	}
+}
+
+// EncodeBlock ...
+func (a PistonArmCollision) EncodeBlock() (string, map[string]any) {
+	if a.Sticky {
+		return "minecraft:sticky_piston_arm_collision", map[string]any{"facing_direction": int32(a.Facing)}
+	}
+	return "minecraft:piston_arm_collision", map[string]any{"facing_direction": int32(a.Facing)}
+}
+
+// allPistonArmCollisions ...
+func allPistonArmCollisions() (arms []world.Block) {
+	for _, f := range cube.Faces() {
+		arms = append(arms, PistonArmCollision{Facing: f})
+		arms = append(arms, PistonArmCollision{Facing: f, Sticky: true})
+	}
+	return
+}
+
+// pushReaction is the way a block reacts to being pushed by a piston.
+type pushReaction uint8
+
+const (
+	// pushMove is the reaction of a block that is moved by a piston.
+	pushMove pushReaction = iota
+	// pushFree is the reaction of air and liquids, which do not prevent a piston from pushing blocks into them.
+	pushFree
+	// pushBreak is the reaction of blocks that break when pushed, such as torches and redstone wire.
+	pushBreak
+	// pushImmovable is the reaction of blocks that cannot be moved and prevent a piston from extending.
+	pushImmovable
+)
+
+// pushReactionOf returns the way the block at the position passed reacts to being pushed by a piston. Blocks that
+// implement PistonImmovable decide for themselves. Otherwise, blocks that cannot be broken and block entities cannot
+// be moved, while replaceable blocks and blocks without a collision box break.
+func pushReactionOf(pos cube.Pos, w *world.World) pushReaction {
+	b := w.Block(pos)
+	if _, ok := b.(Air); ok {
+		return pushFree
+	}
+	if _, ok := b.(world.Liquid); ok {
+		return pushFree
+	}
+	if immovable, ok := b.(PistonImmovable); ok {
+		if immovable.PistonImmovable() {
+			return pushImmovable
+		}
+		return pushMove
+	}
+	if _, ok := b.(Breakable); !ok {
+		return pushImmovable
+	}
+	if _, ok := b.(world.NBTer); ok {
+		return pushImmovable
+	}
+	if r, ok := b.(Replaceable); ok && r.ReplaceableBy(Piston{}) {
+		return pushBreak
+	}
+	if _, ok := b.(LiquidRemovable); ok || len(b.Model().BBox(pos, w)) == 0 {
+		return pushBreak
+	}
+	return pushMove
+}
+
+// breakPushed breaks the block at the position passed after it was pushed by a piston, dropping its drops.
+func breakPushed(pos cube.Pos, w *world.World) {
+	b := w.Block(pos)
+	w.SetBlock(pos, nil, nil)
+	w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	if breakable, ok := b.(Breakable); ok {
+		for _, drop := range breakable.BreakInfo().Drops(item.ToolNone{}, nil) {
+			dropItem(w, drop, pos.Vec3Centre())
+		}
+	}
+}
+
+// moveBlock moves the block at the position from to the position to by replacing it with a Moving block, which is
+// turned back into the block moved once the piston at the position passed finishes moving. moveBlock returns true
+// if the block moved is a PowerSource, in which case the redstone around its old position must be updated.
+func moveBlock(from, to, piston cube.Pos, w *world.World) bool {
+	b := w.Block(from)
+	w.SetBlock(to, Moving{Moving: b, Piston: piston}, nil)
+	w.SetBlock(from, nil, nil)
+	w.ScheduleBlockUpdate(to, pistonMoveDuration)
+	_, source := b.(PowerSource)
+	return source
+}
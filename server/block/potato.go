@@ -4,7 +4,6 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
-	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
 	"math/rand"
 	"time"
@@ -89,10 +88,9 @@ func (p Potato) EncodeItem() (name string, meta int16) {
 
 // RandomTick ...
 func (p Potato) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if w.Light(pos) < 8 {
-		w.SetBlock(pos, nil, nil)
-		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: p})
-	} else if p.Growth < 7 && r.Float64() <= p.CalculateGrowthChance(pos, w) {
+	if w.Light(pos) < cropGrowthLight-1 {
+		p.uproot(pos, w)
+	} else if p.Growth < 7 && p.canGrow(pos, w) && r.Float64() <= p.CalculateGrowthChance(pos, w) {
 		p.Growth++
 		w.SetBlock(pos, p, nil)
 	}
@@ -0,0 +1,118 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// PowderSnow is a block of loose snow that entities sink into. Entities inside powder snow slowly freeze, taking
+// damage once they are fully frozen, unless they wear leather armour. Entities wearing leather boots can walk on
+// top of powder snow. Powder snow can only be obtained using a bucket.
+type PowderSnow struct {
+	transparent
+}
+
+// powderSnowSinkSpeed is the maximum speed in blocks per tick with which entities sink through powder snow.
+const powderSnowSinkSpeed = 0.06
+
+// freezingEntity represents an entity that freezes while it is inside powder snow.
+type freezingEntity interface {
+	// Freeze freezes the entity further as a result of it being inside powder snow for another tick.
+	Freeze()
+}
+
+// velocityEntity represents an entity of which the velocity may be changed.
+type velocityEntity interface {
+	// Velocity returns the velocity of the entity.
+	Velocity() mgl64.Vec3
+	// SetVelocity changes the velocity of the entity.
+	SetVelocity(v mgl64.Vec3)
+}
+
+// Model ...
+func (PowderSnow) Model() world.BlockModel {
+	return powderSnowModel{}
+}
+
+// powderSnowModel is the model of PowderSnow. It has no collision boxes, except for entities wearing leather
+// boots that are not sneaking and stand on top of the powder snow.
+type powderSnowModel struct {
+	model.Empty
+}
+
+// EntityBBox ...
+func (powderSnowModel) EntityBBox(pos cube.Pos, _ *world.World, e world.Entity) []cube.BBox {
+	if s, ok := e.(interface{ Sneaking() bool }); ok && s.Sneaking() {
+		return nil
+	}
+	a, ok := e.(interface{ Armour() *inventory.Armour })
+	if !ok {
+		return nil
+	}
+	boots, ok := a.Armour().Boots().Item().(item.Boots)
+	if !ok {
+		return nil
+	}
+	if _, leather := boots.Tier.(item.ArmourTierLeather); !leather || e.Position()[1] < float64(pos[1]+1)-1e-5 {
+		return nil
+	}
+	return []cube.BBox{cube.Box(0, 0, 0, 1, 1, 1)}
+}
+
+// SideClosed ...
+func (PowderSnow) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// EntityInside ...
+func (p PowderSnow) EntityInside(pos cube.Pos, w *world.World, e world.Entity) {
+	if fallEntity, ok := e.(fallDistanceEntity); ok {
+		fallEntity.ResetFallDistance()
+	}
+	if flammable, ok := e.(flammableEntity); ok && flammable.OnFireDuration() > 0 {
+		// Burning entities are extinguished by powder snow, melting the powder snow in the process.
+		flammable.Extinguish()
+		w.SetBlock(pos, nil, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.FireExtinguish{})
+		return
+	}
+	if f, ok := e.(freezingEntity); ok {
+		f.Freeze()
+	}
+	if v, ok := e.(velocityEntity); ok {
+		// Entities sink slowly through powder snow, regardless of the speed they fell into it with.
+		if vel := v.Velocity(); vel[1] < -powderSnowSinkSpeed {
+			v.SetVelocity(mgl64.Vec3{vel[0], -powderSnowSinkSpeed, vel[2]})
+		}
+	}
+}
+
+// Activate ...
+func (p PowderSnow) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if b, ok := held.Item().(item.Bucket); !ok || !b.Empty() {
+		return false
+	}
+	w.SetBlock(pos, nil, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.PowderSnowBucketFill{})
+
+	ctx.NewItem = item.NewStack(item.Bucket{Content: item.PowderSnowBucketContent()}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// BreakInfo ...
+func (p PowderSnow) BreakInfo() BreakInfo {
+	return newBreakInfo(0.25, alwaysHarvestable, shovelEffective, simpleDrops())
+}
+
+// EncodeBlock ...
+func (PowderSnow) EncodeBlock() (string, map[string]any) {
+	return "minecraft:powder_snow", nil
+}
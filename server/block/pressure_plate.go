@@ -0,0 +1,164 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// PressurePlate is a redstone power source that emits power while entities are standing on it. A powered
+// pressure plate strongly powers the block below it.
+type PressurePlate struct {
+	empty
+	transparent
+	sourceWaterDisplacer
+
+	// Type is the type of the pressure plate.
+	Type PressurePlateType
+	// Power is the redstone power emitted by the pressure plate, ranging from 0 to 15.
+	Power int
+}
+
+// FuelInfo ...
+func (p PressurePlate) FuelInfo() item.FuelInfo {
+	if p.Type.wooden() && p.Type.Wood.Flammable() {
+		return newFuelInfo(time.Second * 15)
+	}
+	return item.FuelInfo{}
+}
+
+// BreakInfo ...
+func (p PressurePlate) BreakInfo() BreakInfo {
+	harvestable, effective := pickaxeHarvestable, pickaxeEffective
+	if p.Type.wooden() {
+		harvestable, effective = alwaysHarvestable, axeEffective
+	}
+	return newBreakInfo(0.5, harvestable, effective, oneOf(PressurePlate{Type: p.Type})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// HasLiquidDrops ...
+func (PressurePlate) HasLiquidDrops() bool {
+	return true
+}
+
+// WeakPower ...
+func (p PressurePlate) WeakPower(cube.Pos, cube.Face, *world.World, bool) int {
+	return p.Power
+}
+
+// StrongPower ...
+func (p PressurePlate) StrongPower(_ cube.Pos, face cube.Face, _ *world.World, _ bool) int {
+	if face == cube.FaceDown {
+		return p.Power
+	}
+	return 0
+}
+
+// EntityInside ...
+func (p PressurePlate) EntityInside(pos cube.Pos, w *world.World, _ world.Entity) {
+	if p.Power == 0 {
+		// The power of an active pressure plate is updated in ScheduledTick instead.
+		p.updatePower(pos, w)
+	}
+}
+
+// ScheduledTick ...
+func (p PressurePlate) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	p.updatePower(pos, w)
+}
+
+// updatePower updates the power of the pressure plate based on the entities on it. As long as the pressure plate
+// is powered, its power is updated again after a delay.
+func (p PressurePlate) updatePower(pos cube.Pos, w *world.World) {
+	power := p.entityPower(pos, w)
+	if power != p.Power {
+		before := p.Power
+		p.Power = power
+		w.SetBlock(pos, p, nil)
+		if before == 0 {
+			w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+		} else if power == 0 {
+			w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+		}
+		UpdateRedstone(pos, w)
+	}
+	if power > 0 {
+		delay := time.Second
+		if p.Type.weighted() {
+			delay = time.Millisecond * 500
+		}
+		w.ScheduleBlockUpdate(pos, delay)
+	}
+}
+
+// entityPower returns the power that the pressure plate should emit based on the entities on it.
+func (p PressurePlate) entityPower(pos cube.Pos, w *world.World) int {
+	box := cube.Box(0.125, 0, 0.125, 0.875, 0.25, 0.875).Translate(pos.Vec3())
+	entities := w.EntitiesWithin(box, func(e world.Entity) bool {
+		if p.Type.wooden() || p.Type.weighted() {
+			return false
+		}
+		_, living := e.(livingEntity)
+		return !living
+	})
+	switch p.Type.pressurePlate {
+	case 3:
+		return min(len(entities), 15)
+	case 4:
+		return min(int(math.Ceil(float64(len(entities))/10)), 15)
+	}
+	if len(entities) > 0 {
+		return 15
+	}
+	return 0
+}
+
+// UseOnBlock ...
+func (p PressurePlate) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, p)
+	if !used {
+		return false
+	}
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		return false
+	}
+	p.Power = 0
+
+	place(w, pos, p, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (p PressurePlate) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		breakUnsupported(pos, p, item.NewStack(PressurePlate{Type: p.Type}, 1), w)
+		UpdateRedstone(pos, w)
+	}
+}
+
+// EncodeItem ...
+func (p PressurePlate) EncodeItem() (name string, meta int16) {
+	return "minecraft:" + p.Type.String() + "_pressure_plate", 0
+}
+
+// EncodeBlock ...
+func (p PressurePlate) EncodeBlock() (string, map[string]any) {
+	return "minecraft:" + p.Type.String() + "_pressure_plate", map[string]any{"redstone_signal": int32(p.Power)}
+}
+
+// allPressurePlates ...
+func allPressurePlates() (plates []world.Block) {
+	for _, t := range PressurePlateTypes() {
+		for i := 0; i <= 15; i++ {
+			plates = append(plates, PressurePlate{Type: t, Power: i})
+		}
+	}
+	return
+}
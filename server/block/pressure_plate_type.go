@@ -0,0 +1,88 @@
+package block
+
+// PressurePlateType represents a type of pressure plate, such as a stone pressure plate or a weighted pressure
+// plate.
+type PressurePlateType struct {
+	pressurePlate
+
+	// Wood is the type of wood of the pressure plate. It is only used for wooden pressure plates.
+	Wood WoodType
+}
+
+type pressurePlate uint8
+
+// StonePressurePlate returns the stone pressure plate type. It is only activated by players and mobs.
+func StonePressurePlate() PressurePlateType {
+	return PressurePlateType{pressurePlate: 0}
+}
+
+// PolishedBlackstonePressurePlate returns the polished blackstone pressure plate type. It is only activated by
+// players and mobs.
+func PolishedBlackstonePressurePlate() PressurePlateType {
+	return PressurePlateType{pressurePlate: 1}
+}
+
+// WoodPressurePlate returns the wooden pressure plate type with the wood type passed. It is activated by any
+// entity.
+func WoodPressurePlate(w WoodType) PressurePlateType {
+	return PressurePlateType{pressurePlate: 2, Wood: w}
+}
+
+// LightWeightedPressurePlate returns the light weighted (gold) pressure plate type. Its power increases by one
+// for every entity on it.
+func LightWeightedPressurePlate() PressurePlateType {
+	return PressurePlateType{pressurePlate: 3}
+}
+
+// HeavyWeightedPressurePlate returns the heavy weighted (iron) pressure plate type. Its power increases by one
+// for every ten entities on it.
+func HeavyWeightedPressurePlate() PressurePlateType {
+	return PressurePlateType{pressurePlate: 4}
+}
+
+// PressurePlateTypes returns all pressure plate types.
+func PressurePlateTypes() []PressurePlateType {
+	types := []PressurePlateType{StonePressurePlate(), PolishedBlackstonePressurePlate(), LightWeightedPressurePlate(), HeavyWeightedPressurePlate()}
+	for _, w := range WoodTypes() {
+		types = append(types, WoodPressurePlate(w))
+	}
+	return types
+}
+
+// Uint8 returns the pressure plate type as a uint8.
+func (p PressurePlateType) Uint8() uint8 {
+	if p.wooden() {
+		return 5 + p.Wood.Uint8()
+	}
+	return uint8(p.pressurePlate)
+}
+
+// wooden checks if the pressure plate type is a wooden pressure plate type.
+func (p PressurePlateType) wooden() bool {
+	return p.pressurePlate == 2
+}
+
+// weighted checks if the pressure plate type is a weighted pressure plate type.
+func (p PressurePlateType) weighted() bool {
+	return p.pressurePlate == 3 || p.pressurePlate == 4
+}
+
+// String returns the pressure plate type as a string.
+func (p PressurePlateType) String() string {
+	switch p.pressurePlate {
+	case 0:
+		return "stone"
+	case 1:
+		return "polished_blackstone"
+	case 2:
+		if p.Wood == OakWood() {
+			return "wooden"
+		}
+		return p.Wood.String()
+	case 3:
+		return "light_weighted"
+	case 4:
+		return "heavy_weighted"
+	}
+	panic("unknown pressure plate type")
+}
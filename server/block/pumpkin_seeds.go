@@ -38,7 +38,7 @@ func (p PumpkinSeeds) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 
 // RandomTick ...
 func (p PumpkinSeeds) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if r.Float64() <= p.CalculateGrowthChance(pos, w) && w.Light(pos) >= 8 {
+	if r.Float64() <= p.CalculateGrowthChance(pos, w) && p.canGrow(pos, w) {
 		if p.Growth < 7 {
 			p.Growth++
 			w.SetBlock(pos, p, nil)
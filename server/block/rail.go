@@ -0,0 +1,172 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Rail is a block that minecarts ride on. Rails must be placed on top of a block with a solid top face and
+// automatically connect to rails next to them, forming straight tracks, curves and slopes.
+type Rail struct {
+	transparent
+	empty
+
+	// Shape is the shape of the rail, describing the directions it connects to.
+	Shape RailShape
+}
+
+// BreakInfo ...
+func (r Rail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(Rail{}))
+}
+
+// UseOnBlock ...
+func (r Rail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, r)
+	if !used {
+		return false
+	}
+	if !supportedBy(pos, cube.FaceDown, w) {
+		return false
+	}
+	r.Shape = railShape(pos, w)
+	place(w, pos, r, user, ctx)
+	if w.Block(pos) == world.Block(r) {
+		// Placing the block may have been cancelled, in which case the rails around it should not change.
+		r.connectNeighbours(pos, w)
+	}
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r Rail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	supported := supportedBy(pos, cube.FaceDown, w)
+	if face, ok := r.Shape.Ascending(); ok && supported {
+		// The block that an ascending rail slopes up to must support the rail next to it.
+		side := pos.Side(face)
+		supported = w.Block(side).Model().FaceSolid(side, cube.FaceUp, w)
+	}
+	if !supported {
+		breakUnsupported(pos, r, item.NewStack(Rail{}, 1), w)
+	}
+}
+
+// connectNeighbours reshapes the rails that the rail at the position passed connects to, so that they connect
+// back to it if one of their ends was not yet connected, or ascend towards it if they are one block lower.
+func (r Rail) connectNeighbours(pos cube.Pos, w *world.World) {
+	for _, face := range r.Shape.Faces() {
+		npos, n, ok := neighbourRail(pos, face, w)
+		if !ok {
+			continue
+		}
+		if _, ascending := n.Shape.Ascending(); n.Shape.Connects(face.Opposite()) && (ascending || npos[1] >= pos[1]) {
+			continue
+		}
+		if shape := railShape(npos, w); shape != n.Shape {
+			n.Shape = shape
+			w.SetBlock(npos, n, nil)
+		}
+	}
+}
+
+// railShape returns the shape that a rail at the position passed should have to connect to the rails around it.
+// Two opposite connections are preferred over a curve. If the rail only connects to a single rail, it runs
+// straight towards it, ascending if that rail is one block higher.
+func railShape(pos cube.Pos, w *world.World) RailShape {
+	var conn [4]bool
+	for i, face := range [...]cube.Face{cube.FaceNorth, cube.FaceSouth, cube.FaceEast, cube.FaceWest} {
+		conn[i] = railConnectable(pos, face, w)
+	}
+	n, s, e, west := conn[0], conn[1], conn[2], conn[3]
+	switch {
+	case (n || s) && !e && !west, n && s:
+		return ascendingRailShape(pos, RailNorthSouth(), w)
+	case (e || west) && !n && !s, e && west:
+		return ascendingRailShape(pos, RailEastWest(), w)
+	case s && e:
+		return RailSouthEast()
+	case s && west:
+		return RailSouthWest()
+	case n && west:
+		return RailNorthWest()
+	case n && e:
+		return RailNorthEast()
+	}
+	return RailNorthSouth()
+}
+
+// ascendingRailShape returns the ascending variant of the straight rail shape passed if the rail at the position
+// passed has a rail one block higher at one of its ends.
+func ascendingRailShape(pos cube.Pos, shape RailShape, w *world.World) RailShape {
+	for _, face := range shape.Faces() {
+		if _, ok := w.Block(pos.Side(face).Side(cube.FaceUp)).(Rail); !ok {
+			continue
+		}
+		switch face {
+		case cube.FaceNorth:
+			return RailAscendingNorth()
+		case cube.FaceSouth:
+			return RailAscendingSouth()
+		case cube.FaceEast:
+			return RailAscendingEast()
+		case cube.FaceWest:
+			return RailAscendingWest()
+		}
+	}
+	return shape
+}
+
+// railConnectable checks if a rail at the position passed can connect to a rail at the face passed. This is the
+// case if that rail already connects towards the position or if one of its ends is not yet connected.
+func railConnectable(pos cube.Pos, face cube.Face, w *world.World) bool {
+	npos, n, ok := neighbourRail(pos, face, w)
+	if !ok {
+		return false
+	}
+	if n.Shape.Connects(face.Opposite()) {
+		return true
+	}
+	for _, f := range n.Shape.Faces() {
+		if _, o, ok := neighbourRail(npos, f, w); !ok || !o.Shape.Connects(f.Opposite()) {
+			return true
+		}
+	}
+	return false
+}
+
+// neighbourRail returns the rail next to the position passed at the face passed. Rails one block higher or lower
+// are also returned, as rails may ascend towards them.
+func neighbourRail(pos cube.Pos, face cube.Face, w *world.World) (cube.Pos, Rail, bool) {
+	side := pos.Side(face)
+	for _, p := range [...]cube.Pos{side, side.Side(cube.FaceUp), side.Side(cube.FaceDown)} {
+		if r, ok := w.Block(p).(Rail); ok {
+			return p, r, true
+		}
+	}
+	return cube.Pos{}, Rail{}, false
+}
+
+// HasLiquidDrops ...
+func (r Rail) HasLiquidDrops() bool {
+	return true
+}
+
+// EncodeItem ...
+func (r Rail) EncodeItem() (name string, meta int16) {
+	return "minecraft:rail", 0
+}
+
+// EncodeBlock ...
+func (r Rail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:rail", map[string]any{"rail_direction": int32(r.Shape.Uint8())}
+}
+
+// allRails ...
+func allRails() (rails []world.Block) {
+	for _, s := range RailShapes() {
+		rails = append(rails, Rail{Shape: s})
+	}
+	return
+}
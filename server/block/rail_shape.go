@@ -0,0 +1,115 @@
+package block
+
+import "github.com/df-mc/dragonfly/server/block/cube"
+
+// RailShape is the shape of a rail. It describes the two directions that a rail connects to, and if the rail
+// ascends towards one of them.
+type RailShape struct {
+	rail
+}
+
+type rail uint8
+
+// RailNorthSouth returns the shape of a straight rail running from north to south.
+func RailNorthSouth() RailShape {
+	return RailShape{0}
+}
+
+// RailEastWest returns the shape of a straight rail running from east to west.
+func RailEastWest() RailShape {
+	return RailShape{1}
+}
+
+// RailAscendingEast returns the shape of a rail running from west to east that ascends towards the east.
+func RailAscendingEast() RailShape {
+	return RailShape{2}
+}
+
+// RailAscendingWest returns the shape of a rail running from east to west that ascends towards the west.
+func RailAscendingWest() RailShape {
+	return RailShape{3}
+}
+
+// RailAscendingNorth returns the shape of a rail running from south to north that ascends towards the north.
+func RailAscendingNorth() RailShape {
+	return RailShape{4}
+}
+
+// RailAscendingSouth returns the shape of a rail running from north to south that ascends towards the south.
+func RailAscendingSouth() RailShape {
+	return RailShape{5}
+}
+
+// RailSouthEast returns the shape of a curved rail connecting south and east.
+func RailSouthEast() RailShape {
+	return RailShape{6}
+}
+
+// RailSouthWest returns the shape of a curved rail connecting south and west.
+func RailSouthWest() RailShape {
+	return RailShape{7}
+}
+
+// RailNorthWest returns the shape of a curved rail connecting north and west.
+func RailNorthWest() RailShape {
+	return RailShape{8}
+}
+
+// RailNorthEast returns the shape of a curved rail connecting north and east.
+func RailNorthEast() RailShape {
+	return RailShape{9}
+}
+
+// RailShapes returns all rail shapes.
+func RailShapes() []RailShape {
+	return []RailShape{RailNorthSouth(), RailEastWest(), RailAscendingEast(), RailAscendingWest(), RailAscendingNorth(), RailAscendingSouth(), RailSouthEast(), RailSouthWest(), RailNorthWest(), RailNorthEast()}
+}
+
+// Uint8 returns the rail shape as a uint8.
+func (r rail) Uint8() uint8 {
+	return uint8(r)
+}
+
+// Faces returns the two horizontal faces that a rail with the shape connects to.
+func (r rail) Faces() [2]cube.Face {
+	switch r {
+	case 1, 2, 3:
+		return [2]cube.Face{cube.FaceEast, cube.FaceWest}
+	case 6:
+		return [2]cube.Face{cube.FaceSouth, cube.FaceEast}
+	case 7:
+		return [2]cube.Face{cube.FaceSouth, cube.FaceWest}
+	case 8:
+		return [2]cube.Face{cube.FaceNorth, cube.FaceWest}
+	case 9:
+		return [2]cube.Face{cube.FaceNorth, cube.FaceEast}
+	}
+	return [2]cube.Face{cube.FaceNorth, cube.FaceSouth}
+}
+
+// Ascending returns the face towards which a rail with the shape ascends. False is returned if the shape is not
+// ascending.
+func (r rail) Ascending() (cube.Face, bool) {
+	switch r {
+	case 2:
+		return cube.FaceEast, true
+	case 3:
+		return cube.FaceWest, true
+	case 4:
+		return cube.FaceNorth, true
+	case 5:
+		return cube.FaceSouth, true
+	}
+	return 0, false
+}
+
+// Curved checks if the rail shape is a curve rather than a straight line.
+func (r rail) Curved() bool {
+	return r >= 6
+}
+
+// Connects checks if a rail with the shape connects to the face passed.
+func (r rail) Connects(face cube.Face) bool {
+	f := r.Faces()
+	return f[0] == face || f[1] == face
+}
@@ -12,9 +12,7 @@ type RawGold struct {
 
 // BreakInfo ...
 func (g RawGold) BreakInfo() BreakInfo {
-	return newBreakInfo(5, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierIron.HarvestLevel
-	}, pickaxeEffective, oneOf(g)).withBlastResistance(30)
+	return newBreakInfo(5, pickaxeHarvestableTier(item.ToolTierIron), pickaxeEffective, oneOf(g)).withBlastResistance(30)
 }
 
 // EncodeItem ...
@@ -12,9 +12,7 @@ type RawIron struct {
 
 // BreakInfo ...
 func (r RawIron) BreakInfo() BreakInfo {
-	return newBreakInfo(5, func(t item.Tool) bool {
-		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
-	}, pickaxeEffective, oneOf(r)).withBlastResistance(30)
+	return newBreakInfo(5, pickaxeHarvestableTier(item.ToolTierStone), pickaxeEffective, oneOf(r)).withBlastResistance(30)
 }
 
 // EncodeItem ...
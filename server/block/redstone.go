@@ -0,0 +1,129 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// PowerSource represents a block that emits redstone power, such as a lever or redstone wire. Power levels range
+// from 0, meaning the block emits no power, to 15.
+//
+// A block may emit power in two ways: Weak power is only received by the block directly next to the source,
+// while strong power is conducted by a solid block, so that the blocks around that solid block are powered too.
+// Blocks powered by redstone wire only pass power on to components, not to other redstone wire. To achieve this,
+// the dust parameter is false when the power is calculated for redstone wire, in which case redstone wire should
+// report no power.
+type PowerSource interface {
+	// WeakPower returns the power that the block at pos emits into the block on its face side.
+	WeakPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) int
+	// StrongPower returns the power that the block at pos emits into the block on its face side, which is
+	// conducted to the blocks around it if that block is solid.
+	StrongPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) int
+}
+
+// Powerable represents a block that reacts to changes in the redstone power it receives, such as a hopper, which
+// is locked while powered. ReceivedPower may be used to find out how much power a block receives.
+type Powerable interface {
+	// RedstoneUpdate is called when the redstone power received by the block at pos may have changed.
+	RedstoneUpdate(pos cube.Pos, w *world.World)
+}
+
+// ReceivedPower returns the highest redstone power level that the block at the position passed receives from any
+// of the blocks around it. The value returned is between 0 and 15.
+func ReceivedPower(pos cube.Pos, w *world.World) int {
+	return receivedPower(pos, w, true)
+}
+
+// Powered checks if the block at the position passed receives any redstone power.
+func Powered(pos cube.Pos, w *world.World) bool {
+	return ReceivedPower(pos, w) > 0
+}
+
+// UpdateRedstone notifies all Powerable blocks that may be affected by a change in the power emitted by the block
+// at the position passed. These are the blocks directly next to it and the blocks around those, which may be
+// powered through a solid block. UpdateRedstone must be called by a PowerSource whenever the power it emits
+// changes.
+func UpdateRedstone(pos cube.Pos, w *world.World) {
+	updateRedstone([]cube.Pos{pos}, w, nil)
+}
+
+// receivedPower returns the highest power level that the block at pos receives from the blocks around it. If dust
+// is false, power from redstone wire and blocks powered by redstone wire is ignored.
+func receivedPower(pos cube.Pos, w *world.World, dust bool) (power int) {
+	for _, f := range cube.Faces() {
+		if power = max(power, emittedPower(pos.Side(f), f.Opposite(), w, dust)); power >= 15 {
+			return 15
+		}
+	}
+	return power
+}
+
+// emittedPower returns the power that the block at pos emits into the block on its face side. This is either the
+// weak power of a PowerSource, or the strong power that a solid block conducts.
+func emittedPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) (power int) {
+	if src, ok := w.Block(pos).(PowerSource); ok {
+		return src.WeakPower(pos, face, w, dust)
+	}
+	if !conductsRedstone(pos, w) {
+		return 0
+	}
+	for _, f := range cube.Faces() {
+		if f == face {
+			continue
+		}
+		side := pos.Side(f)
+		if src, ok := w.Block(side).(PowerSource); ok {
+			power = max(power, src.StrongPower(side, f.Opposite(), w, dust))
+		}
+	}
+	return power
+}
+
+// conductsRedstone checks if the block at the position passed conducts strong redstone power. Only opaque blocks
+// that are solid on all sides, such as stone, conduct power.
+func conductsRedstone(pos cube.Pos, w *world.World) bool {
+	b := w.Block(pos)
+	if _, ok := b.(PowerSource); ok {
+		return false
+	}
+	if diffuser, ok := b.(LightDiffuser); ok && diffuser.LightDiffusionLevel() != 15 {
+		return false
+	}
+	m := b.Model()
+	for _, f := range cube.Faces() {
+		if !m.FaceSolid(pos, f, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// updateRedstone calls RedstoneUpdate on all Powerable blocks within two blocks of any of the positions passed,
+// except for the positions for which skip returns true. Every block is updated at most once.
+func updateRedstone(positions []cube.Pos, w *world.World, skip func(pos cube.Pos) bool) {
+	updated := make(map[cube.Pos]struct{}, len(positions)*25)
+	update := func(pos cube.Pos) {
+		if _, ok := updated[pos]; ok || pos.OutOfBounds(w.Range()) {
+			return
+		}
+		updated[pos] = struct{}{}
+		if skip != nil && skip(pos) {
+			return
+		}
+		if p, ok := w.Block(pos).(Powerable); ok {
+			p.RedstoneUpdate(pos, w)
+		}
+	}
+	for _, pos := range positions {
+		updated[pos] = struct{}{}
+	}
+	for _, pos := range positions {
+		for _, f := range cube.Faces() {
+			side := pos.Side(f)
+			update(side)
+			for _, f2 := range cube.Faces() {
+				update(side.Side(f2))
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// RedstoneBlock is a mineral block that is a permanent redstone power source. Unlike levers and buttons, it
+// does not strongly power the blocks around it.
+type RedstoneBlock struct {
+	solid
+}
+
+// BreakInfo ...
+func (r RedstoneBlock) BreakInfo() BreakInfo {
+	return newBreakInfo(5, pickaxeHarvestable, pickaxeEffective, oneOf(r)).withBlastResistance(30).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// WeakPower ...
+func (RedstoneBlock) WeakPower(cube.Pos, cube.Face, *world.World, bool) int {
+	return 15
+}
+
+// StrongPower ...
+func (RedstoneBlock) StrongPower(cube.Pos, cube.Face, *world.World, bool) int {
+	return 0
+}
+
+// EncodeItem ...
+func (RedstoneBlock) EncodeItem() (name string, meta int16) {
+	return "minecraft:redstone_block", 0
+}
+
+// EncodeBlock ...
+func (RedstoneBlock) EncodeBlock() (string, map[string]any) {
+	return "minecraft:redstone_block", nil
+}
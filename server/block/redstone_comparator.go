@@ -0,0 +1,246 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// comparatorDelay is the time it takes for the output of a comparator to change after its input changed.
+const comparatorDelay = time.Millisecond * 100
+
+// ComparatorSource represents a block of which the state can be measured by a redstone comparator placed against
+// it. Containers, such as chests, are measured by a comparator without implementing ComparatorSource.
+type ComparatorSource interface {
+	// ComparatorSignal returns the power level between 0 and 15 that a comparator measuring the block at pos
+	// outputs.
+	ComparatorSignal(pos cube.Pos, w *world.World) int
+}
+
+// RedstoneComparator is a redstone component that compares the power it receives from behind with the power it
+// receives from its sides. Comparators are also able to measure the state of blocks behind them, such as the
+// fill level of a container.
+type RedstoneComparator struct {
+	transparent
+
+	// Facing is the direction that the comparator outputs power towards. The comparator receives its main input
+	// from the opposite direction.
+	Facing cube.Direction
+	// Subtract is true if the comparator is in subtract mode. In this mode, the comparator outputs the power it
+	// receives from behind minus the power it receives from its sides. Otherwise, the comparator outputs the
+	// power received from behind if it is not lower than the power received from its sides.
+	Subtract bool
+	// Powered is true if the comparator is outputting redstone power.
+	Powered bool
+	// Output is the redstone power output by the comparator, ranging from 0 to 15.
+	Output int
+}
+
+// Model ...
+func (RedstoneComparator) Model() world.BlockModel {
+	return model.Diode{}
+}
+
+// BreakInfo ...
+func (c RedstoneComparator) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(RedstoneComparator{})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// HasLiquidDrops ...
+func (RedstoneComparator) HasLiquidDrops() bool {
+	return true
+}
+
+// WeakPower ...
+func (c RedstoneComparator) WeakPower(_ cube.Pos, face cube.Face, _ *world.World, _ bool) int {
+	if face == c.Facing.Face() {
+		return c.Output
+	}
+	return 0
+}
+
+// StrongPower ...
+func (c RedstoneComparator) StrongPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) int {
+	return c.WeakPower(pos, face, w, dust)
+}
+
+// Activate toggles the subtract mode of the comparator.
+func (c RedstoneComparator) Activate(pos cube.Pos, _ cube.Face, w *world.World, _ item.User, _ *item.UseContext) bool {
+	c.Subtract = !c.Subtract
+	w.SetBlock(pos, c, nil)
+	if c.Subtract {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+	} else {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+	}
+	w.ScheduleBlockUpdate(pos, comparatorDelay)
+	return true
+}
+
+// UseOnBlock ...
+func (c RedstoneComparator) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, c)
+	if !used {
+		return false
+	}
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		return false
+	}
+	c.Facing, c.Subtract, c.Powered, c.Output = user.Rotation().Direction(), false, false, 0
+
+	place(w, pos, c, user, ctx)
+	if placed(ctx) {
+		w.ScheduleBlockUpdate(pos, comparatorDelay)
+	}
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (c RedstoneComparator) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		w.SetBlock(pos, nil, nil)
+		dropItem(w, item.NewStack(RedstoneComparator{}, 1), pos.Vec3Centre())
+		UpdateRedstone(pos, w)
+		return
+	}
+	c.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate schedules a change of the output of the comparator if the power it should output changed.
+func (c RedstoneComparator) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if power, _ := c.output(pos, w); power != c.Output {
+		w.ScheduleBlockUpdate(pos, comparatorDelay)
+	}
+}
+
+// ScheduledTick updates the output of the comparator. Comparators measuring a container keep checking its fill
+// level for as long as the container is there.
+func (c RedstoneComparator) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	power, measuring := c.output(pos, w)
+	if power != c.Output {
+		c.Output, c.Powered = power, power > 0
+		w.SetBlock(pos, c, nil)
+		UpdateRedstone(pos, w)
+	}
+	if measuring {
+		w.ScheduleBlockUpdate(pos, comparatorDelay)
+	}
+}
+
+// output returns the power that the comparator should output. If the comparator is measuring the fill level of a
+// container, output additionally returns true.
+func (c RedstoneComparator) output(pos cube.Pos, w *world.World) (int, bool) {
+	rear, measuring := c.rearPower(pos, w)
+	var side int
+	for _, d := range []cube.Direction{c.Facing.RotateLeft(), c.Facing.RotateRight()} {
+		sidePos := pos.Side(d.Face())
+		if src, ok := w.Block(sidePos).(PowerSource); ok {
+			side = max(side, src.WeakPower(sidePos, d.Opposite().Face(), w, true))
+		}
+	}
+	if c.Subtract {
+		return max(rear-side, 0), measuring
+	}
+	if side > rear {
+		return 0, measuring
+	}
+	return rear, measuring
+}
+
+// rearPower returns the power that the comparator receives from behind. If the block behind the comparator, or the
+// block behind a solid block behind the comparator, can be measured, its signal is used if it is stronger than the
+// redstone power received. rearPower returns true if the signal of a container is measured.
+func (c RedstoneComparator) rearPower(pos cube.Pos, w *world.World) (int, bool) {
+	face := c.Facing.Face()
+	back := pos.Side(face.Opposite())
+	power := emittedPower(back, face, w, true)
+	if signal, measuring, ok := comparatorSignal(back, w); ok {
+		return max(power, signal), measuring
+	}
+	if conductsRedstone(back, w) {
+		if signal, measuring, ok := comparatorSignal(back.Side(face.Opposite()), w); ok {
+			return max(power, signal), measuring
+		}
+	}
+	return power, false
+}
+
+// comparatorSignal returns the signal that a comparator measures from the block at the position passed. False is
+// returned if the block cannot be measured. The second bool returned is true if the block is a container.
+func comparatorSignal(pos cube.Pos, w *world.World) (signal int, container bool, ok bool) {
+	switch b := w.Block(pos).(type) {
+	case ComparatorSource:
+		return b.ComparatorSignal(pos, w), false, true
+	case Container:
+		return inventorySignal(b.Inventory()), true, true
+	}
+	return 0, false, false
+}
+
+// inventorySignal returns the signal that a comparator measures from the inventory passed, based on how full it
+// is. An empty inventory has a signal of 0, while any item in the inventory results in a signal of at least 1.
+func inventorySignal(inv *inventory.Inventory) int {
+	var fullness float64
+	var empty = true
+	for _, it := range inv.Slots() {
+		if it.Empty() {
+			continue
+		}
+		fullness += float64(it.Count()) / float64(it.MaxCount())
+		empty = false
+	}
+	if empty {
+		return 0
+	}
+	return int(math.Floor(fullness/float64(inv.Size())*14)) + 1
+}
+
+// EncodeItem ...
+func (RedstoneComparator) EncodeItem() (name string, meta int16) {
+	return "minecraft:comparator", 0
+}
+
+// EncodeBlock ...
+func (c RedstoneComparator) EncodeBlock() (string, map[string]any) {
+	name := "minecraft:unpowered_comparator"
+	if c.Powered {
+		name = "minecraft:powered_comparator"
+	}
+	return name, map[string]any{
+		"minecraft:cardinal_direction": c.Facing.Opposite().String(),
+		"output_lit_bit":               boolByte(c.Powered),
+		"output_subtract_bit":          boolByte(c.Subtract),
+	}
+}
+
+// EncodeNBT ...
+func (c RedstoneComparator) EncodeNBT() map[string]any {
+	return map[string]any{"id": "Comparator", "OutputSignal": int32(c.Output)}
+}
+
+// DecodeNBT ...
+func (c RedstoneComparator) DecodeNBT(data map[string]any) any {
+	c.Output = int(nbtconv.Int32(data, "OutputSignal"))
+	return c
+}
+
+// allRedstoneComparators ...
+func allRedstoneComparators() (comparators []world.Block) {
+	for _, d := range cube.Directions() {
+		for _, subtract := range []bool{false, true} {
+			comparators = append(comparators, RedstoneComparator{Facing: d, Subtract: subtract})
+			comparators = append(comparators, RedstoneComparator{Facing: d, Subtract: subtract, Powered: true})
+		}
+	}
+	return
+}
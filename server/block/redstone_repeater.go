@@ -0,0 +1,171 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// RedstoneRepeater is a redstone component that passes on the power it receives from behind at full strength after
+// a delay. A repeater cannot change its output while a powered repeater or comparator points into its side, in
+// which case the repeater is locked.
+type RedstoneRepeater struct {
+	transparent
+
+	// Facing is the direction that the repeater outputs power towards. The repeater receives power from the
+	// opposite direction.
+	Facing cube.Direction
+	// Delay is the delay of the repeater in redstone ticks of 0.1 seconds, minus one. It ranges from 0 to 3.
+	Delay int
+	// Powered is true if the repeater is outputting redstone power.
+	Powered bool
+}
+
+// Model ...
+func (RedstoneRepeater) Model() world.BlockModel {
+	return model.Diode{}
+}
+
+// BreakInfo ...
+func (r RedstoneRepeater) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(RedstoneRepeater{})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// HasLiquidDrops ...
+func (RedstoneRepeater) HasLiquidDrops() bool {
+	return true
+}
+
+// WeakPower ...
+func (r RedstoneRepeater) WeakPower(_ cube.Pos, face cube.Face, _ *world.World, _ bool) int {
+	if r.Powered && face == r.Facing.Face() {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (r RedstoneRepeater) StrongPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) int {
+	return r.WeakPower(pos, face, w, dust)
+}
+
+// Activate cycles through the delays of the repeater.
+func (r RedstoneRepeater) Activate(pos cube.Pos, _ cube.Face, w *world.World, _ item.User, _ *item.UseContext) bool {
+	r.Delay = (r.Delay + 1) % 4
+	w.SetBlock(pos, r, nil)
+	return true
+}
+
+// UseOnBlock ...
+func (r RedstoneRepeater) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, r)
+	if !used {
+		return false
+	}
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		return false
+	}
+	r.Facing, r.Delay, r.Powered = user.Rotation().Direction(), 0, false
+
+	place(w, pos, r, user, ctx)
+	if placed(ctx) {
+		r.RedstoneUpdate(pos, w)
+	}
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r RedstoneRepeater) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		w.SetBlock(pos, nil, nil)
+		dropItem(w, item.NewStack(RedstoneRepeater{}, 1), pos.Vec3Centre())
+		UpdateRedstone(pos, w)
+		return
+	}
+	r.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate schedules a change of the output of the repeater if the power it receives changed.
+func (r RedstoneRepeater) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if r.locked(pos, w) || r.Powered == r.inputPowered(pos, w) {
+		return
+	}
+	w.ScheduleBlockUpdate(pos, r.delay())
+}
+
+// ScheduledTick changes the output of the repeater. A repeater that turns on always stays on for at least the
+// duration of its delay, even if the power it receives was only a short pulse.
+func (r RedstoneRepeater) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if r.locked(pos, w) {
+		return
+	}
+	input := r.inputPowered(pos, w)
+	if r.Powered == input && r.Powered {
+		return
+	}
+	r.Powered = !r.Powered
+	w.SetBlock(pos, r, nil)
+	UpdateRedstone(pos, w)
+	if r.Powered != input {
+		w.ScheduleBlockUpdate(pos, r.delay())
+	}
+}
+
+// delay returns the time it takes for the output of the repeater to change after its input changed.
+func (r RedstoneRepeater) delay() time.Duration {
+	return time.Duration(r.Delay+1) * time.Millisecond * 100
+}
+
+// inputPowered checks if the repeater receives redstone power from the block behind it.
+func (r RedstoneRepeater) inputPowered(pos cube.Pos, w *world.World) bool {
+	return emittedPower(pos.Side(r.Facing.Opposite().Face()), r.Facing.Face(), w, true) > 0
+}
+
+// locked checks if the repeater is locked by a powered repeater or comparator pointing into either of its sides.
+func (r RedstoneRepeater) locked(pos cube.Pos, w *world.World) bool {
+	for _, d := range []cube.Direction{r.Facing.RotateLeft(), r.Facing.RotateRight()} {
+		side := pos.Side(d.Face())
+		switch b := w.Block(side).(type) {
+		case RedstoneRepeater:
+			if b.Powered && b.Facing == d.Opposite() {
+				return true
+			}
+		case RedstoneComparator:
+			if b.Powered && b.Facing == d.Opposite() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// EncodeItem ...
+func (RedstoneRepeater) EncodeItem() (name string, meta int16) {
+	return "minecraft:repeater", 0
+}
+
+// EncodeBlock ...
+func (r RedstoneRepeater) EncodeBlock() (string, map[string]any) {
+	name := "minecraft:unpowered_repeater"
+	if r.Powered {
+		name = "minecraft:powered_repeater"
+	}
+	return name, map[string]any{"minecraft:cardinal_direction": r.Facing.Opposite().String(), "repeater_delay": int32(r.Delay)}
+}
+
+// allRedstoneRepeaters ...
+func allRedstoneRepeaters() (repeaters []world.Block) {
+	for _, d := range cube.Directions() {
+		for i := 0; i <= 3; i++ {
+			repeaters = append(repeaters, RedstoneRepeater{Facing: d, Delay: i})
+			repeaters = append(repeaters, RedstoneRepeater{Facing: d, Delay: i, Powered: true})
+		}
+	}
+	return
+}
@@ -0,0 +1,227 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// maxWireNetworkSize is the maximum amount of redstone wire that the power of is recalculated at once when a wire
+// is updated. Wire beyond this limit is updated when the power of the wire next to it changes.
+const maxWireNetworkSize = 4096
+
+// RedstoneWire is a block placed using redstone dust that carries redstone power from power sources to
+// components. The power carried decreases by one for every block of wire.
+type RedstoneWire struct {
+	empty
+	transparent
+
+	// Power is the redstone power level carried by the wire, ranging from 0 to 15.
+	Power int
+}
+
+// BreakInfo ...
+func (r RedstoneWire) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(RedstoneWire{})).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		UpdateRedstone(pos, w)
+	})
+}
+
+// HasLiquidDrops ...
+func (RedstoneWire) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock ...
+func (r RedstoneWire) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, r)
+	if !used {
+		return false
+	}
+	if _, ok := w.Liquid(pos); ok {
+		return false
+	}
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		return false
+	}
+	place(w, pos, RedstoneWire{}, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (r RedstoneWire) NeighbourUpdateTick(pos, changedNeighbour cube.Pos, w *world.World) {
+	if !supportsWire(pos.Side(cube.FaceDown), w) {
+		w.SetBlock(pos, nil, nil)
+		dropItem(w, item.NewStack(RedstoneWire{}, 1), pos.Vec3Centre())
+		UpdateRedstone(pos, w)
+		return
+	}
+	if _, ok := w.Block(changedNeighbour).(RedstoneWire); ok && changedNeighbour != pos {
+		// Changes in the power of other wire are already handled when the network of that wire is updated.
+		return
+	}
+	r.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate recalculates the power of all wire connected to the wire at the position passed.
+func (RedstoneWire) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	updateWireNetwork(pos, w)
+}
+
+// WeakPower ...
+func (r RedstoneWire) WeakPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) int {
+	if !dust || r.Power == 0 || face == cube.FaceUp {
+		return 0
+	}
+	if face == cube.FaceDown || r.pointsTowards(pos, face, w) {
+		return r.Power
+	}
+	return 0
+}
+
+// StrongPower ...
+func (r RedstoneWire) StrongPower(pos cube.Pos, face cube.Face, w *world.World, dust bool) int {
+	return r.WeakPower(pos, face, w, dust)
+}
+
+// pointsTowards checks if the wire at the position passed points towards the horizontal face passed. Wire points
+// towards a side if it is connected to it, or if it is not connected to either of the sides perpendicular to it.
+func (r RedstoneWire) pointsTowards(pos cube.Pos, face cube.Face, w *world.World) bool {
+	if wireConnects(pos, face, w) {
+		return true
+	}
+	return !wireConnects(pos, face.RotateLeft(), w) && !wireConnects(pos, face.RotateRight(), w)
+}
+
+// EncodeItem ...
+func (RedstoneWire) EncodeItem() (name string, meta int16) {
+	return "minecraft:redstone", 0
+}
+
+// EncodeBlock ...
+func (r RedstoneWire) EncodeBlock() (string, map[string]any) {
+	return "minecraft:redstone_wire", map[string]any{"redstone_signal": int32(r.Power)}
+}
+
+// allRedstoneWires ...
+func allRedstoneWires() (wires []world.Block) {
+	for i := 0; i <= 15; i++ {
+		wires = append(wires, RedstoneWire{Power: i})
+	}
+	return
+}
+
+// supportsWire checks if the block at the position passed is able to support redstone wire or a pressure plate on
+// top of it.
+func supportsWire(pos cube.Pos, w *world.World) bool {
+	return w.Block(pos).Model().FaceSolid(pos, cube.FaceUp, w)
+}
+
+// wireConnects checks if the wire at the position passed connects to the horizontal face passed. Wire connects
+// to other wire next to it or one block above or below it, and to power sources such as levers.
+func wireConnects(pos cube.Pos, face cube.Face, w *world.World) bool {
+	side := pos.Side(face)
+	switch b := w.Block(side).(type) {
+	case RedstoneRepeater:
+		// Repeaters only connect to wire in front of or behind them.
+		return b.Facing.Face().Axis() == face.Axis()
+	case PowerSource:
+		return true
+	}
+	return len(wireStepNeighbours(pos, face, w)) > 0
+}
+
+// wireStepNeighbours returns the positions of wire one block above or below the side of the wire at pos that
+// the wire at pos is connected to. Wire is connected to wire one block above it if the block above the wire is
+// not solid, and to wire one block below it if the block on the side of the wire is not solid.
+func wireStepNeighbours(pos cube.Pos, face cube.Face, w *world.World) []cube.Pos {
+	side := pos.Side(face)
+	var positions []cube.Pos
+	if up := side.Side(cube.FaceUp); !conductsRedstone(pos.Side(cube.FaceUp), w) {
+		if _, ok := w.Block(up).(RedstoneWire); ok {
+			positions = append(positions, up)
+		}
+	}
+	if down := side.Side(cube.FaceDown); !conductsRedstone(side, w) {
+		if _, ok := w.Block(down).(RedstoneWire); ok {
+			positions = append(positions, down)
+		}
+	}
+	return positions
+}
+
+// wireNeighbours returns the positions of all wire that the wire at the position passed is connected to.
+func wireNeighbours(pos cube.Pos, w *world.World) []cube.Pos {
+	positions := make([]cube.Pos, 0, 4)
+	for _, face := range cube.HorizontalFaces() {
+		side := pos.Side(face)
+		if _, ok := w.Block(side).(RedstoneWire); ok {
+			positions = append(positions, side)
+			continue
+		}
+		positions = append(positions, wireStepNeighbours(pos, face, w)...)
+	}
+	return positions
+}
+
+// updateWireNetwork recalculates the power of the network of wire connected to the wire at the position passed.
+// The power of every wire in the network is the highest of the power it receives from blocks other than wire and
+// the power of the wire next to it minus one. Wire of which the power changed is updated in the world, after which
+// the blocks around it are notified.
+func updateWireNetwork(pos cube.Pos, w *world.World) {
+	if _, ok := w.Block(pos).(RedstoneWire); !ok {
+		return
+	}
+	// First find all wire connected to the wire at pos.
+	network := map[cube.Pos]int{pos: 0}
+	queue := []cube.Pos{pos}
+	for i := 0; i < len(queue) && len(network) < maxWireNetworkSize; i++ {
+		for _, n := range wireNeighbours(queue[i], w) {
+			if _, ok := network[n]; !ok {
+				network[n] = 0
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	// Then find the power that each wire receives from sources other than wire. These sources are the starting
+	// points of the power spreading through the network.
+	var buckets [16][]cube.Pos
+	for p := range network {
+		power := receivedPower(p, w, false)
+		network[p] = power
+		buckets[power] = append(buckets[power], p)
+	}
+	// Spread the power through the network, starting with the highest power levels, so that every wire is
+	// visited at most once per power level.
+	for power := 15; power > 1; power-- {
+		for i := 0; i < len(buckets[power]); i++ {
+			p := buckets[power][i]
+			if network[p] != power {
+				continue
+			}
+			for _, n := range wireNeighbours(p, w) {
+				if current, ok := network[n]; ok && current < power-1 {
+					network[n] = power - 1
+					buckets[power-1] = append(buckets[power-1], n)
+				}
+			}
+		}
+	}
+
+	// Finally update all wire of which the power changed and notify the blocks around them.
+	var changed []cube.Pos
+	for p, power := range network {
+		if wire, ok := w.Block(p).(RedstoneWire); ok && wire.Power != power {
+			w.SetBlock(p, RedstoneWire{Power: power}, &world.SetOpts{DisableBlockUpdates: true, DisableLiquidDisplacement: true})
+			changed = append(changed, p)
+		}
+	}
+	if len(changed) > 0 {
+		updateRedstone(changed, w, func(p cube.Pos) bool {
+			_, ok := network[p]
+			return ok
+		})
+	}
+}
@@ -44,6 +44,7 @@ func init() {
 	world.RegisterBlock(Emerald{})
 	world.RegisterBlock(EnchantingTable{})
 	world.RegisterBlock(EndBricks{})
+	world.RegisterBlock(EndPortal{})
 	world.RegisterBlock(EndStone{})
 	world.RegisterBlock(FletchingTable{})
 	world.RegisterBlock(GlassPane{})
@@ -55,13 +56,17 @@ func init() {
 	world.RegisterBlock(Grass{})
 	world.RegisterBlock(Gravel{})
 	world.RegisterBlock(Honeycomb{})
+	world.RegisterBlock(Ice{})
 	world.RegisterBlock(InvisibleBedrock{})
 	world.RegisterBlock(IronBars{})
 	world.RegisterBlock(Iron{})
 	world.RegisterBlock(Jukebox{})
 	world.RegisterBlock(Lapis{})
+	world.RegisterBlock(Lodestone{})
 	world.RegisterBlock(Melon{})
 	world.RegisterBlock(MossCarpet{})
+	world.RegisterBlock(MobSpawner{})
+	world.RegisterBlock(Moving{})
 	world.RegisterBlock(MudBricks{})
 	world.RegisterBlock(Mud{})
 	world.RegisterBlock(NetherBrickFence{})
@@ -80,10 +85,12 @@ func init() {
 	world.RegisterBlock(Podzol{})
 	world.RegisterBlock(PolishedBlackstoneBrick{Cracked: true})
 	world.RegisterBlock(PolishedBlackstoneBrick{})
+	world.RegisterBlock(PowderSnow{})
 	world.RegisterBlock(QuartzBricks{})
 	world.RegisterBlock(RawCopper{})
 	world.RegisterBlock(RawGold{})
 	world.RegisterBlock(RawIron{})
+	world.RegisterBlock(RedstoneBlock{})
 	world.RegisterBlock(ReinforcedDeepslate{})
 	world.RegisterBlock(Sand{Red: true})
 	world.RegisterBlock(Sand{})
@@ -113,17 +120,23 @@ func init() {
 	}
 
 	registerAll(allAnvils())
+	registerAll(allBamboo())
 	registerAll(allBanners())
 	registerAll(allBarrels())
 	registerAll(allBasalt())
+	registerAll(allBeds())
+	registerAll(allBells())
 	registerAll(allBeetroot())
 	registerAll(allBlackstone())
 	registerAll(allBlastFurnaces())
 	registerAll(allBoneBlock())
+	registerAll(allButtons())
 	registerAll(allCactus())
 	registerAll(allCake())
+	registerAll(allCampfires())
 	registerAll(allCarpet())
 	registerAll(allCarrots())
+	registerAll(allCauldrons())
 	registerAll(allChains())
 	registerAll(allChests())
 	registerAll(allCocoaBeans())
@@ -147,13 +160,16 @@ func init() {
 	registerAll(allGlazedTerracotta())
 	registerAll(allGrindstones())
 	registerAll(allHayBales())
+	registerAll(allHoppers())
 	registerAll(allItemFrames())
 	registerAll(allKelp())
 	registerAll(allLadders())
 	registerAll(allLanterns())
+	registerAll(allRails())
 	registerAll(allLava())
 	registerAll(allLeaves())
 	registerAll(allLecterns())
+	registerAll(allLevers())
 	registerAll(allLight())
 	registerAll(allLitPumpkins())
 	registerAll(allLogs())
@@ -162,19 +178,28 @@ func init() {
 	registerAll(allMuddyMangroveRoots())
 	registerAll(allNetherBricks())
 	registerAll(allNetherWart())
+	registerAll(allPistonArmCollisions())
+	registerAll(allPistons())
 	registerAll(allPlanks())
 	registerAll(allPotato())
+	registerAll(allPressurePlates())
 	registerAll(allPrismarine())
 	registerAll(allPumpkinStems())
 	registerAll(allPumpkins())
 	registerAll(allPurpurs())
 	registerAll(allQuartz())
+	registerAll(allRedstoneComparators())
+	registerAll(allRedstoneRepeaters())
+	registerAll(allRedstoneWires())
 	registerAll(allSandstones())
+	registerAll(allSaplings())
 	registerAll(allSeaPickles())
+	registerAll(allShulkerBoxes())
 	registerAll(allSigns())
 	registerAll(allSkulls())
 	registerAll(allSlabs())
 	registerAll(allSmokers())
+	registerAll(allSnowLayers())
 	registerAll(allStainedGlass())
 	registerAll(allStainedGlassPane())
 	registerAll(allStainedTerracotta())
@@ -199,6 +224,7 @@ func init() {
 	world.RegisterItem(AncientDebris{})
 	world.RegisterItem(Andesite{Polished: true})
 	world.RegisterItem(Andesite{})
+	world.RegisterItem(Bamboo{})
 	world.RegisterItem(Barrel{})
 	world.RegisterItem(Barrier{})
 	world.RegisterItem(Basalt{Polished: true})
@@ -206,6 +232,7 @@ func init() {
 	world.RegisterItem(Beacon{})
 	world.RegisterItem(Bedrock{})
 	world.RegisterItem(BeetrootSeeds{})
+	world.RegisterItem(Bell{})
 	world.RegisterItem(BlastFurnace{})
 	world.RegisterItem(BlueIce{})
 	world.RegisterItem(Bone{})
@@ -215,6 +242,7 @@ func init() {
 	world.RegisterItem(Cake{})
 	world.RegisterItem(Calcite{})
 	world.RegisterItem(Carrot{})
+	world.RegisterItem(Cauldron{})
 	world.RegisterItem(Chain{})
 	world.RegisterItem(Chest{})
 	world.RegisterItem(ChiseledQuartz{})
@@ -257,6 +285,8 @@ func init() {
 	world.RegisterItem(Grindstone{})
 	world.RegisterItem(HayBale{})
 	world.RegisterItem(Honeycomb{})
+	world.RegisterItem(Hopper{})
+	world.RegisterItem(Ice{})
 	world.RegisterItem(InvisibleBedrock{})
 	world.RegisterItem(IronBars{})
 	world.RegisterItem(Iron{})
@@ -267,10 +297,13 @@ func init() {
 	world.RegisterItem(Ladder{})
 	world.RegisterItem(Lapis{})
 	world.RegisterItem(Lectern{})
+	world.RegisterItem(Lever{})
 	world.RegisterItem(LitPumpkin{})
+	world.RegisterItem(Lodestone{})
 	world.RegisterItem(Loom{})
 	world.RegisterItem(MelonSeeds{})
 	world.RegisterItem(Melon{})
+	world.RegisterItem(MobSpawner{})
 	world.RegisterItem(MossCarpet{})
 	world.RegisterItem(MudBricks{})
 	world.RegisterItem(MuddyMangroveRoots{})
@@ -294,6 +327,8 @@ func init() {
 	world.RegisterItem(PolishedBlackstoneBrick{})
 	world.RegisterItem(Potato{})
 	world.RegisterItem(PumpkinSeeds{})
+	world.RegisterItem(Piston{Sticky: true})
+	world.RegisterItem(Piston{})
 	world.RegisterItem(Pumpkin{Carved: true})
 	world.RegisterItem(Pumpkin{})
 	world.RegisterItem(PurpurPillar{})
@@ -302,9 +337,14 @@ func init() {
 	world.RegisterItem(QuartzPillar{})
 	world.RegisterItem(Quartz{Smooth: true})
 	world.RegisterItem(Quartz{})
+	world.RegisterItem(Rail{})
 	world.RegisterItem(RawCopper{})
 	world.RegisterItem(RawGold{})
 	world.RegisterItem(RawIron{})
+	world.RegisterItem(RedstoneBlock{})
+	world.RegisterItem(RedstoneComparator{})
+	world.RegisterItem(RedstoneRepeater{})
+	world.RegisterItem(RedstoneWire{})
 	world.RegisterItem(ReinforcedDeepslate{})
 	world.RegisterItem(Sand{Red: true})
 	world.RegisterItem(Sand{})
@@ -313,6 +353,7 @@ func init() {
 	world.RegisterItem(Shroomlight{})
 	world.RegisterItem(SmithingTable{})
 	world.RegisterItem(Smoker{})
+	world.RegisterItem(SnowLayer{})
 	world.RegisterItem(Snow{})
 	world.RegisterItem(SoulSand{})
 	world.RegisterItem(SoulSoil{})
@@ -331,6 +372,7 @@ func init() {
 	world.RegisterItem(item.Bucket{Content: item.LiquidBucketContent(Lava{})})
 	world.RegisterItem(item.Bucket{Content: item.LiquidBucketContent(Water{})})
 	world.RegisterItem(item.Bucket{Content: item.MilkBucketContent()})
+	world.RegisterItem(item.Bucket{Content: item.PowderSnowBucketContent()})
 
 	for _, b := range allLight() {
 		world.RegisterItem(b.(world.Item))
@@ -351,8 +393,15 @@ func init() {
 	for _, t := range AnvilTypes() {
 		world.RegisterItem(Anvil{Type: t})
 	}
+	for _, t := range ButtonTypes() {
+		world.RegisterItem(Button{Type: t})
+	}
+	for _, t := range PressurePlateTypes() {
+		world.RegisterItem(PressurePlate{Type: t})
+	}
 	for _, c := range item.Colours() {
 		world.RegisterItem(Banner{Colour: c})
+		world.RegisterItem(Bed{Colour: c})
 		world.RegisterItem(Carpet{Colour: c})
 		world.RegisterItem(ConcretePowder{Colour: c})
 		world.RegisterItem(Concrete{Colour: c})
@@ -366,6 +415,9 @@ func init() {
 		if w != WarpedWood() && w != CrimsonWood() {
 			world.RegisterItem(Leaves{Wood: w, Persistent: true})
 		}
+		if saplingWood(w) {
+			world.RegisterItem(Sapling{Wood: w})
+		}
 		world.RegisterItem(Log{Wood: w, Stripped: true})
 		world.RegisterItem(Log{Wood: w})
 		world.RegisterItem(Planks{Wood: w})
@@ -387,6 +439,7 @@ func init() {
 		world.RegisterItem(LapisOre{Type: ore})
 	}
 	for _, f := range FireTypes() {
+		world.RegisterItem(Campfire{Type: f})
 		world.RegisterItem(Lantern{Type: f})
 		world.RegisterItem(Torch{Type: f})
 	}
@@ -405,6 +458,9 @@ func init() {
 	for _, p := range PrismarineTypes() {
 		world.RegisterItem(Prismarine{Type: p})
 	}
+	for _, t := range ShulkerBoxTypes() {
+		world.RegisterItem(ShulkerBox{Type: t})
+	}
 	for _, t := range NetherBricksTypes() {
 		world.RegisterItem(NetherBricks{Type: t})
 	}
@@ -431,6 +487,15 @@ func init() {
 	}
 }
 
+// init registers the item tags of blocks implemented by Dragonfly.
+func init() {
+	for _, w := range WoodTypes() {
+		item.RegisterTag(item.TagPlanks, Planks{Wood: w})
+		item.RegisterTag(item.TagLogs, Log{Wood: w}, Log{Wood: w, Stripped: true}, Wood{Wood: w}, Wood{Wood: w, Stripped: true})
+	}
+	item.RegisterTag(item.TagWool, Wool{})
+}
+
 func registerAll(blocks []world.Block) {
 	for _, b := range blocks {
 		world.RegisterBlock(b)
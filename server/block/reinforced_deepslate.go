@@ -11,6 +11,11 @@ func (r ReinforcedDeepslate) BreakInfo() BreakInfo {
 	return newBreakInfo(55, alwaysHarvestable, nothingEffective, oneOf(r)).withBlastResistance(3600)
 }
 
+// PistonImmovable always returns true. Reinforced deepslate cannot be moved by pistons.
+func (ReinforcedDeepslate) PistonImmovable() bool {
+	return true
+}
+
 // EncodeItem ...
 func (ReinforcedDeepslate) EncodeItem() (name string, meta int16) {
 	return "minecraft:reinforced_deepslate", 0
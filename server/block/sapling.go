@@ -0,0 +1,93 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Sapling is a non-solid plant that is dropped by leaves and that may be planted on dirt or grass.
+type Sapling struct {
+	empty
+	transparent
+
+	// Wood is the type of wood of the sapling. Crimson, warped and mangrove wood have no sapling.
+	Wood WoodType
+	// Ready specifies if the sapling is ready to grow into a tree during its next growth stage.
+	Ready bool
+}
+
+// NeighbourUpdateTick ...
+func (s Sapling) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !supportsVegetation(s, w.Block(pos.Side(cube.FaceDown))) {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: s})
+		dropItem(w, item.NewStack(Sapling{Wood: s.Wood}, 1), pos.Vec3Centre())
+	}
+}
+
+// UseOnBlock ...
+func (s Sapling) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, s)
+	if !used {
+		return false
+	}
+	if !supportsVegetation(s, w.Block(pos.Side(cube.FaceDown))) {
+		return false
+	}
+
+	place(w, pos, s, user, ctx)
+	return placed(ctx)
+}
+
+// HasLiquidDrops ...
+func (Sapling) HasLiquidDrops() bool {
+	return true
+}
+
+// BreakInfo ...
+func (s Sapling) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(Sapling{Wood: s.Wood}))
+}
+
+// CompostChance ...
+func (Sapling) CompostChance() float64 {
+	return 0.3
+}
+
+// EncodeItem ...
+func (s Sapling) EncodeItem() (name string, meta int16) {
+	if s.Wood == Cherry() {
+		return "minecraft:cherry_sapling", 0
+	}
+	return "minecraft:sapling", int16(s.Wood.Uint8())
+}
+
+// EncodeBlock ...
+func (s Sapling) EncodeBlock() (string, map[string]any) {
+	if s.Wood == Cherry() {
+		return "minecraft:cherry_sapling", map[string]any{"age_bit": s.Ready}
+	}
+	return "minecraft:sapling", map[string]any{"age_bit": s.Ready, "sapling_type": s.Wood.String()}
+}
+
+// saplingWood checks if the WoodType passed has a sapling.
+func saplingWood(w WoodType) bool {
+	switch w {
+	case OakWood(), SpruceWood(), BirchWood(), JungleWood(), AcaciaWood(), DarkOakWood(), Cherry():
+		return true
+	}
+	return false
+}
+
+// allSaplings returns a list of all possible sapling states.
+func allSaplings() (saplings []world.Block) {
+	for _, w := range WoodTypes() {
+		if saplingWood(w) {
+			saplings = append(saplings, Sapling{Wood: w}, Sapling{Wood: w, Ready: true})
+		}
+	}
+	return
+}
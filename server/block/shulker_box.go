@@ -0,0 +1,232 @@
+package block
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+	"sync"
+)
+
+// ShulkerBox is a container block which may be used to store items. Unlike other containers, a shulker box
+// keeps its contents when it is broken: The items are stored in the item dropped, so that they may be
+// carried around and placed again. Shulker boxes cannot be placed inside other shulker boxes.
+// The empty value of ShulkerBox is not valid. It must be created using block.NewShulkerBox().
+type ShulkerBox struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Type is the type of the shulker box, specifying its colour.
+	Type ShulkerBoxType
+	// Facing is the direction that the shulker box opens towards.
+	Facing cube.Face
+	// CustomName is the custom name of the shulker box. This name is displayed when the shulker box is opened,
+	// and may include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+}
+
+// NewShulkerBox creates a new initialised shulker box. The inventory is properly initialised.
+func NewShulkerBox() ShulkerBox {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	return ShulkerBox{
+		Facing: cube.FaceUp,
+		inventory: inventory.New(27, func(slot int, _, item item.Stack) {
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot, item)
+			}
+		}),
+		viewerMu: m,
+		viewers:  v,
+	}
+}
+
+// Inventory returns the inventory of the shulker box. The size of the inventory will be 27.
+func (s ShulkerBox) Inventory() *inventory.Inventory {
+	return s.inventory
+}
+
+// WithName returns the shulker box after applying a specific name to the block.
+func (s ShulkerBox) WithName(a ...any) world.Item {
+	s.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return s
+}
+
+// Model ...
+func (ShulkerBox) Model() world.BlockModel {
+	return model.Solid{}
+}
+
+// MaxCount always returns 1.
+func (ShulkerBox) MaxCount() int {
+	return 1
+}
+
+// open opens the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) open(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, OpenAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxOpen{})
+}
+
+// close closes the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) close(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, CloseAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxClose{})
+}
+
+// AddViewer adds a viewer to the shulker box, so that it is updated whenever the inventory of the shulker box
+// is changed.
+func (s ShulkerBox) AddViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		s.open(w, pos)
+	}
+	s.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the shulker box, so that slot updates in the inventory are no longer sent
+// to it.
+func (s ShulkerBox) RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		return
+	}
+	delete(s.viewers, v)
+	if len(s.viewers) == 0 {
+		s.close(w, pos)
+	}
+}
+
+// Activate ...
+func (s ShulkerBox) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		if s.openable(w, pos) {
+			opener.OpenBlockContainer(pos)
+		}
+		return true
+	}
+	return false
+}
+
+// openable checks if the shulker box at the position passed is not obstructed by the block in the direction
+// that it opens towards.
+func (s ShulkerBox) openable(w *world.World, pos cube.Pos) bool {
+	side := pos.Side(s.Facing)
+	return !w.Block(side).Model().FaceSolid(side, s.Facing.Opposite(), w)
+}
+
+// UseOnBlock ...
+func (s ShulkerBox) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, s)
+	if !used {
+		return
+	}
+	// The shulker box placed gets a copy of the contents of the item, as the item may not be consumed when it
+	// is placed in creative mode.
+	box := s.withContents(s.Items())
+	box.Facing = face
+
+	place(w, pos, box, user, ctx)
+	return placed(ctx)
+}
+
+// Items returns the items stored in the shulker box.
+func (s ShulkerBox) Items() []item.Stack {
+	if s.inventory == nil {
+		return nil
+	}
+	return s.inventory.Slots()
+}
+
+// withContents returns a new initialised copy of the shulker box holding the items passed. The type, facing
+// direction and custom name are kept.
+func (s ShulkerBox) withContents(items []item.Stack) ShulkerBox {
+	box := NewShulkerBox()
+	box.Type, box.Facing, box.CustomName = s.Type, s.Facing, s.CustomName
+	for slot, it := range items {
+		_ = box.inventory.SetItem(slot, it)
+	}
+	return box
+}
+
+// BreakInfo ...
+func (s ShulkerBox) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, pickaxeEffective, func(item.Tool, []item.Enchantment) []item.Stack {
+		return []item.Stack{item.NewStack(s.withContents(s.Items()), 1)}
+	})
+}
+
+// DecodeNBT ...
+func (s ShulkerBox) DecodeNBT(data map[string]any) any {
+	t := s.Type
+	//noinspection GoAssignmentToReceiver
+	s = NewShulkerBox()
+	s.Type = t
+	if _, ok := data["facing"]; ok {
+		s.Facing = cube.Face(nbtconv.Uint8(data, "facing"))
+	}
+	s.CustomName = nbtconv.String(data, "CustomName")
+	items := nbtconv.Slice(data, "Items")
+	if m, ok := data["Items"].([]map[string]any); ok {
+		// The NBT of items is not always encoded before it is decoded again, for example when an item entity is
+		// created, so the items may still be in the format produced by EncodeNBT.
+		for _, v := range m {
+			items = append(items, v)
+		}
+	}
+	nbtconv.InvFromNBT(s.inventory, items)
+	return s
+}
+
+// EncodeNBT ...
+func (s ShulkerBox) EncodeNBT() map[string]any {
+	if s.inventory == nil {
+		//noinspection GoAssignmentToReceiver
+		s = s.withContents(nil)
+	}
+	m := map[string]any{
+		"Items":  nbtconv.InvToNBT(s.inventory),
+		"id":     "ShulkerBox",
+		"facing": uint8(s.Facing),
+	}
+	if s.CustomName != "" {
+		m["CustomName"] = s.CustomName
+	}
+	return m
+}
+
+// EncodeBlock ...
+func (s ShulkerBox) EncodeBlock() (string, map[string]any) {
+	return "minecraft:" + s.Type.String() + "_shulker_box", nil
+}
+
+// EncodeItem ...
+func (s ShulkerBox) EncodeItem() (name string, meta int16) {
+	return "minecraft:" + s.Type.String() + "_shulker_box", 0
+}
+
+// allShulkerBoxes ...
+func allShulkerBoxes() (boxes []world.Block) {
+	for _, t := range ShulkerBoxTypes() {
+		boxes = append(boxes, ShulkerBox{Type: t})
+	}
+	return
+}
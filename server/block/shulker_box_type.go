@@ -0,0 +1,52 @@
+package block
+
+import "github.com/df-mc/dragonfly/server/item"
+
+// ShulkerBoxType represents the type of ShulkerBox: Either an undyed shulker box or a shulker box dyed with
+// one of the 16 colours.
+type ShulkerBoxType struct {
+	shulkerBox
+}
+
+// UndyedShulkerBox returns the type of shulker box that has not been dyed.
+func UndyedShulkerBox() ShulkerBoxType {
+	return ShulkerBoxType{0}
+}
+
+// DyedShulkerBox returns the type of shulker box dyed with the colour passed.
+func DyedShulkerBox(c item.Colour) ShulkerBoxType {
+	return ShulkerBoxType{shulkerBox(c.Uint8() + 1)}
+}
+
+// ShulkerBoxTypes returns all possible ShulkerBoxTypes.
+func ShulkerBoxTypes() []ShulkerBoxType {
+	types := []ShulkerBoxType{UndyedShulkerBox()}
+	for _, c := range item.Colours() {
+		types = append(types, DyedShulkerBox(c))
+	}
+	return types
+}
+
+type shulkerBox uint8
+
+// Uint8 returns the ShulkerBoxType as a uint8.
+func (s shulkerBox) Uint8() uint8 {
+	return uint8(s)
+}
+
+// Colour returns the colour that the shulker box was dyed with. False is returned if the shulker box is
+// undyed.
+func (s shulkerBox) Colour() (item.Colour, bool) {
+	if s == 0 {
+		return item.Colour{}, false
+	}
+	return item.Colours()[s-1], true
+}
+
+// String returns the ShulkerBoxType as a string.
+func (s shulkerBox) String() string {
+	if c, ok := s.Colour(); ok {
+		return c.String()
+	}
+	return "undyed"
+}
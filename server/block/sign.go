@@ -5,7 +5,6 @@ import (
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
-	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
 	"image/color"
 	"strings"
@@ -160,16 +159,12 @@ func (s Sign) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.Wo
 func (s Sign) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	if s.Attach.hanging {
 		if _, ok := w.Block(pos.Side(s.Attach.facing.Opposite().Face())).(Air); ok {
-			w.SetBlock(pos, nil, nil)
-			w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: s})
-			dropItem(w, item.NewStack(Sign{Wood: s.Wood}, 1), pos.Vec3Centre())
+			breakUnsupported(pos, s, item.NewStack(Sign{Wood: s.Wood}, 1), w)
 		}
 		return
 	}
 	if _, ok := w.Block(pos.Side(cube.FaceDown)).(Air); ok {
-		w.SetBlock(pos, nil, nil)
-		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: s})
-		dropItem(w, item.NewStack(Sign{Wood: s.Wood}, 1), pos.Vec3Centre())
+		breakUnsupported(pos, s, item.NewStack(Sign{Wood: s.Wood}, 1), w)
 	}
 }
 
@@ -117,8 +117,8 @@ func (s *smelter) tickSmelting(requirement, decrement time.Duration, lit bool, s
 
 	// Initialize some default smelt info, and update it if we can smelt the item.
 	var inputInfo item.SmeltInfo
-	if i, ok := input.Item().(item.Smeltable); ok && supported(i.SmeltInfo()) {
-		inputInfo = i.SmeltInfo()
+	if info, ok := item.SmeltInfoOf(input.Item()); ok && supported(info) {
+		inputInfo = info
 	}
 
 	// Initialize some default fuel info, and update it if it can be used as fuel.
@@ -160,7 +160,7 @@ func (s *smelter) tickSmelting(requirement, decrement time.Duration, lit bool, s
 				// Calculate the amount of experience to grant. Round the experience down to the nearest integer.
 				// The remaining XP is a chance to be granted an additional experience point.
 				xp := inputInfo.Experience * float64(inputInfo.Product.Count())
-				earned := math.Floor(inputInfo.Experience)
+				earned := math.Floor(xp)
 				if chance := xp - earned; chance > 0 && rand.Float64() < chance {
 					earned++
 				}
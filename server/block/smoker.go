@@ -59,6 +59,14 @@ func (s Smoker) EncodeBlock() (name string, properties map[string]interface{}) {
 	return "minecraft:smoker", map[string]interface{}{"minecraft:cardinal_direction": s.Facing.String()}
 }
 
+// LightEmissionLevel ...
+func (s Smoker) LightEmissionLevel() uint8 {
+	if s.Lit {
+		return 13
+	}
+	return 0
+}
+
 // UseOnBlock ...
 func (s Smoker) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
 	pos, _, used := firstReplaceable(w, pos, face, s)
@@ -0,0 +1,107 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// SnowLayer is a thin layer of snow that may be stacked up to eight layers high. Snow layers are laid in cold
+// biomes while it is snowing and melt when exposed to bright light from blocks, such as torches.
+type SnowLayer struct {
+	transparent
+
+	// Height is the height of the snow layer, ranging from 0 for a single layer to 7 for eight layers, which is
+	// as high as a full block.
+	Height int
+	// Covered specifies if the snow layer covers a plant, such as grass, that was in its place before.
+	Covered bool
+}
+
+// Model ...
+func (s SnowLayer) Model() world.BlockModel {
+	return model.SnowLayer{Height: s.Height}
+}
+
+// SideClosed ...
+func (SnowLayer) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// ReplaceableBy only returns true for a single layer of snow, unless the block replacing it is also a snow layer,
+// in which case it is stacked instead.
+func (s SnowLayer) ReplaceableBy(b world.Block) bool {
+	_, snow := b.(SnowLayer)
+	return s.Height == 0 && !snow
+}
+
+// UseOnBlock ...
+func (s SnowLayer) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	for _, p := range []cube.Pos{pos, pos.Side(face)} {
+		if existing, ok := w.Block(p).(SnowLayer); ok && existing.Height < 7 {
+			// Snow layers used on other snow layers are stacked on top of them.
+			existing.Height++
+			place(w, p, existing, user, ctx)
+			return placed(ctx)
+		}
+	}
+	pos, _, used := firstReplaceable(w, pos, face, s)
+	if !used || !s.supported(pos, w) {
+		return false
+	}
+	place(w, pos, s, user, ctx)
+	return placed(ctx)
+}
+
+// supported checks if a snow layer may be placed at the position passed. Snow layers need a solid block below
+// them, but cannot be placed on ice.
+func (SnowLayer) supported(pos cube.Pos, w *world.World) bool {
+	switch w.Block(pos.Side(cube.FaceDown)).(type) {
+	case Ice, PackedIce, BlueIce:
+		return false
+	case Leaves:
+		return true
+	}
+	return supportedBy(pos, cube.FaceDown, w)
+}
+
+// NeighbourUpdateTick ...
+func (s SnowLayer) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !s.supported(pos, w) {
+		breakUnsupported(pos, s, item.Stack{}, w)
+	}
+}
+
+// RandomTick ...
+func (SnowLayer) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if w.BlockLight(pos) > 11 {
+		w.SetBlock(pos, nil, nil)
+	}
+}
+
+// BreakInfo ...
+func (s SnowLayer) BreakInfo() BreakInfo {
+	return newBreakInfo(0.1, shovelEffective, shovelEffective, silkTouchDrop(item.NewStack(item.Snowball{}, s.Height+1), item.NewStack(SnowLayer{}, s.Height+1)))
+}
+
+// EncodeItem ...
+func (SnowLayer) EncodeItem() (name string, meta int16) {
+	return "minecraft:snow_layer", 0
+}
+
+// EncodeBlock ...
+func (s SnowLayer) EncodeBlock() (string, map[string]any) {
+	return "minecraft:snow_layer", map[string]any{"height": int32(s.Height), "covered_bit": s.Covered}
+}
+
+// allSnowLayers ...
+func allSnowLayers() (layers []world.Block) {
+	for h := 0; h < 8; h++ {
+		layers = append(layers, SnowLayer{Height: h})
+		layers = append(layers, SnowLayer{Height: h, Covered: true})
+	}
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/df-mc/dragonfly/server/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
 )
 
@@ -64,6 +65,7 @@ func (s Sponge) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.
 	place(w, pos, s, user, ctx)
 	if particles && placed(ctx) {
 		w.AddParticle(pos.Side(cube.FaceUp).Vec3(), particle.Evaporate{})
+		w.PlaySound(pos.Vec3Centre(), sound.FireExtinguish{})
 	}
 	return placed(ctx)
 }
@@ -113,6 +115,9 @@ func (s Sponge) absorbWater(pos cube.Pos, w *world.World) int {
 		queue = queue[1:]
 
 		next.block.Neighbours(func(neighbour cube.Pos) {
+			if replaced >= 65 {
+				return
+			}
 			liquid, found := w.Liquid(neighbour)
 			if found {
 				if _, isWater := liquid.(Water); isWater {
@@ -12,6 +12,7 @@ import (
 // and is more efficient than crafting for certain recipes.
 type Stonecutter struct {
 	bassDrum
+	sourceWaterDisplacer
 
 	// Facing is the direction the stonecutter is facing.
 	Facing cube.Direction
@@ -22,6 +23,11 @@ func (Stonecutter) Model() world.BlockModel {
 	return model.Stonecutter{}
 }
 
+// SideClosed ...
+func (Stonecutter) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
 // BreakInfo ...
 func (s Stonecutter) BreakInfo() BreakInfo {
 	return newBreakInfo(3.5, pickaxeHarvestable, pickaxeEffective, oneOf(s))
@@ -42,39 +42,46 @@ func (c SugarCane) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 }
 
 // RandomTick ...
-func (c SugarCane) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+func (c SugarCane) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	abovePos := pos.Side(cube.FaceUp)
+	if _, ok := w.Block(abovePos).(Air); !ok || c.height(pos, w) >= 3 {
+		// Only the top block of sugar cane grows, and only until the sugar cane is three blocks tall.
+		return
+	}
 	if c.Age < 15 {
 		c.Age++
-	} else if c.Age == 15 {
-		c.Age = 0
-		if c.canGrowHere(pos.Side(cube.FaceDown), w, false) {
-			for y := 1; y < 3; y++ {
-				if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(Air); ok {
-					w.SetBlock(pos.Add(cube.Pos{0, y}), SugarCane{}, nil)
-					break
-				} else if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(SugarCane); !ok {
-					break
-				}
-			}
-		}
+		w.SetBlock(pos, c, nil)
+		return
 	}
-	w.SetBlock(pos, c, nil)
+	w.SetBlock(pos, SugarCane{}, nil)
+	w.SetBlock(abovePos, SugarCane{}, nil)
 }
 
 // BoneMeal ...
 func (c SugarCane) BoneMeal(pos cube.Pos, w *world.World) bool {
-	for _, ok := w.Block(pos.Side(cube.FaceDown)).(SugarCane); ok; _, ok = w.Block(pos.Side(cube.FaceDown)).(SugarCane) {
-		pos = pos.Side(cube.FaceDown)
+	for _, ok := w.Block(pos.Side(cube.FaceUp)).(SugarCane); ok; _, ok = w.Block(pos.Side(cube.FaceUp)).(SugarCane) {
+		pos = pos.Side(cube.FaceUp)
 	}
-	if c.canGrowHere(pos.Side(cube.FaceDown), w, false) {
-		for y := 1; y < 3; y++ {
-			if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(Air); ok {
-				w.SetBlock(pos.Add(cube.Pos{0, y}), SugarCane{}, nil)
-			}
+	grown := false
+	for h := c.height(pos, w); h < 3; h++ {
+		pos = pos.Side(cube.FaceUp)
+		if _, ok := w.Block(pos).(Air); !ok {
+			break
 		}
-		return true
+		w.SetBlock(pos, SugarCane{}, nil)
+		grown = true
 	}
-	return false
+	return grown
+}
+
+// height returns the amount of sugar cane blocks in the column of the sugar cane at the position passed, counting
+// from that position downwards.
+func (SugarCane) height(pos cube.Pos, w *world.World) (n int) {
+	for _, ok := w.Block(pos).(SugarCane); ok; _, ok = w.Block(pos).(SugarCane) {
+		n++
+		pos = pos.Side(cube.FaceDown)
+	}
+	return n
 }
 
 // canGrowHere implements logic to check if sugar cane can live/grow here.
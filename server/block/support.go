@@ -0,0 +1,25 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+)
+
+// supportedBy checks if the block at the side of the position passed has a solid face towards that position, so
+// that blocks such as torches, rails and lanterns may be attached to it.
+func supportedBy(pos cube.Pos, side cube.Face, w *world.World) bool {
+	other := pos.Side(side)
+	return w.Block(other).Model().FaceSolid(other, side.Opposite(), w)
+}
+
+// breakUnsupported breaks the block b at the position passed because the block it was attached to was removed or
+// changed, dropping the item stack passed if it is not empty.
+func breakUnsupported(pos cube.Pos, b world.Block, drop item.Stack, w *world.World) {
+	w.SetBlock(pos, nil, nil)
+	w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	if !drop.Empty() {
+		dropItem(w, drop, pos.Vec3Centre())
+	}
+}
@@ -20,7 +20,7 @@ type TNT struct {
 func (t TNT) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
 	held, _ := u.HeldItems()
 	if _, ok := held.Enchantment(enchantment.FireAspect{}); ok {
-		t.Ignite(pos, w)
+		t.Ignite(pos, w, u)
 		ctx.DamageItem(1)
 		return true
 	}
@@ -28,14 +28,26 @@ func (t TNT) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ct
 }
 
 // Ignite ...
-func (t TNT) Ignite(pos cube.Pos, w *world.World) bool {
-	spawnTnt(pos, w, time.Second*4)
+func (t TNT) Ignite(pos cube.Pos, w *world.World, igniter world.Entity) bool {
+	spawnTnt(pos, w, time.Second*4, igniter)
 	return true
 }
 
+// NeighbourUpdateTick ...
+func (t TNT) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	t.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate ignites the TNT if it is powered by redstone.
+func (t TNT) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if Powered(pos, w) {
+		t.Ignite(pos, w, nil)
+	}
+}
+
 // Explode ...
-func (t TNT) Explode(_ mgl64.Vec3, pos cube.Pos, w *world.World, _ ExplosionConfig) {
-	spawnTnt(pos, w, time.Second/2+time.Duration(rand.Intn(int(time.Second+time.Second/2))))
+func (t TNT) Explode(_ mgl64.Vec3, pos cube.Pos, w *world.World, c ExplosionConfig) {
+	spawnTnt(pos, w, time.Second/2+time.Duration(rand.Intn(int(time.Second+time.Second/2))), c.Owner)
 }
 
 // BreakInfo ...
@@ -59,8 +71,10 @@ func (t TNT) EncodeBlock() (name string, properties map[string]interface{}) {
 }
 
 // spawnTnt creates a new TNT entity at the given position with the given fuse duration.
-func spawnTnt(pos cube.Pos, w *world.World, fuse time.Duration) {
+func spawnTnt(pos cube.Pos, w *world.World, fuse time.Duration, igniter world.Entity) {
+	if !w.AddEntity(w.EntityRegistry().Config().TNT(pos.Vec3Centre(), fuse, igniter)) {
+		return
+	}
 	w.PlaySound(pos.Vec3Centre(), sound.TNT{})
 	w.SetBlock(pos, nil, nil)
-	w.AddEntity(w.EntityRegistry().Config().TNT(pos.Vec3Centre(), fuse))
 }
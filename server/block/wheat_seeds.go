@@ -4,7 +4,6 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
-	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
 	"math/rand"
 )
@@ -67,10 +66,9 @@ func (s WheatSeeds) EncodeItem() (name string, meta int16) {
 
 // RandomTick ...
 func (s WheatSeeds) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if w.Light(pos) < 8 {
-		w.SetBlock(pos, nil, nil)
-		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: s})
-	} else if s.Growth < 7 && r.Float64() <= s.CalculateGrowthChance(pos, w) {
+	if w.Light(pos) < cropGrowthLight-1 {
+		s.uproot(pos, w)
+	} else if s.Growth < 7 && s.canGrow(pos, w) && r.Float64() <= s.CalculateGrowthChance(pos, w) {
 		s.Growth++
 		w.SetBlock(pos, s, nil)
 	}
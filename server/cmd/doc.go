@@ -12,6 +12,8 @@
 // float32, float64, string, bool, mgl64.Vec3, Varargs, []Target, cmd.SubCommand, Optional[T] (to make a parameter
 // optional), or a type that implements the cmd.Parameter or cmd.Enum interface. cmd.Enum implementations must be of the
 // type string.
+// A cmd.Parameter may also implement cmd.Completer to have values, such as the names of warps, suggested to the client
+// while the parameter is typed.
 // Fields in the Runnable struct may have `cmd:` struct tag to specify the name and suffix of a parameter as such:
 //
 //	type T struct {
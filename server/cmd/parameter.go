@@ -17,6 +17,17 @@ type Parameter interface {
 	Type() string
 }
 
+// Completer may be implemented by a Parameter to supply values that show up as suggestions client-side while
+// the parameter is being typed, such as the names of warps or arenas. Unlike the options of an Enum, the
+// argument passed is not limited to these values and is still parsed using Parameter.Parse. The values may
+// change at any time, after which they are resent to the client automatically.
+type Completer interface {
+	Parameter
+	// Completions returns the values suggested client-side for the parameter. The provided Source can be used
+	// to change the values for each player.
+	Completions(source Source) []string
+}
+
 // Enum is an interface for enum-type parameters. Users may have types as command parameters that implement
 // this parameter in order to allow a specific set of options only.
 // Enum implementations must be of the type string, for example:
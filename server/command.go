@@ -0,0 +1,215 @@
+package server
+
+import (
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/session"
+	"strings"
+)
+
+// commands returns the administration commands of the Server, so that a Server may be managed without any
+// plugins. The commands are indexed by their name and aliases and are only available to players of the
+// Server, rather than being registered globally using cmd.Register.
+func (srv *Server) commands() map[string]cmd.Command {
+	commands := []cmd.Command{
+		cmd.New("list", "Lists the players on the server.", nil, listCommand{srv: srv}),
+		cmd.New("kick", "Kicks a player from the server.", nil, kickCommand{operatorCommand: operatorCommand{srv: srv}}),
+		cmd.New("stop", "Stops the server.", nil, stopCommand{operatorCommand: operatorCommand{srv: srv}}),
+		cmd.New("op", "Grants operator status to a player.", nil, opCommand{operatorCommand: operatorCommand{srv: srv}}),
+		cmd.New("deop", "Revokes operator status from a player.", nil, deopCommand{operatorCommand: operatorCommand{srv: srv}}),
+		cmd.New("maintenance", "Manages the maintenance mode of the server.", nil,
+			maintenanceCommand{operatorCommand: operatorCommand{srv: srv}},
+			maintenanceExemptCommand{operatorCommand: operatorCommand{srv: srv}},
+		),
+	}
+	m := make(map[string]cmd.Command, len(commands))
+	for _, c := range commands {
+		m[c.Name()] = c
+		for _, alias := range c.Aliases() {
+			m[alias] = c
+		}
+	}
+	return m
+}
+
+// operatorCommand may be embedded by commands that may only be run by operators. Sources other than players,
+// such as a console, are always allowed to run these commands.
+type operatorCommand struct {
+	srv *Server
+}
+
+// Allow ...
+func (c operatorCommand) Allow(src cmd.Source) bool {
+	p, ok := src.(*player.Player)
+	return !ok || c.srv.Operator(p.XUID())
+}
+
+// listCommand implements the /list command, which lists all players online. Vanished players are only listed
+// if the source is able to see them.
+type listCommand struct {
+	srv *Server
+}
+
+// Run ...
+func (c listCommand) Run(src cmd.Source, o *cmd.Output) {
+	viewer, isPlayer := src.(*player.Player)
+	players := c.srv.Players()
+	names := make([]string, 0, len(players))
+	for _, p := range players {
+		if isPlayer && p != viewer && p.Vanished() && !viewer.VanishBypass() {
+			continue
+		}
+		names = append(names, p.Name())
+	}
+	o.Printf("There are %v/%v players online:", len(names), c.srv.MaxPlayerCount())
+	o.Print(strings.Join(names, ", "))
+}
+
+// kickCommand implements the /kick command, which disconnects players from the server.
+type kickCommand struct {
+	operatorCommand
+	Targets []cmd.Target              `cmd:"name"`
+	Reason  cmd.Optional[cmd.Varargs] `cmd:"reason"`
+}
+
+// Run ...
+func (c kickCommand) Run(_ cmd.Source, o *cmd.Output) {
+	reason := string(c.Reason.LoadOr("Kicked by an operator."))
+	for _, t := range c.Targets {
+		p, ok := t.(*player.Player)
+		if !ok {
+			continue
+		}
+		p.Disconnect(session.Disconnection{Reason: session.DisconnectReasonKicked, Details: reason})
+		o.Printf("Kicked %v from the game: '%v'", p.Name(), reason)
+	}
+}
+
+// stopCommand implements the /stop command, which closes the server.
+type stopCommand struct {
+	operatorCommand
+}
+
+// Run ...
+func (c stopCommand) Run(_ cmd.Source, o *cmd.Output) {
+	o.Print("Stopping the server...")
+	// The source may be a player, whose connection is closed when the server closes, so the server is closed
+	// on a different goroutine.
+	go func() {
+		_ = c.srv.Close()
+	}()
+}
+
+// opCommand implements the /op command, which grants operator status to online players. Because operators
+// are identified by their XUID, only players that are online can be made operator.
+type opCommand struct {
+	operatorCommand
+	Targets []cmd.Target `cmd:"player"`
+}
+
+// Run ...
+func (c opCommand) Run(_ cmd.Source, o *cmd.Output) {
+	for _, t := range c.Targets {
+		p, ok := t.(*player.Player)
+		if !ok {
+			continue
+		}
+		if c.srv.Operator(p.XUID()) {
+			o.Errorf("Could not op (already op or higher): %v", p.Name())
+			continue
+		}
+		if err := c.srv.SetOperator(p.XUID(), p.Name(), true); err != nil {
+			o.Errorf("Could not op %v: %v", p.Name(), err)
+			continue
+		}
+		o.Printf("Opped: %v", p.Name())
+	}
+}
+
+// deopCommand implements the /deop command, which revokes operator status from a player. The player is
+// looked up by name among the players online first, and among the names operators had when they were made
+// operator otherwise, so that players that are offline may also be de-opped.
+type deopCommand struct {
+	operatorCommand
+	Player string `cmd:"player"`
+}
+
+// Run ...
+func (c deopCommand) Run(_ cmd.Source, o *cmd.Output) {
+	xuid, ok := c.srv.ops.xuid(c.Player)
+	if p, online := c.srv.PlayerByName(c.Player); online && c.srv.Operator(p.XUID()) {
+		xuid, ok = p.XUID(), true
+	}
+	if !ok {
+		o.Errorf("Could not de-op (not an op): %v", c.Player)
+		return
+	}
+	if err := c.srv.SetOperator(xuid, c.Player, false); err != nil {
+		o.Errorf("Could not de-op %v: %v", c.Player, err)
+		return
+	}
+	o.Printf("De-opped: %v", c.Player)
+}
+
+// maintenanceCommand implements the /maintenance command, which enables or disables maintenance mode of the
+// server. If no state is passed, the current state is printed.
+type maintenanceCommand struct {
+	operatorCommand
+	State cmd.Optional[maintenanceState] `cmd:"state"`
+}
+
+// Run ...
+func (c maintenanceCommand) Run(_ cmd.Source, o *cmd.Output) {
+	state, ok := c.State.Load()
+	if !ok {
+		if c.srv.Maintenance() {
+			o.Print("Maintenance mode is enabled.")
+		} else {
+			o.Print("Maintenance mode is disabled.")
+		}
+		return
+	}
+	if err := c.srv.SetMaintenance(state == "on"); err != nil {
+		o.Errorf("Could not save maintenance mode: %v", err)
+	}
+	o.Printf("Turned maintenance mode %v.", state)
+}
+
+// maintenanceExemptCommand implements the /maintenance exempt subcommand, which adds or removes players from
+// the list of players that may join while the server is in maintenance mode.
+type maintenanceExemptCommand struct {
+	operatorCommand
+	Exempt cmd.SubCommand          `cmd:"exempt"`
+	Action maintenanceExemptAction `cmd:"action"`
+	Player string                  `cmd:"player"`
+}
+
+// Run ...
+func (c maintenanceExemptCommand) Run(_ cmd.Source, o *cmd.Output) {
+	if err := c.srv.SetMaintenanceExempt(c.Player, c.Action == "add"); err != nil {
+		o.Errorf("Could not save maintenance exemptions: %v", err)
+	}
+	if c.Action == "add" {
+		o.Printf("Exempted %v from maintenance mode.", c.Player)
+		return
+	}
+	o.Printf("Removed maintenance exemption of %v.", c.Player)
+}
+
+// maintenanceState is the state argument of the /maintenance command.
+type maintenanceState string
+
+// Type ...
+func (maintenanceState) Type() string { return "MaintenanceState" }
+
+// Options ...
+func (maintenanceState) Options(cmd.Source) []string { return []string{"on", "off"} }
+
+// maintenanceExemptAction is the action argument of the /maintenance exempt command.
+type maintenanceExemptAction string
+
+// Type ...
+func (maintenanceExemptAction) Type() string { return "MaintenanceExemptAction" }
+
+// Options ...
+func (maintenanceExemptAction) Options(cmd.Source) []string { return []string{"add", "remove"} }
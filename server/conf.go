@@ -2,14 +2,13 @@ package server
 
 import (
 	"fmt"
-	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/internal/packbuilder"
 	"github.com/df-mc/dragonfly/server/player"
 	"github.com/df-mc/dragonfly/server/player/playerdb"
 	"github.com/df-mc/dragonfly/server/session"
 	"github.com/df-mc/dragonfly/server/world"
-	"github.com/df-mc/dragonfly/server/world/biome"
 	"github.com/df-mc/dragonfly/server/world/generator"
 	"github.com/df-mc/dragonfly/server/world/mcdb"
 	"github.com/google/uuid"
@@ -18,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 )
 
 // Config contains options for starting a Minecraft server.
@@ -82,9 +82,9 @@ type Config struct {
 	ReadOnlyWorld bool
 	// Generator should return a function that specifies the world.Generator to
 	// use for every world.Dimension (world.Overworld, world.Nether and
-	// world.End). If left empty, Generator will be set to a flat world for each
-	// of the dimensions (with netherrack and end stone for nether/end
-	// respectively).
+	// world.End). If left empty, Generator will be set to noise-based
+	// generators of the overworld, nether and end, using the seed of the
+	// WorldProvider.
 	Generator func(dim world.Dimension) world.Generator
 	// RandomTickSpeed specifies the rate at which blocks should be ticked in
 	// the default worlds. Setting this value to -1 or lower will stop random
@@ -92,6 +92,78 @@ type Config struct {
 	// left as 0, the RandomTickSpeed will default to a speed of 3 blocks per
 	// sub chunk per tick (normal ticking speed).
 	RandomTickSpeed int
+	// EntityTickBudget limits the time spent ticking entities in the default
+	// worlds every tick. When exceeded, distant entities are ticked less
+	// often and item entities stop merging until the world recovers. The zero
+	// value disables the budget.
+	EntityTickBudget world.EntityTickBudget
+	// Watchdog monitors the duration of the ticks of the default worlds. Slow
+	// ticks are logged with a dump of all goroutines and, if configured, make
+	// a world take emergency measures such as pausing chunk generation. The
+	// zero value disables the watchdog.
+	Watchdog world.Watchdog
+	// Despawn holds the lifetimes of dropped items, arrows and experience orbs
+	// in the default worlds. The zero value results in the vanilla lifetimes,
+	// such as 5 minutes for dropped items.
+	Despawn world.DespawnConfig
+	// EntityCaps holds hard caps on the amount of monsters, animals, items
+	// and projectiles in each of the default worlds and in each of their
+	// chunks. The zero value does not cap any entities.
+	EntityCaps world.EntityCaps
+	// Griefing holds the policy on blocks in the default worlds being
+	// destroyed or changed by explosions, mobs and fire. The zero value allows
+	// all griefing.
+	Griefing world.GriefingConfig
+	// HorizontalBound is the maximum absolute X and Z block coordinate of the
+	// default worlds. Players cannot move beyond it and no chunks are
+	// generated past it. If left as 0, a bound of 30,000,000 blocks is used,
+	// which is also the maximum bound.
+	HorizontalBound int
+	// WorldRange should return the vertical range in blocks of the default
+	// world of the world.Dimension passed. Blocks cannot be placed or
+	// generated outside this range. The range is clamped to that of the
+	// dimension and rounded outwards to whole sub chunks. If left empty, the
+	// range of each dimension is used, such as -64 to 319 for the overworld.
+	WorldRange func(dim world.Dimension) cube.Range
+	// MovementRewindHistory, if set to a value higher than 0, makes players
+	// use server authoritative movement with rewind. Clients keep a history
+	// of their movement of MovementRewindHistory ticks, so that movement the
+	// server disagrees with, for example because player.Handler.HandleMove
+	// was cancelled, is corrected by rewinding the client to the position the
+	// server expects, rather than by teleporting it. If left as 0, movement
+	// is corrected by teleporting the player.
+	MovementRewindHistory int
+	// ResumeTimeout is the grace period during which a player that lost its
+	// connection is kept in the world. If the same client connects again
+	// within the ResumeTimeout, it takes control of the same player again,
+	// keeping its position, inventories and other state. Players that are
+	// disconnected or transferred by the server are never kept. If left as
+	// 0, players are removed from the world as soon as their connection is
+	// lost.
+	ResumeTimeout time.Duration
+	// OperatorsFile is the path of the JSON file that the XUIDs and names of
+	// operators of the Server are stored in. Operators may run administration
+	// commands, such as /kick and /stop. If left empty, operators are not
+	// saved and are lost when the Server is closed.
+	OperatorsFile string
+	// Maintenance specifies if the Server starts in maintenance mode. While
+	// in maintenance mode, only operators and players in MaintenanceExempt
+	// may join the Server. Maintenance mode may be toggled at runtime using
+	// Server.SetMaintenance or the /maintenance command.
+	Maintenance bool
+	// MaintenanceMessage is the message shown to players that are refused or
+	// kicked because the Server is in maintenance mode. If left empty, a
+	// default message is shown.
+	MaintenanceMessage string
+	// MaintenanceExempt holds the names of players, other than operators,
+	// that may join the Server while it is in maintenance mode.
+	MaintenanceExempt []string
+	// MaintenanceFile is the path of the JSON file that the maintenance mode
+	// state and the players exempt from it are stored in, so that changes
+	// made at runtime are kept after a restart. If the file exists, the state
+	// stored in it is used instead of Maintenance and MaintenanceExempt. If
+	// left empty, the state is not saved.
+	MaintenanceFile string
 	// Entities is a world.EntityRegistry with all entity types registered that
 	// may be added to the Server's worlds. If no entity types are registered,
 	// Entities will be set to entity.DefaultRegistry.
@@ -131,7 +203,15 @@ func (conf Config) New() *Server {
 		conf.WorldProvider = world.NopProvider{}
 	}
 	if conf.Generator == nil {
-		conf.Generator = loadGenerator
+		seed := conf.WorldProvider.Settings().Seed
+		conf.Generator = func(dim world.Dimension) world.Generator {
+			return loadGenerator(dim, seed)
+		}
+	}
+	if conf.WorldRange == nil {
+		conf.WorldRange = func(dim world.Dimension) cube.Range {
+			return dim.Range()
+		}
 	}
 	if conf.MaxChunkRadius == 0 {
 		conf.MaxChunkRadius = 12
@@ -151,13 +231,23 @@ func (conf Config) New() *Server {
 		conf:     conf,
 		incoming: make(chan *session.Session),
 		p:        make(map[uuid.UUID]*player.Player),
+		detached: make(map[uuid.UUID]*time.Timer),
 		world:    &world.World{}, nether: &world.World{}, end: &world.World{},
 	}
 	srv.world = srv.createWorld(world.Overworld, &srv.nether, &srv.end)
 	srv.nether = srv.createWorld(world.Nether, &srv.world, &srv.end)
 	srv.end = srv.createWorld(world.End, &srv.nether, &srv.world)
 
+	var err error
+	if srv.ops, err = loadOperators(conf.OperatorsFile); err != nil {
+		conf.Log.Errorf("Error loading operators: %v", err)
+	}
+	if srv.maintenance, err = loadMaintenance(conf.MaintenanceFile, conf.Maintenance, conf.MaintenanceExempt); err != nil {
+		conf.Log.Errorf("Error loading maintenance state: %v", err)
+	}
+
 	srv.registerTargetFunc()
+	srv.cmds = srv.commands()
 	srv.checkNetIsolation()
 
 	return srv
@@ -177,6 +267,25 @@ type UserConfig struct {
 	Server struct {
 		// Name is the name of the server as it shows up in the server list.
 		Name string
+		// OperatorsFile is the JSON file that the XUIDs and names of operators
+		// of the server are stored in. Operators are allowed to run
+		// administration commands such as /kick and /stop. Players may be made
+		// operator using /op, which may also be run from the console.
+		OperatorsFile string
+		// Maintenance controls whether the server starts in maintenance mode,
+		// in which only operators and players in MaintenanceExempt may join.
+		Maintenance bool
+		// MaintenanceMessage is the message shown to players that cannot join
+		// because the server is in maintenance mode. Leave this empty to use
+		// the default message.
+		MaintenanceMessage string
+		// MaintenanceExempt is a list of names of players that may join while
+		// the server is in maintenance mode.
+		MaintenanceExempt []string
+		// MaintenanceFile is the JSON file that the maintenance mode state is
+		// stored in once it is changed using /maintenance. If the file exists,
+		// it is used instead of Maintenance and MaintenanceExempt.
+		MaintenanceFile string
 		// ShutdownMessage is the message shown to players when the server shuts
 		// down. If empty, players will be directed to the menu screen right
 		// away.
@@ -251,6 +360,11 @@ func (uc UserConfig) Config(log Logger) (Config, error) {
 		QuitMessage:             uc.Server.QuitMessage,
 		ShutdownMessage:         uc.Server.ShutdownMessage,
 		DisableResourceBuilding: !uc.Resources.AutoBuildPack,
+		OperatorsFile:           uc.Server.OperatorsFile,
+		Maintenance:             uc.Server.Maintenance,
+		MaintenanceMessage:      uc.Server.MaintenanceMessage,
+		MaintenanceExempt:       uc.Server.MaintenanceExempt,
+		MaintenanceFile:         uc.Server.MaintenanceFile,
 	}
 	if uc.World.SaveData {
 		conf.WorldProvider, err = mcdb.Config{Log: log}.Open(uc.World.Folder)
@@ -290,15 +404,16 @@ func loadResources(dir string) ([]*resource.Pack, error) {
 	return packs, nil
 }
 
-// loadGenerator loads a standard world.Generator for a world.Dimension.
-func loadGenerator(dim world.Dimension) world.Generator {
+// loadGenerator loads a standard world.Generator for a world.Dimension. The
+// seed passed is used for generators that generate terrain based on noise.
+func loadGenerator(dim world.Dimension, seed int64) world.Generator {
 	switch dim {
 	case world.Overworld:
-		return generator.NewFlat(biome.Plains{}, []world.Block{block.Grass{}, block.Dirt{}, block.Dirt{}, block.Bedrock{}})
+		return generator.NewOverworld(seed)
 	case world.Nether:
-		return generator.NewFlat(biome.NetherWastes{}, []world.Block{block.Netherrack{}, block.Netherrack{}, block.Netherrack{}, block.Bedrock{}})
+		return generator.NewNether(seed)
 	case world.End:
-		return generator.NewFlat(biome.End{}, []world.Block{block.EndStone{}, block.EndStone{}, block.EndStone{}, block.Bedrock{}})
+		return generator.NewEnd(seed)
 	}
 	panic("should never happen")
 }
@@ -308,6 +423,8 @@ func DefaultConfig() UserConfig {
 	c := UserConfig{}
 	c.Network.Address = ":19132"
 	c.Server.Name = "Dragonfly Server"
+	c.Server.OperatorsFile = "ops.json"
+	c.Server.MaintenanceFile = "maintenance.json"
 	c.Server.ShutdownMessage = "Server closed."
 	c.Server.AuthEnabled = true
 	c.Server.JoinMessage = "%v has joined the game"
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"bufio"
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"io"
+	"strings"
+)
+
+// console is the cmd.Source of commands executed using Server.ExecuteCommand. Unlike players, the console may
+// run all commands of the Server, which allows it to make the first players of a Server operator using /op.
+type console struct {
+	srv *Server
+}
+
+// Name returns the name of the console as a command source.
+func (console) Name() string {
+	return "Console"
+}
+
+// Position returns the spawn position of the overworld of the Server.
+func (c console) Position() mgl64.Vec3 {
+	return c.srv.world.Spawn().Vec3Middle()
+}
+
+// World returns the overworld of the Server.
+func (c console) World() *world.World {
+	return c.srv.world
+}
+
+// SendCommandOutput logs the messages and errors of the cmd.Output passed to the Logger of the Server.
+func (c console) SendCommandOutput(o *cmd.Output) {
+	for _, m := range o.Messages() {
+		c.srv.conf.Log.Infof("%v", m)
+	}
+	for _, err := range o.Errors() {
+		c.srv.conf.Log.Errorf("%v", err)
+	}
+}
+
+// ExecuteCommand executes the command line passed as the console of the Server. The command line may start
+// with a '/', but does not have to. Both the administration commands of the Server, such as /op, and commands
+// registered using cmd.Register may be executed. Output of the command is written to the Logger of the Server.
+func (srv *Server) ExecuteCommand(commandLine string) {
+	args := strings.Split(strings.TrimPrefix(strings.TrimSpace(commandLine), "/"), " ")
+	if args[0] == "" {
+		return
+	}
+	command, ok := srv.cmds[args[0]]
+	if !ok {
+		if command, ok = cmd.ByAlias(args[0]); !ok {
+			srv.conf.Log.Errorf("Unknown command: %v.", args[0])
+			return
+		}
+	}
+	command.Execute(strings.Join(args[1:], " "), console{srv: srv})
+}
+
+// ReadCommands reads command lines from the io.Reader passed, such as os.Stdin, and executes every line using
+// ExecuteCommand. ReadCommands blocks until the io.Reader returns io.EOF or another error, which is returned
+// if it is not io.EOF.
+func (srv *Server) ReadCommands(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		srv.ExecuteCommand(scanner.Text())
+	}
+	return scanner.Err()
+}
@@ -41,9 +41,15 @@ type PickedUpAction struct {
 	action
 }
 
+// TotemUseAction is a world.EntityAction that makes an entity display the animation of a totem of undying being used.
+type TotemUseAction struct{ action }
+
 // FireworkExplosionAction is a world.EntityAction that makes a Firework rocket display an explosion particle.
 type FireworkExplosionAction struct{ action }
 
+// WakeUpAction is a world.EntityAction that makes an entity wake up after sleeping in a bed.
+type WakeUpAction struct{ action }
+
 // action implements the Action interface. Structures in this package may embed it to gets its functionality
 // out of the box.
 type action struct{}
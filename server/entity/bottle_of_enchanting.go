@@ -45,6 +45,9 @@ func (BottleOfEnchantingType) Glint() bool {
 func (BottleOfEnchantingType) EncodeEntity() string {
 	return "minecraft:xp_bottle"
 }
+func (BottleOfEnchantingType) EntityCategory() world.EntityCategory {
+	return world.EntityCategoryProjectile
+}
 func (BottleOfEnchantingType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
 }
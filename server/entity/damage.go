@@ -37,6 +37,10 @@ type (
 	// lightning.
 	LightningDamageSource struct{}
 
+	// FreezingDamageSource is used for damage caused by an entity being
+	// fully frozen in powder snow.
+	FreezingDamageSource struct{}
+
 	// ProjectileDamageSource is used for damage caused by a projectile.
 	ProjectileDamageSource struct {
 		// Projectile and Owner are the world.Entity that dealt the damage and
@@ -45,7 +49,11 @@ type (
 	}
 
 	// ExplosionDamageSource is used for damage caused by an explosion.
-	ExplosionDamageSource struct{}
+	ExplosionDamageSource struct {
+		// Owner is the world.Entity responsible for the explosion, such as
+		// the entity that ignited TNT. Owner may be nil.
+		Owner world.Entity
+	}
 )
 
 func (FallDamageSource) ReducedByArmour() bool     { return false }
@@ -73,6 +81,9 @@ func (SuffocationDamageSource) Fire() bool                { return false }
 func (DrowningDamageSource) ReducedByResistance() bool    { return false }
 func (DrowningDamageSource) ReducedByArmour() bool        { return false }
 func (DrowningDamageSource) Fire() bool                   { return false }
+func (FreezingDamageSource) ReducedByResistance() bool    { return true }
+func (FreezingDamageSource) ReducedByArmour() bool        { return false }
+func (FreezingDamageSource) Fire() bool                   { return false }
 func (ProjectileDamageSource) ReducedByResistance() bool  { return true }
 func (ProjectileDamageSource) ReducedByArmour() bool      { return true }
 func (ProjectileDamageSource) Fire() bool                 { return false }
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// DetectionRange returns the range in blocks within which the world.Entity passed may be noticed and targeted
+// by a mob with a base detection range of r. Sneaking entities are noticed from 80% of the range. Invisible
+// entities are noticed from only 70% of the range, multiplied by the fraction of armour pieces they wear,
+// with a minimum of 10%, so that an invisible entity without armour is noticed from only 7% of the range.
+func DetectionRange(e world.Entity, r float64) float64 {
+	if s, ok := e.(interface{ Sneaking() bool }); ok && s.Sneaking() {
+		r *= 0.8
+	}
+	if i, ok := e.(interface{ Invisible() bool }); ok && i.Invisible() {
+		worn := 0
+		if a, ok := e.(interface{ Armour() *inventory.Armour }); ok {
+			worn = len(a.Armour().Items())
+		}
+		r *= 0.7 * max(float64(worn)/4, 0.1)
+	}
+	return r
+}
@@ -134,3 +134,14 @@ func (m *EffectManager) Tick(entity Living) {
 func (m *EffectManager) expired(e effect.Effect) bool {
 	return e.Duration() <= 0
 }
+
+// effectLevel returns the level of the effect with the type passed that the Living entity passed has. False is
+// returned if the entity does not have the effect.
+func effectLevel(l Living, t effect.Type) (int, bool) {
+	for _, e := range l.Effects() {
+		if e.Type() == t {
+			return e.Level(), true
+		}
+	}
+	return 0, false
+}
@@ -12,18 +12,29 @@ type HealthBoost struct {
 
 // Start ...
 func (HealthBoost) Start(e world.Entity, lvl int) {
-	if l, ok := e.(living); ok {
+	if b, ok := e.(boostable); ok {
+		b.SetMaxHealthBoost(4 * float64(lvl))
+	} else if l, ok := e.(living); ok {
 		l.SetMaxHealth(l.MaxHealth() + 4*float64(lvl))
 	}
 }
 
 // End ...
 func (HealthBoost) End(e world.Entity, lvl int) {
-	if l, ok := e.(living); ok {
+	if b, ok := e.(boostable); ok {
+		b.SetMaxHealthBoost(0)
+	} else if l, ok := e.(living); ok {
 		l.SetMaxHealth(l.MaxHealth() - 4*float64(lvl))
 	}
 }
 
+// boostable represents an entity of which the maximum health may be boosted temporarily, separately from its
+// base maximum health.
+type boostable interface {
+	// SetMaxHealthBoost sets the health added to the maximum health of the entity.
+	SetMaxHealthBoost(boost float64)
+}
+
 // RGBA ...
 func (HealthBoost) RGBA() color.RGBA {
 	return color.RGBA{R: 0xf8, G: 0x7d, B: 0x23, A: 0xff}
@@ -14,12 +14,18 @@ type Hunger struct {
 // Apply ...
 func (Hunger) Apply(e world.Entity, lvl int, _ time.Duration) {
 	if i, ok := e.(interface {
-		Exhaust(points float64)
+		Exhaust(points float64, src world.ExhaustionSource)
 	}); ok {
-		i.Exhaust(float64(lvl) * 0.005)
+		i.Exhaust(float64(lvl)*0.005, HungerExhaustionSource{})
 	}
 }
 
+// HungerExhaustionSource is used for exhaustion caused by the Hunger effect.
+type HungerExhaustionSource struct{}
+
+// ExhaustionSource ...
+func (HungerExhaustionSource) ExhaustionSource() {}
+
 // RGBA ...
 func (Hunger) RGBA() color.RGBA {
 	return color.RGBA{R: 0x58, G: 0x76, B: 0x53, A: 0xff}
@@ -6,7 +6,8 @@ import (
 )
 
 // Invisibility is a lasting effect that causes the affected entity to turn invisible. While invisible, the
-// entity's armour is still visible and effect particles will still be displayed.
+// entity's armour and held items are still visible and effect particles will still be displayed. Invisible
+// entities are harder for mobs to notice, as described in entity.DetectionRange.
 type Invisibility struct {
 	nopLasting
 }
@@ -25,7 +25,8 @@ var eggConf = ProjectileBehaviourConfig{
 // EggType is a world.EntityType implementation for Egg.
 type EggType struct{}
 
-func (EggType) EncodeEntity() string { return "minecraft:egg" }
+func (EggType) EncodeEntity() string                 { return "minecraft:egg" }
+func (EggType) EntityCategory() world.EntityCategory { return world.EntityCategoryProjectile }
 func (EggType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
 }
@@ -43,7 +43,8 @@ func teleport(e *Ent, target trace.Result) {
 // EnderPearlType is a world.EntityType implementation for EnderPearl.
 type EnderPearlType struct{}
 
-func (EnderPearlType) EncodeEntity() string { return "minecraft:ender_pearl" }
+func (EnderPearlType) EncodeEntity() string                 { return "minecraft:ender_pearl" }
+func (EnderPearlType) EntityCategory() world.EntityCategory { return world.EntityCategoryProjectile }
 func (EnderPearlType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
 }
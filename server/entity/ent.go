@@ -63,6 +63,15 @@ func (e *Ent) Behaviour() Behaviour {
 	return e.conf.Behaviour
 }
 
+// Owner returns the owner of the entity, such as the entity that shot a
+// projectile. Nil is returned if the Behaviour of the entity has no owner.
+func (e *Ent) Owner() world.Entity {
+	if o, ok := e.conf.Behaviour.(interface{ Owner() world.Entity }); ok {
+		return o.Owner()
+	}
+	return nil
+}
+
 // Position returns the current position of the entity.
 func (e *Ent) Position() mgl64.Vec3 {
 	e.mu.Lock()
@@ -86,6 +95,22 @@ func (e *Ent) SetVelocity(v mgl64.Vec3) {
 	e.vel = v
 }
 
+// Teleport teleports the entity to the position passed. Unlike movement of the entity through its Behaviour,
+// the entity is shown at the new position immediately and its velocity is reset.
+func (e *Ent) Teleport(pos mgl64.Vec3) {
+	w := e.World()
+	if w == nil {
+		return
+	}
+	pos = w.ClampPosition(pos)
+	for _, v := range w.Viewers(e.Position()) {
+		v.ViewEntityTeleport(e, pos)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pos, e.vel = pos, mgl64.Vec3{}
+}
+
 // Rotation returns the rotation of the entity.
 func (e *Ent) Rotation() cube.Rotation {
 	e.mu.Lock()
@@ -159,12 +184,12 @@ func (e *Ent) SetNameTag(s string) {
 }
 
 // Tick ticks Ent, progressing its lifetime and closing the entity if it is
-// in the void.
+// in the void or beyond the horizontal bounds of the world.
 func (e *Ent) Tick(w *world.World, current int64) {
 	e.mu.Lock()
-	y := e.pos[1]
+	pos := e.pos
 	e.mu.Unlock()
-	if y < float64(w.Range()[0]) && current%10 == 0 {
+	if (pos[1] < float64(w.Range()[0]) || w.ClampPosition(pos) != pos) && current%10 == 0 {
 		_ = e.Close()
 		return
 	}
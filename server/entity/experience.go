@@ -60,7 +60,7 @@ func (e *ExperienceManager) SetLevel(level int) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	_, progress := progressFromExperience(e.total())
-	e.experience = experienceForLevels(level) + int(float64(experienceForLevel(level))*progress)
+	e.experience = ExperienceForLevels(level) + int(float64(ExperienceForLevel(level))*progress)
 }
 
 // Progress returns the progress towards the next level.
@@ -79,8 +79,8 @@ func (e *ExperienceManager) SetProgress(progress float64) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	currentLevel, _ := progressFromExperience(e.total())
-	progressExp := float64(experienceForLevel(currentLevel)) * progress
-	e.experience = experienceForLevels(currentLevel) + int(progressExp)
+	progressExp := float64(ExperienceForLevel(currentLevel)) * progress
+	e.experience = ExperienceForLevels(currentLevel) + int(progressExp)
 	e.d = progressExp - math.Trunc(progressExp)
 }
 
@@ -91,12 +91,18 @@ func (e *ExperienceManager) Reset() {
 	e.experience, e.d = 0, 0
 }
 
+// LevelFromExperience returns the level and the progress towards the next level that an amount of experience in
+// total amounts to. It is the inverse of ExperienceForLevels.
+func LevelFromExperience(experience int) (level int, progress float64) {
+	return progressFromExperience(float64(experience))
+}
+
 // progressFromExperience returns the level and progress from the total experience given.
 func progressFromExperience(experience float64) (level int, progress float64) {
 	var a, b, c float64
-	if experience <= float64(experienceForLevels(16)) {
+	if experience <= float64(ExperienceForLevels(16)) {
 		a, b = 1.0, 6.0
-	} else if experience <= float64(experienceForLevels(31)) {
+	} else if experience <= float64(ExperienceForLevels(31)) {
 		a, b, c = 2.5, -40.5, 360.0
 	} else {
 		a, b, c = 4.5, -162.5, 2220.0
@@ -112,8 +118,8 @@ func progressFromExperience(experience float64) (level int, progress float64) {
 	return int(sol), sol - math.Trunc(sol)
 }
 
-// experienceForLevels calculates the amount of experience needed in total to reach a certain level.
-func experienceForLevels(level int) int {
+// ExperienceForLevels calculates the amount of experience needed in total to reach a certain level.
+func ExperienceForLevels(level int) int {
 	if level <= 16 {
 		return level*level + level*6
 	} else if level <= 31 {
@@ -122,8 +128,8 @@ func experienceForLevels(level int) int {
 	return int(float64(level*level)*4.5 - 162.5*float64(level) + 2220)
 }
 
-// experienceForLevel returns the amount experience needed to reach level + 1.
-func experienceForLevel(level int) int {
+// ExperienceForLevel returns the amount of experience needed to reach level + 1.
+func ExperienceForLevel(level int) int {
 	if level <= 15 {
 		return 2*level + 7
 	} else if level <= 30 {
@@ -5,6 +5,7 @@ import (
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"math"
 	"slices"
 	"time"
 )
@@ -56,7 +57,7 @@ func (ExperienceOrbType) DecodeNBT(m map[string]any) world.Entity {
 func (ExperienceOrbType) EncodeNBT(e world.Entity) map[string]any {
 	orb := e.(*Ent)
 	return map[string]any{
-		"Age":    int16(orb.Age() / (time.Second * 20)),
+		"Age":    int16(min(orb.Age()/(time.Second/20), math.MaxInt16)),
 		"Value":  int32(orb.Behaviour().(*ExperienceOrbBehaviour).Experience()),
 		"Pos":    nbtconv.Vec3ToFloat32Slice(orb.Position()),
 		"Motion": nbtconv.Vec3ToFloat32Slice(orb.Velocity()),
@@ -16,8 +16,10 @@ type ExperienceOrbBehaviourConfig struct {
 	// Drag is used to reduce all axes of the velocity every tick. Velocity is
 	// multiplied with (1-Drag) every tick.
 	Drag float64
-	// ExistenceDuration specifies how long the experience orb should last. The
-	// default is time.Minute * 5.
+	// ExistenceDuration specifies how long the experience orb should last. If
+	// left as 0, the experience orb lifetime of the world.DespawnConfig of the
+	// world that the orb is in is used, which is time.Minute * 5 by default. If
+	// negative, the orb never despawns.
 	ExistenceDuration time.Duration
 	// Experience is the amount of experience held by the orb. Default is 1.
 	Experience int
@@ -28,15 +30,11 @@ func (conf ExperienceOrbBehaviourConfig) New() *ExperienceOrbBehaviour {
 	if conf.Experience == 0 {
 		conf.Experience = 1
 	}
-	if conf.ExistenceDuration == 0 {
-		conf.ExistenceDuration = time.Minute * 5
-	}
 	b := &ExperienceOrbBehaviour{conf: conf, lastSearch: time.Now()}
 	b.passive = PassiveBehaviourConfig{
-		Gravity:           conf.Gravity,
-		Drag:              conf.Drag,
-		ExistenceDuration: conf.ExistenceDuration,
-		Tick:              b.tick,
+		Gravity: conf.Gravity,
+		Drag:    conf.Drag,
+		Tick:    b.tick,
 	}.New()
 	return b
 }
@@ -61,11 +59,25 @@ func (exp *ExperienceOrbBehaviour) Tick(e *Ent) *Movement {
 	return exp.passive.Tick(e)
 }
 
+// Lifetime returns the duration that the experience orb exists for before
+// despawning, taking the world.DespawnConfig of the world of the entity e into
+// account. A negative duration is returned if the orb never despawns.
+func (exp *ExperienceOrbBehaviour) Lifetime(e *Ent) time.Duration {
+	if exp.conf.ExistenceDuration != 0 {
+		return exp.conf.ExistenceDuration
+	}
+	return e.World().Despawn().ExperienceOrb()
+}
+
 // followBox is the bounding box used to search for collectors to follow for experience orbs.
 var followBox = cube.Box(-8, -8, -8, 8, 8, 8)
 
 // tick finds a target for the experience orb and moves the orb towards it.
 func (exp *ExperienceOrbBehaviour) tick(e *Ent) {
+	if d := exp.Lifetime(e); d >= 0 && e.Age() > d {
+		_ = e.Close()
+		return
+	}
 	w, pos := e.World(), e.Position()
 	if exp.target != nil && (exp.target.Dead() || exp.target.World() != w || pos.Sub(exp.target.Position()).Len() > 8) {
 		exp.target = nil
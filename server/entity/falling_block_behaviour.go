@@ -52,15 +52,22 @@ func (f *FallingBlockBehaviour) Tick(e *Ent) *Movement {
 func (f *FallingBlockBehaviour) tick(e *Ent) {
 	pos := e.Position()
 	bpos, w := cube.PosFromVec3(pos), e.World()
-	if a, ok := f.block.(Solidifiable); (ok && a.Solidifies(bpos, w)) || f.passive.mc.OnGround() {
+	if a, ok := f.block.(Solidifiable); ok && a.Solidifies(bpos, w) {
+		if h, ok := f.block.(hardenable); ok {
+			// The block solidified in a liquid, such as concrete powder falling into water, so it lands as its
+			// hardened form.
+			f.block = h.Harden()
+		}
+		f.solidify(e, pos, w)
+	} else if f.passive.mc.OnGround() {
 		f.solidify(e, pos, w)
 	}
 }
 
 // solidify attempts to solidify the falling block at the position passed. It
 // also deals damage to any entities standing at that position. If the block at
-// the position could not be replaced by the falling block, the block will drop
-// as an item.
+// the position could not be replaced by the falling block, or the change was
+// cancelled, the block will drop as an item.
 func (f *FallingBlockBehaviour) solidify(e *Ent, pos mgl64.Vec3, w *world.World) {
 	bpos := cube.PosFromVec3(pos)
 
@@ -72,10 +79,11 @@ func (f *FallingBlockBehaviour) solidify(e *Ent, pos mgl64.Vec3, w *world.World)
 	}
 	f.passive.close = true
 
-	if r, ok := w.Block(bpos).(replaceable); ok && r.ReplaceableBy(f.block) {
-		w.SetBlock(bpos, f.block, nil)
-	} else if i, ok := f.block.(world.Item); ok {
-		w.AddEntity(NewItem(item.NewStack(i, 1), bpos.Vec3Middle()))
+	if r, ok := w.Block(bpos).(replaceable); ok && r.ReplaceableBy(f.block) && w.SetBlockByEntity(e, bpos, f.block) {
+		return
+	}
+	if i, ok := f.block.(world.Item); ok {
+		w.AddEntityUncapped(NewItem(item.NewStack(i, 1), bpos.Vec3Middle()))
 	}
 }
 
@@ -110,6 +118,12 @@ type Solidifiable interface {
 	Solidifies(pos cube.Pos, w *world.World) bool
 }
 
+// hardenable represents a Solidifiable block that turns into a different block when it solidifies, such as
+// concrete powder turning into concrete.
+type hardenable interface {
+	Harden() world.Block
+}
+
 type replaceable interface {
 	ReplaceableBy(b world.Block) bool
 }
@@ -28,11 +28,24 @@ func NewFireworkAttached(pos mgl64.Vec3, rot cube.Rotation, firework item.Firewo
 	return e
 }
 
+// NewFireworkShot creates a firework entity that is shot with a constant
+// velocity, such as by a crossbow. Unlike normal fireworks, it does not
+// accelerate while flying.
+func NewFireworkShot(pos, vel mgl64.Vec3, rot cube.Rotation, firework item.Firework, owner world.Entity) *Ent {
+	e := Config{Behaviour: FireworkBehaviourConfig{
+		ExistenceDuration:          firework.RandomisedDuration(),
+		SidewaysVelocityMultiplier: 1,
+	}.New(firework, owner)}.New(FireworkType{}, pos)
+	e.rot, e.vel = rot, vel
+	return e
+}
+
 // FireworkType is a world.EntityType implementation for Firework.
 type FireworkType struct{}
 
-func (FireworkType) EncodeEntity() string        { return "minecraft:fireworks_rocket" }
-func (FireworkType) BBox(world.Entity) cube.BBox { return cube.BBox{} }
+func (FireworkType) EncodeEntity() string                 { return "minecraft:fireworks_rocket" }
+func (FireworkType) EntityCategory() world.EntityCategory { return world.EntityCategoryProjectile }
+func (FireworkType) BBox(world.Entity) cube.BBox          { return cube.BBox{} }
 
 func (FireworkType) DecodeNBT(m map[string]any) world.Entity {
 	f := NewFirework(
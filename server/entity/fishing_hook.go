@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/cube/trace"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// NewFishingHook creates a fishing hook entity, cast by the owner using a
+// fishing rod. Fishing hooks only hurt and knock back the entities they hit
+// if world.CombatConfig.FishingRodHits is set for the world they are in.
+func NewFishingHook(pos mgl64.Vec3, owner world.Entity) *Ent {
+	return Config{Behaviour: fishingHookConf.New(owner)}.New(FishingHookType{}, pos)
+}
+
+var fishingHookConf = ProjectileBehaviourConfig{
+	Gravity:               0.03,
+	Drag:                  0.08,
+	Damage:                -1,
+	Hit:                   hitFishingHook,
+	SurviveBlockCollision: true,
+	DisablePickup:         true,
+}
+
+// hitFishingHook is called when a fishing hook hits a target. If the target is
+// a Living entity and fishing rod hits are enabled in the world, the entity is
+// hurt without taking damage and knocked back, like it would be by a snowball.
+func hitFishingHook(e *Ent, target trace.Result) {
+	r, ok := target.(trace.EntityResult)
+	if !ok || !e.World().Combat().FishingRodHits {
+		return
+	}
+	l, ok := r.Entity().(Living)
+	if !ok {
+		return
+	}
+	src := ProjectileDamageSource{Projectile: e, Owner: e.Owner()}
+	if _, vulnerable := l.Hurt(0, src); vulnerable {
+		l.KnockBack(e.Position(), 0.45, 0.3608)
+	}
+}
+
+// FishingHookType is a world.EntityType implementation for fishing hooks.
+// Fishing hooks are not saved to the world.
+type FishingHookType struct{}
+
+func (FishingHookType) EncodeEntity() string                 { return "minecraft:fishing_hook" }
+func (FishingHookType) EntityCategory() world.EntityCategory { return world.EntityCategoryProjectile }
+func (FishingHookType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
+}
@@ -1,12 +1,17 @@
 package entity
 
-import "sync"
+import (
+	"math"
+	"sync"
+)
 
 // HealthManager handles the health of an entity.
 type HealthManager struct {
-	mu     sync.RWMutex
-	health float64
-	max    float64
+	mu         sync.RWMutex
+	health     float64
+	max        float64
+	boost      float64
+	absorption float64
 }
 
 // NewHealthManager returns a new health manager with the health and max health provided.
@@ -34,22 +39,31 @@ func (m *HealthManager) AddHealth(health float64) {
 	l := m.health + health
 	if l < 0 {
 		l = 0
-	} else if l > m.max {
-		l = m.max
+	} else if max := m.maxHealth(); l > max {
+		l = max
 	}
 	m.health = l
 }
 
-// MaxHealth returns the maximum health of the entity.
+// MaxHealth returns the maximum health of the entity, including the boost set using SetMaxHealthBoost.
 func (m *HealthManager) MaxHealth() float64 {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	return m.maxHealth()
+}
+
+// BaseMaxHealth returns the maximum health of the entity without the boost set using SetMaxHealthBoost.
+func (m *HealthManager) BaseMaxHealth() float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	return m.max
 }
 
 // SetMaxHealth changes the max health of an entity to the maximum passed. If the maximum is set to 0 or
-// lower, SetMaxHealth will default to a value of 1.
+// lower, SetMaxHealth will default to a value of 1. Any boost set using SetMaxHealthBoost is added on top
+// of the maximum passed.
 func (m *HealthManager) SetMaxHealth(max float64) {
 	if max <= 0 {
 		max = 1
@@ -57,7 +71,61 @@ func (m *HealthManager) SetMaxHealth(max float64) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.max = max
-	if m.health > max {
-		m.health = max
-	}
+	m.health = math.Min(m.health, m.maxHealth())
+}
+
+// MaxHealthBoost returns the health added to the maximum health of the entity, for example by the health
+// boost effect.
+func (m *HealthManager) MaxHealthBoost() float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.boost
+}
+
+// SetMaxHealthBoost sets the health added to the maximum health of the entity. Unlike SetMaxHealth, the
+// boost is temporary and is not included in BaseMaxHealth. A negative boost lowers the maximum health, which
+// will never drop below 1.
+func (m *HealthManager) SetMaxHealthBoost(boost float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.boost = boost
+	m.health = math.Min(m.health, m.maxHealth())
+}
+
+// maxHealth returns the max health including the boost. It must be called while holding the lock of the
+// HealthManager.
+func (m *HealthManager) maxHealth() float64 {
+	return math.Max(m.max+m.boost, 1)
+}
+
+// Absorption returns the absorption health of the entity. Absorption health is lost before regular health
+// when the entity is damaged and does not regenerate.
+func (m *HealthManager) Absorption() float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.absorption
+}
+
+// SetAbsorption sets the absorption health of the entity. Negative values are treated as 0.
+func (m *HealthManager) SetAbsorption(health float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.absorption = math.Max(health, 0)
+}
+
+// Damage deals damage to the entity. The damage is first subtracted from the absorption health, after which
+// any damage left is subtracted from the regular health. Damage returns the damage that was subtracted from
+// the regular health.
+func (m *HealthManager) Damage(dmg float64) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	absorbed := math.Min(m.absorption, dmg)
+	m.absorption -= absorbed
+	dmg -= absorbed
+
+	m.health = math.Max(m.health-dmg, 0)
+	return dmg
 }
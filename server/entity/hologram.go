@@ -0,0 +1,257 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"slices"
+	"sync"
+)
+
+// DefaultHologramLineSpacing is the default vertical distance in blocks between two lines of a Hologram.
+const DefaultHologramLineSpacing = 0.3
+
+// HologramViewer is a viewer that a Hologram may be hidden from, such as a *player.Player.
+type HologramViewer interface {
+	// HideEntity hides the world.Entity passed from the viewer.
+	HideEntity(e world.Entity)
+	// ShowEntity shows a world.Entity previously hidden using HideEntity to the viewer.
+	ShowEntity(e world.Entity)
+}
+
+// Hologram is floating text made up of one or more lines, such as a leaderboard or a sign displaying
+// information at spawn. Each line is displayed by a separate, invisible entity, and lines are stacked
+// downwards from the position of the Hologram. Holograms are not saved to the world.
+// Hologram is safe for concurrent usage.
+type Hologram struct {
+	mu      sync.Mutex
+	w       *world.World
+	pos     mgl64.Vec3
+	spacing float64
+	text    []string
+	lines   []*Ent
+	hidden  map[HologramViewer]struct{}
+}
+
+// NewHologram creates a new Hologram with the lines of text passed. The first line is displayed at the position
+// passed, with the other lines below it. The Hologram must be spawned in a world using Hologram.Spawn.
+func NewHologram(pos mgl64.Vec3, lines ...string) *Hologram {
+	return &Hologram{pos: pos, spacing: DefaultHologramLineSpacing, text: slices.Clone(lines), hidden: make(map[HologramViewer]struct{})}
+}
+
+// Spawn spawns the Hologram in the world passed. If the Hologram was already spawned in a world, it is removed
+// from that world first.
+func (h *Hologram) Spawn(w *world.World) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.despawn()
+	h.w = w
+	for i, text := range h.text {
+		h.spawnLine(i, text)
+	}
+}
+
+// Remove removes the Hologram from the world it was spawned in. The Hologram may be spawned again using
+// Hologram.Spawn.
+func (h *Hologram) Remove() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.despawn()
+	h.w = nil
+}
+
+// World returns the world that the Hologram is spawned in, or nil if it is not spawned.
+func (h *Hologram) World() *world.World {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.w
+}
+
+// Position returns the position of the first line of the Hologram.
+func (h *Hologram) Position() mgl64.Vec3 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.pos
+}
+
+// Lines returns the lines of text of the Hologram.
+func (h *Hologram) Lines() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.text)
+}
+
+// SetLines replaces all lines of the Hologram with the lines passed. Lines that already exist are updated
+// in-place, without respawning them.
+func (h *Hologram) SetLines(lines ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i := len(lines); i < len(h.lines); i++ {
+		h.removeLine(h.lines[i])
+	}
+	if len(h.lines) > len(lines) {
+		h.lines = h.lines[:len(lines)]
+	}
+	h.text = slices.Clone(lines)
+	h.respawnStale()
+	for i, text := range h.text {
+		if i < len(h.lines) {
+			h.lines[i].SetNameTag(text)
+			continue
+		}
+		h.spawnLine(i, text)
+	}
+}
+
+// SetLine changes the text of the line at the index passed. If the index is beyond the last line of the
+// Hologram, empty lines are added up to the index.
+func (h *Hologram) SetLine(index int, text string) {
+	if index < 0 {
+		return
+	}
+	lines := h.Lines()
+	for len(lines) <= index {
+		lines = append(lines, "")
+	}
+	lines[index] = text
+	h.SetLines(lines...)
+}
+
+// AddLine adds a line of text below the last line of the Hologram.
+func (h *Hologram) AddLine(text string) {
+	h.SetLines(append(h.Lines(), text)...)
+}
+
+// RemoveLine removes the line at the index passed. Lines below it are moved up. RemoveLine does nothing if no
+// line exists at the index.
+func (h *Hologram) RemoveLine(index int) {
+	lines := h.Lines()
+	if index < 0 || index >= len(lines) {
+		return
+	}
+	h.SetLines(slices.Delete(lines, index, index+1)...)
+}
+
+// SetLineSpacing changes the vertical distance in blocks between two lines of the Hologram. By default, this is
+// DefaultHologramLineSpacing.
+func (h *Hologram) SetLineSpacing(spacing float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.spacing = spacing
+	h.moveLines()
+}
+
+// Move moves the Hologram so that its first line is at the position passed.
+func (h *Hologram) Move(pos mgl64.Vec3) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.pos = pos
+	h.moveLines()
+}
+
+// HideFrom hides the Hologram from the HologramViewer passed, so that its lines are no longer displayed to it.
+// Lines added afterwards are also hidden from the viewer.
+func (h *Hologram) HideFrom(v HologramViewer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hidden[v] = struct{}{}
+	h.respawnStale()
+	for _, line := range h.lines {
+		v.HideEntity(line)
+	}
+}
+
+// ShowTo shows the Hologram to a HologramViewer that it was previously hidden from using HideFrom.
+func (h *Hologram) ShowTo(v HologramViewer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.hidden[v]; !ok {
+		return
+	}
+	delete(h.hidden, v)
+	h.respawnStale()
+	for _, line := range h.lines {
+		v.ShowEntity(line)
+	}
+}
+
+// HiddenFrom checks if the Hologram is hidden from the HologramViewer passed.
+func (h *Hologram) HiddenFrom(v HologramViewer) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, ok := h.hidden[v]
+	return ok
+}
+
+// spawnLine spawns a new line entity with the text passed at the index passed and adds it to the lines of the
+// Hologram. spawnLine must only be called while holding h.mu.
+func (h *Hologram) spawnLine(index int, text string) {
+	if h.w == nil {
+		return
+	}
+	h.lines = append(h.lines, h.newLine(index, text))
+}
+
+// newLine creates a line entity with the text passed at the index passed and adds it to the world of the
+// Hologram. newLine must only be called while holding h.mu.
+func (h *Hologram) newLine(index int, text string) *Ent {
+	e := Config{Behaviour: textConf.New()}.New(HologramLineType{}, h.linePos(index))
+	e.name = text
+	for v := range h.hidden {
+		v.HideEntity(e)
+	}
+	h.w.AddEntity(e)
+	return e
+}
+
+// respawnStale replaces line entities that are no longer in the world of the Hologram with new ones. Lines are
+// not saved, so they are closed when the chunk they are in is unloaded. respawnStale must only be called while
+// holding h.mu.
+func (h *Hologram) respawnStale() {
+	if h.w == nil {
+		return
+	}
+	for i, line := range h.lines {
+		if line.World() != h.w {
+			h.lines[i] = h.newLine(i, h.text[i])
+		}
+	}
+}
+
+// removeLine removes the line entity passed from the world. removeLine must only be called while holding h.mu.
+func (h *Hologram) removeLine(e *Ent) {
+	if e.World() != nil {
+		e.World().RemoveEntity(e)
+	}
+}
+
+// despawn removes all line entities of the Hologram from its world. despawn must only be called while holding
+// h.mu.
+func (h *Hologram) despawn() {
+	for _, line := range h.lines {
+		h.removeLine(line)
+	}
+	h.lines = nil
+}
+
+// moveLines teleports all line entities to their current positions. moveLines must only be called while
+// holding h.mu.
+func (h *Hologram) moveLines() {
+	h.respawnStale()
+	for i, line := range h.lines {
+		line.Teleport(h.linePos(i))
+	}
+}
+
+// linePos returns the position of the line at the index passed.
+func (h *Hologram) linePos(index int) mgl64.Vec3 {
+	return h.pos.Sub(mgl64.Vec3{0, float64(index) * h.spacing})
+}
+
+// HologramLineType is a world.EntityType implementation for the entities displaying the lines of a Hologram.
+// Unlike TextType, it is not saved to the world.
+type HologramLineType struct{}
+
+func (HologramLineType) EncodeEntity() string        { return "dragonfly:hologram_line" }
+func (HologramLineType) BBox(world.Entity) cube.BBox { return cube.BBox{} }
+func (HologramLineType) NetworkEncodeEntity() string { return "minecraft:falling_block" }
@@ -6,6 +6,8 @@ import (
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"math"
 	"time"
 )
 
@@ -26,6 +28,17 @@ func NewItemPickupDelay(i item.Stack, pos mgl64.Vec3, delay time.Duration) *Ent
 	return Config{Behaviour: config.New(i)}.New(ItemType{}, pos)
 }
 
+// NewItemConf creates a new item entity containing item stack i using the
+// ItemBehaviourConfig passed, which may be used to set the owner and thrower
+// of the item and restrict who may pick it up. If the Gravity and Drag of the
+// config are left at 0, the defaults of item entities are used.
+func NewItemConf(conf ItemBehaviourConfig, i item.Stack, pos mgl64.Vec3) *Ent {
+	if conf.Gravity == 0 && conf.Drag == 0 {
+		conf.Gravity, conf.Drag = itemConf.Gravity, itemConf.Drag
+	}
+	return Config{Behaviour: conf.New(i)}.New(ItemType{}, pos)
+}
+
 var itemConf = ItemBehaviourConfig{
 	Gravity: 0.04,
 	Drag:    0.02,
@@ -34,8 +47,9 @@ var itemConf = ItemBehaviourConfig{
 // ItemType is a world.EntityType implementation for Item.
 type ItemType struct{}
 
-func (ItemType) EncodeEntity() string   { return "minecraft:item" }
-func (ItemType) NetworkOffset() float64 { return 0.125 }
+func (ItemType) EncodeEntity() string                 { return "minecraft:item" }
+func (ItemType) EntityCategory() world.EntityCategory { return world.EntityCategoryItem }
+func (ItemType) NetworkOffset() float64               { return 0.125 }
 func (ItemType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
 }
@@ -45,7 +59,13 @@ func (ItemType) DecodeNBT(m map[string]any) world.Entity {
 	if i.Empty() {
 		return nil
 	}
-	n := NewItem(i, nbtconv.Vec3(m, "Pos"))
+	conf := itemConf
+	conf.Owner, _ = uuid.Parse(nbtconv.String(m, "Owner"))
+	conf.Thrower, _ = uuid.Parse(nbtconv.String(m, "Thrower"))
+	conf.OwnerOnly = nbtconv.Bool(m, "OwnerOnly")
+	conf.OthersPickupDelay = nbtconv.TickDuration[int32](m, "OthersPickupDelay")
+	conf.ExistenceDuration = nbtconv.TickDuration[int32](m, "Lifetime")
+	n := Config{Behaviour: conf.New(i)}.New(ItemType{}, nbtconv.Vec3(m, "Pos"))
 	n.SetVelocity(nbtconv.Vec3(m, "Motion"))
 	n.age = time.Duration(nbtconv.Int16(m, "Age")) * (time.Second / 20)
 	n.Behaviour().(*ItemBehaviour).pickupDelay = time.Duration(nbtconv.Int64(m, "PickupDelay")) * (time.Second / 20)
@@ -55,12 +75,27 @@ func (ItemType) DecodeNBT(m map[string]any) world.Entity {
 func (ItemType) EncodeNBT(e world.Entity) map[string]any {
 	it := e.(*Ent)
 	b := it.Behaviour().(*ItemBehaviour)
-	return map[string]any{
+	data := map[string]any{
 		"Health":      int16(5),
-		"Age":         int16(it.Age() / (time.Second * 20)),
-		"PickupDelay": int64(b.pickupDelay / (time.Second * 20)),
+		"Age":         int16(min(it.Age()/(time.Second/20), math.MaxInt16)),
+		"PickupDelay": int64(b.pickupDelay / (time.Second / 20)),
 		"Pos":         nbtconv.Vec3ToFloat32Slice(it.Position()),
 		"Motion":      nbtconv.Vec3ToFloat32Slice(it.Velocity()),
 		"Item":        nbtconv.WriteItem(b.Item(), true),
 	}
+	if b.conf.Owner != uuid.Nil {
+		data["Owner"], data["OwnerOnly"] = b.conf.Owner.String(), boolByte(b.conf.OwnerOnly)
+		if d := b.conf.OthersPickupDelay - it.Age(); d > 0 {
+			data["OthersPickupDelay"] = int32(d / (time.Second / 20))
+		}
+	}
+	if b.conf.ExistenceDuration > 0 {
+		data["Lifetime"] = int32(b.conf.ExistenceDuration / (time.Second / 20))
+	} else if b.conf.ExistenceDuration < 0 {
+		data["Lifetime"] = int32(-1)
+	}
+	if b.conf.Thrower != uuid.Nil {
+		data["Thrower"] = b.conf.Thrower.String()
+	}
+	return data
 }
@@ -1,10 +1,14 @@
 package entity
 
 import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
 	"math"
 	"time"
 )
@@ -16,12 +20,28 @@ type ItemBehaviourConfig struct {
 	// Drag is used to reduce all axes of the velocity every tick. Velocity is
 	// multiplied with (1-Drag) every tick.
 	Drag float64
-	// ExistenceDuration specifies how long the item stack should last. The
-	// default is time.Minute * 5.
+	// ExistenceDuration specifies how long the item stack should last before
+	// it despawns. If left as 0, the item lifetime of the world.DespawnConfig
+	// of the world that the item is in is used, which is time.Minute * 5 by
+	// default. If negative, the item never despawns.
 	ExistenceDuration time.Duration
 	// PickupDelay specifies how much time must expire before the item can be
 	// picked up by collectors. The default is time.Second / 2.
 	PickupDelay time.Duration
+	// Owner is the UUID of the entity that owns the item, such as the player
+	// whose death dropped it. If left as uuid.Nil, the item has no owner and
+	// OwnerOnly and OthersPickupDelay have no effect.
+	Owner uuid.UUID
+	// Thrower is the UUID of the entity that threw or dropped the item, if
+	// any. Thrower does not restrict who may pick up the item.
+	Thrower uuid.UUID
+	// OwnerOnly specifies if the item may only be picked up by its Owner.
+	// Other collectors, including hoppers, leave the item on the ground.
+	OwnerOnly bool
+	// OthersPickupDelay specifies for how long after the item is spawned only
+	// its Owner may pick it up. After this window, any collector may pick up
+	// the item, unless OwnerOnly is set.
+	OthersPickupDelay time.Duration
 }
 
 // New creates an ItemBehaviour using i and the optional parameters in conf.
@@ -34,16 +54,12 @@ func (conf ItemBehaviourConfig) New(i item.Stack) *ItemBehaviour {
 	if conf.PickupDelay == 0 {
 		conf.PickupDelay = time.Second / 2
 	}
-	if conf.ExistenceDuration == 0 {
-		conf.ExistenceDuration = time.Minute * 5
-	}
 
 	b := &ItemBehaviour{conf: conf, i: i, pickupDelay: conf.PickupDelay}
 	b.passive = PassiveBehaviourConfig{
-		Gravity:           conf.Gravity,
-		Drag:              conf.Drag,
-		ExistenceDuration: conf.ExistenceDuration,
-		Tick:              b.tick,
+		Gravity: conf.Gravity,
+		Drag:    conf.Drag,
+		Tick:    b.tick,
 	}.New()
 	return b
 }
@@ -55,6 +71,9 @@ type ItemBehaviour struct {
 	i       item.Stack
 
 	pickupDelay time.Duration
+	// lifetimeStart is the age of the entity at which its current lifetime
+	// started. It is reset when the despawning of the item is cancelled.
+	lifetimeStart time.Duration
 }
 
 // Item returns the item.Stack held by the entity.
@@ -62,21 +81,102 @@ func (i *ItemBehaviour) Item() item.Stack {
 	return i.i
 }
 
+// Owner returns the UUID of the entity that owns the item. uuid.Nil is
+// returned if the item has no owner.
+func (i *ItemBehaviour) Owner() uuid.UUID {
+	return i.conf.Owner
+}
+
+// Thrower returns the UUID of the entity that threw or dropped the item.
+// uuid.Nil is returned if the item was not thrown by an entity.
+func (i *ItemBehaviour) Thrower() uuid.UUID {
+	return i.conf.Thrower
+}
+
+// OwnerOnly checks if the item may only be picked up by its owner.
+func (i *ItemBehaviour) OwnerOnly() bool {
+	return i.conf.Owner != uuid.Nil && i.conf.OwnerOnly
+}
+
+// CanCollect checks if the Collector passed is allowed to pick up the item
+// held by the entity e, taking its owner into account. The pickup delay is not
+// checked.
+func (i *ItemBehaviour) CanCollect(e *Ent, collector Collector) bool {
+	if i.conf.Owner == uuid.Nil {
+		return true
+	}
+	if c, ok := collector.(interface{ UUID() uuid.UUID }); ok && c.UUID() == i.conf.Owner {
+		return true
+	}
+	return i.othersMayCollect(e)
+}
+
+// othersMayCollect checks if collectors other than the owner of the item, such
+// as hoppers, may pick up the item held by the entity e.
+func (i *ItemBehaviour) othersMayCollect(e *Ent) bool {
+	return i.conf.Owner == uuid.Nil || (!i.conf.OwnerOnly && e.Age() >= i.conf.OthersPickupDelay)
+}
+
+// spawn creates a new item entity holding the item stack passed at the
+// position passed, which keeps the ownership of the item entity e. It is used
+// when an item entity is split or merged.
+func (i *ItemBehaviour) spawn(e *Ent, s item.Stack, pos mgl64.Vec3) *Ent {
+	conf := itemConf
+	conf.Owner, conf.Thrower, conf.OwnerOnly = i.conf.Owner, i.conf.Thrower, i.conf.OwnerOnly
+	conf.ExistenceDuration = i.conf.ExistenceDuration
+	if conf.OthersPickupDelay = i.conf.OthersPickupDelay - e.Age(); conf.OthersPickupDelay < 0 {
+		conf.OthersPickupDelay = 0
+	}
+	return Config{Behaviour: conf.New(s)}.New(ItemType{}, pos)
+}
+
 // Tick moves the entity, checks if it should be picked up by a nearby collector
 // or if it should merge with nearby item entities.
 func (i *ItemBehaviour) Tick(e *Ent) *Movement {
 	return i.passive.Tick(e)
 }
 
+// Lifetime returns the duration that the item exists for before despawning,
+// taking the world.DespawnConfig of the world of the entity e into account. A
+// negative duration is returned if the item never despawns.
+func (i *ItemBehaviour) Lifetime(e *Ent) time.Duration {
+	if i.conf.ExistenceDuration != 0 {
+		return i.conf.ExistenceDuration
+	}
+	return e.World().Despawn().Item()
+}
+
 // tick checks if the item can be picked up or merged with nearby item stacks.
 func (i *ItemBehaviour) tick(e *Ent) {
+	if i.despawn(e) || i.checkCactus(e) {
+		return
+	}
 	if i.pickupDelay == 0 {
+		if i.checkHopper(e) {
+			return
+		}
 		i.checkNearby(e)
 	} else if i.pickupDelay < math.MaxInt16*(time.Second/20) {
 		i.pickupDelay -= time.Second / 20
 	}
 }
 
+// despawn closes the entity if it has existed for longer than its lifetime,
+// unless the world.Handler of its world cancels it. True is returned if the
+// entity was closed.
+func (i *ItemBehaviour) despawn(e *Ent) bool {
+	if d := i.Lifetime(e); d < 0 || e.Age()-i.lifetimeStart <= d {
+		return false
+	}
+	ctx := event.C()
+	if e.World().Handler().HandleItemDespawn(ctx, e); ctx.Cancelled() {
+		i.lifetimeStart = e.Age()
+		return false
+	}
+	_ = e.Close()
+	return true
+}
+
 // checkNearby checks the nearby entities for item collectors and other item
 // stacks. If a collector is found in range, the item will be picked up. If
 // another item stack with the same item type is found in range, the item
@@ -93,11 +193,14 @@ func (i *ItemBehaviour) checkNearby(e *Ent) {
 			continue
 		}
 		if collector, ok := other.(Collector); ok {
+			if !i.CanCollect(e, collector) {
+				continue
+			}
 			// A collector was within range to pick up the entity.
 			i.collect(e, collector)
 			return
-		} else if _, ok := other.Type().(ItemType); ok {
-			// Another item entity was in range to merge with.
+		} else if _, ok := other.Type().(ItemType); ok && !w.Overloaded() {
+			// Another item entity was in range to merge with. Merging is skipped while the world is overloaded.
 			if i.merge(e, other.(*Ent)) {
 				return
 			}
@@ -105,6 +208,58 @@ func (i *ItemBehaviour) checkNearby(e *Ent) {
 	}
 }
 
+// checkHopper checks if the item entity is inside or directly above a hopper. If so, the hopper collects as much
+// of the item as possible. True is returned if the item entity was closed as a result.
+func (i *ItemBehaviour) checkHopper(e *Ent) bool {
+	w, pos := e.World(), e.Position()
+	if !i.othersMayCollect(e) {
+		return false
+	}
+	for _, hopperPos := range []cube.Pos{cube.PosFromVec3(pos), cube.PosFromVec3(pos.Sub(mgl64.Vec3{0, 0.75}))} {
+		h, ok := w.Block(hopperPos).(block.Hopper)
+		if !ok {
+			continue
+		}
+		n := h.Collect(i.i)
+		if n == 0 {
+			return false
+		}
+		if n < i.i.Count() {
+			// Create a new item entity and shrink it by the amount of items that the hopper collected.
+			w.AddEntityUncapped(i.spawn(e, i.i.Grow(-n), pos))
+		}
+		_ = e.Close()
+		return true
+	}
+	return false
+}
+
+// checkCactus checks if the item entity is touching a cactus. If so, the item entity is destroyed and true is
+// returned.
+func (i *ItemBehaviour) checkCactus(e *Ent) bool {
+	w := e.World()
+	box := e.Type().BBox(e).Translate(e.Position()).Grow(0.01)
+	min, max := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+	for y := min[1]; y <= max[1]; y++ {
+		for x := min[0]; x <= max[0]; x++ {
+			for z := min[2]; z <= max[2]; z++ {
+				pos := cube.Pos{x, y, z}
+				c, ok := w.Block(pos).(block.Cactus)
+				if !ok {
+					continue
+				}
+				for _, bb := range c.Model().BBox(pos, w) {
+					if bb.Translate(pos.Vec3()).IntersectsWith(box) {
+						_ = e.Close()
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
 // merge merges the item entity with another item entity.
 func (i *ItemBehaviour) merge(e *Ent, other *Ent) bool {
 	w, pos := e.World(), e.Position()
@@ -114,16 +269,21 @@ func (i *ItemBehaviour) merge(e *Ent, other *Ent) bool {
 		// change anything any way, other the stack types weren't comparable.
 		return false
 	}
+	if i.conf.Owner != otherBehaviour.conf.Owner || i.conf.OwnerOnly != otherBehaviour.conf.OwnerOnly {
+		// Items with different owners are never merged, so that neither owner
+		// loses their items.
+		return false
+	}
 	a, b := otherBehaviour.i.AddStack(i.i)
 
-	newA := NewItem(a, other.Position())
+	newA := otherBehaviour.spawn(other, a, other.Position())
 	newA.SetVelocity(other.Velocity())
-	w.AddEntity(newA)
+	w.AddEntityUncapped(newA)
 
 	if !b.Empty() {
-		newB := NewItem(b, pos)
+		newB := i.spawn(e, b, pos)
 		newB.SetVelocity(e.Velocity())
-		w.AddEntity(newB)
+		w.AddEntityUncapped(newB)
 	}
 	_ = e.Close()
 	_ = other.Close()
@@ -148,7 +308,7 @@ func (i *ItemBehaviour) collect(e *Ent, collector Collector) {
 	}
 	// Create a new item entity and shrink it by the amount of items that the
 	// collector collected.
-	w.AddEntity(NewItem(i.i.Grow(-n), pos))
+	w.AddEntityUncapped(i.spawn(e, i.i.Grow(-n), pos))
 	_ = e.Close()
 }
 
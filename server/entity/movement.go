@@ -2,6 +2,7 @@ package entity
 
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"math"
@@ -67,7 +68,7 @@ func (c *MovementComputer) TickMovement(e world.Entity, pos, vel mgl64.Vec3, rot
 	viewers := w.Viewers(pos)
 
 	velBefore := vel
-	vel = c.applyHorizontalForces(w, pos, c.applyVerticalForces(vel))
+	vel = c.applyHorizontalForces(w, pos, c.applyVerticalForces(e, vel))
 	dPos, vel := c.checkCollision(e, pos, vel)
 
 	return &Movement{v: viewers, e: e,
@@ -87,12 +88,25 @@ var zeroVec3 mgl64.Vec3
 // epsilon is the epsilon used for thresholds for change used for change in position and velocity.
 const epsilon = 0.001
 
-// applyVerticalForces applies gravity and drag on the Y axis, based on the Gravity and Drag values set.
-func (c *MovementComputer) applyVerticalForces(vel mgl64.Vec3) mgl64.Vec3 {
+// applyVerticalForces applies gravity and drag on the Y axis, based on the Gravity and Drag values set. If the
+// entity is a Living entity with the Levitation effect, it drifts upwards instead. With the SlowFalling effect,
+// gravity is reduced while the entity is falling.
+func (c *MovementComputer) applyVerticalForces(e world.Entity, vel mgl64.Vec3) mgl64.Vec3 {
+	gravity := c.Gravity
+	if l, ok := e.(Living); ok {
+		if lvl, ok := effectLevel(l, effect.Levitation{}); ok {
+			// Levitation accelerates the entity towards an upward speed of 0.05 blocks per tick per level.
+			vel[1] += (0.05*float64(lvl) - vel[1]) * 0.2
+			return vel
+		}
+		if _, ok := effectLevel(l, effect.SlowFalling{}); ok && vel[1] <= 0 {
+			gravity = math.Min(gravity, 0.01)
+		}
+	}
 	if c.DragBeforeGravity {
 		vel[1] *= 1 - c.Drag
 	}
-	vel[1] -= c.Gravity
+	vel[1] -= gravity
 	if !c.DragBeforeGravity {
 		vel[1] *= 1 - c.Drag
 	}
@@ -184,7 +198,7 @@ func blockBBoxsAround(e world.Entity, box cube.BBox) []cube.BBox {
 		for x := minX; x <= maxX; x++ {
 			for z := minZ; z <= maxZ; z++ {
 				pos := cube.Pos{x, y, z}
-				boxes := w.Block(pos).Model().BBox(pos, w)
+				boxes := world.EntityBBox(w.Block(pos).Model(), pos, w, e)
 				for _, box := range boxes {
 					blockBBoxs = append(blockBBoxs, box.Translate(mgl64.Vec3{float64(x), float64(y), float64(z)}))
 				}
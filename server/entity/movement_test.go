@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/go-gl/mathgl/mgl64"
+	"testing"
+	"time"
+)
+
+// effectLiving is a Living entity that only implements the Effects method, used to test how effects change
+// the movement of entities.
+type effectLiving struct {
+	Living
+	effects []effect.Effect
+}
+
+// Effects ...
+func (l effectLiving) Effects() []effect.Effect {
+	return l.effects
+}
+
+func TestLevitationUpwardDrift(t *testing.T) {
+	c := &MovementComputer{Gravity: 0.08, Drag: 0.02, DragBeforeGravity: true}
+	e := effectLiving{effects: []effect.Effect{effect.New(effect.Levitation{}, 1, time.Minute)}}
+
+	vel := mgl64.Vec3{}
+	for i := 0; i < 100; i++ {
+		vel = c.applyVerticalForces(e, vel)
+	}
+	if !mgl64.FloatEqualThreshold(vel[1], 0.05, 1e-6) {
+		t.Fatalf("levitating entity should drift upwards at 0.05 blocks per tick, got %v", vel[1])
+	}
+}
+
+func TestSlowFallingFallSpeed(t *testing.T) {
+	c := &MovementComputer{Gravity: 0.08, Drag: 0.02, DragBeforeGravity: true}
+	normal := c.applyVerticalForces(effectLiving{}, mgl64.Vec3{})
+	slow := c.applyVerticalForces(effectLiving{effects: []effect.Effect{effect.New(effect.SlowFalling{}, 1, time.Minute)}}, mgl64.Vec3{})
+	if slow[1] >= 0 || slow[1] <= normal[1] {
+		t.Fatalf("entity with slow falling should fall slower than %v, got %v", normal[1], slow[1])
+	}
+
+	// Slow falling does not reduce gravity while the entity is moving upwards.
+	up := mgl64.Vec3{0, 0.5}
+	if a, b := c.applyVerticalForces(effectLiving{}, up), c.applyVerticalForces(effectLiving{effects: []effect.Effect{effect.New(effect.SlowFalling{}, 1, time.Minute)}}, up); a != b {
+		t.Fatalf("slow falling should not affect upward movement, got %v instead of %v", b, a)
+	}
+}
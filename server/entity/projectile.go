@@ -10,6 +10,7 @@ import (
 	"github.com/go-gl/mathgl/mgl64"
 	"math"
 	"math/rand"
+	"slices"
 	"time"
 )
 
@@ -74,6 +75,10 @@ type ProjectileBehaviourConfig struct {
 	// PickupItem is the item that is given to a player when it picks up this
 	// projectile. If left as an empty item.Stack, no item is given upon pickup.
 	PickupItem item.Stack
+	// Piercing is the amount of entities the projectile may pass through after
+	// hitting one. Each entity is hit at most once. The default, 0, causes the
+	// projectile to stop at the first entity hit.
+	Piercing int
 }
 
 // New creates a new ProjectileBehaviour using conf. The owner passed may be nil
@@ -100,6 +105,8 @@ type ProjectileBehaviour struct {
 
 	collisionPos cube.Pos
 	collided     bool
+
+	pierced []world.Entity
 }
 
 // Owner returns the owner of the projectile.
@@ -139,7 +146,8 @@ func (lt *ProjectileBehaviour) Tick(e *Ent) *Movement {
 	if lt.collided && lt.tickAttached(e) {
 		e.mu.Unlock()
 
-		if lt.ageCollided > 1200 {
+		if d := w.Despawn().Arrow(); d >= 0 && time.Duration(lt.ageCollided)*(time.Second/20) > d {
+			// The projectile was stuck in a block for longer than its lifetime.
 			lt.close = true
 		}
 		return nil
@@ -167,10 +175,22 @@ func (lt *ProjectileBehaviour) Tick(e *Ent) *Movement {
 		if l, ok := r.Entity().(Living); ok && lt.conf.Damage >= 0 {
 			lt.hitEntity(l, e, before, vel)
 		}
+		if lt.piercing() {
+			// The projectile passes through the entity and keeps going. The
+			// entity is ignored from now on so that it is not hit again.
+			lt.pierced = append(lt.pierced, r.Entity())
+			if lt.conf.Hit != nil {
+				lt.conf.Hit(e, result)
+			}
+			return m
+		}
 	case trace.BlockResult:
 		bpos := r.BlockPosition()
 		if t, ok := w.Block(bpos).(block.TNT); ok && e.OnFireDuration() > 0 {
-			t.Ignite(bpos, w)
+			t.Ignite(bpos, w, lt.owner)
+		}
+		if h, ok := w.Block(bpos).(block.ProjectileHitter); ok {
+			h.ProjectileHit(bpos, w, e, r.Face())
 		}
 		if lt.conf.SurviveBlockCollision {
 			lt.hitBlockSurviving(e, r, m)
@@ -262,6 +282,11 @@ func (lt *ProjectileBehaviour) hitBlockSurviving(e *Ent, r trace.BlockResult, m
 // entity and knocks it back. Additionally, it applies any potion effects and
 // fire if applicable.
 func (lt *ProjectileBehaviour) hitEntity(l Living, e *Ent, origin, vel mgl64.Vec3) {
+	if lt.conf.Damage == 0 && e.World().Combat().DisableThrowableKnockBack {
+		// Projectiles such as snowballs and eggs deal no damage and only knock entities back, which is disabled
+		// in this world.
+		return
+	}
 	src := ProjectileDamageSource{Projectile: e, Owner: lt.owner}
 	dmg := math.Ceil(lt.conf.Damage * vel.Len())
 	if lt.conf.Critical {
@@ -287,7 +312,7 @@ func (lt *ProjectileBehaviour) tickMovement(e *Ent) (*Movement, trace.Result) {
 	viewers := w.Viewers(pos)
 
 	velBefore := vel
-	vel = lt.mc.applyHorizontalForces(w, pos, lt.mc.applyVerticalForces(vel))
+	vel = lt.mc.applyHorizontalForces(w, pos, lt.mc.applyVerticalForces(e, vel))
 	rot := cube.Rotation{
 		mgl64.RadToDeg(math.Atan2(vel[0], vel[2])),
 		mgl64.RadToDeg(math.Atan2(vel[1], math.Hypot(vel[0], vel[2]))),
@@ -311,7 +336,7 @@ func (lt *ProjectileBehaviour) tickMovement(e *Ent) (*Movement, trace.Result) {
 				mx, my, mz := hit.Face().Axis().Vec3().Mul(-2).Add(mgl64.Vec3{1, 1, 1}).Elem()
 
 				vel = mgl64.Vec3{x * mx, y * my, z * mz}
-			} else {
+			} else if !lt.piercing() {
 				vel = zeroVec3
 			}
 			end = hit.Position()
@@ -320,13 +345,19 @@ func (lt *ProjectileBehaviour) tickMovement(e *Ent) (*Movement, trace.Result) {
 	return &Movement{v: viewers, e: e, pos: end, vel: vel, dpos: end.Sub(pos), dvel: vel.Sub(velBefore), rot: rot}, hit
 }
 
+// piercing checks if the projectile is still able to pierce through an entity
+// it hits.
+func (lt *ProjectileBehaviour) piercing() bool {
+	return len(lt.pierced) < lt.conf.Piercing
+}
+
 // ignores returns a function to ignore entities in trace.Perform that are
-// either a spectator, not living, the entity itself or its owner in the first
-// 5 ticks.
+// either a spectator, not living, the entity itself, its owner in the first
+// 5 ticks or an entity that was already pierced.
 func (lt *ProjectileBehaviour) ignores(e *Ent) func(other world.Entity) bool {
 	return func(other world.Entity) (ignored bool) {
 		g, ok := other.(interface{ GameMode() world.GameMode })
 		_, living := other.(Living)
-		return (ok && !g.GameMode().HasCollision()) || e == other || !living || (e.age < time.Second/4 && lt.owner == other)
+		return (ok && !g.GameMode().HasCollision()) || e == other || !living || (e.age < time.Second/4 && lt.owner == other) || slices.Contains(lt.pierced, other)
 	}
 }
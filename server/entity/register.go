@@ -21,6 +21,7 @@ var DefaultRegistry = conf.New([]world.EntityType{
 	ExperienceOrbType{},
 	FallingBlockType{},
 	FireworkType{},
+	FishingHookType{},
 	ItemType{},
 	LightningType{},
 	LingeringPotionType{},
@@ -39,8 +40,8 @@ var conf = world.EntityRegistryConfig{
 	FallingBlock: func(bl world.Block, pos mgl64.Vec3) world.Entity {
 		return NewFallingBlock(bl, pos)
 	},
-	TNT: func(pos mgl64.Vec3, fuse time.Duration) world.Entity {
-		return NewTNT(pos, fuse)
+	TNT: func(pos mgl64.Vec3, fuse time.Duration, igniter world.Entity) world.Entity {
+		return NewTNTWithIgniter(pos, fuse, igniter)
 	},
 	BottleOfEnchanting: func(pos, vel mgl64.Vec3, owner world.Entity) world.Entity {
 		b := NewBottleOfEnchanting(pos, owner)
@@ -72,6 +73,11 @@ var conf = world.EntityRegistryConfig{
 	Firework: func(pos mgl64.Vec3, rot cube.Rotation, attached bool, firework world.Item, owner world.Entity) world.Entity {
 		return NewFireworkAttached(pos, rot, firework.(item.Firework), owner, attached)
 	},
+	FishingHook: func(pos, vel mgl64.Vec3, owner world.Entity) world.Entity {
+		h := NewFishingHook(pos, owner)
+		h.vel = vel
+		return h
+	},
 	LingeringPotion: func(pos, vel mgl64.Vec3, t any, owner world.Entity) world.Entity {
 		p := NewLingeringPotion(pos, owner, t.(potion.Potion))
 		p.vel = vel
@@ -90,4 +96,17 @@ var conf = world.EntityRegistryConfig{
 	Lightning: func(pos mgl64.Vec3) world.Entity {
 		return NewLightning(pos)
 	},
+	CrossbowArrow: func(pos, vel mgl64.Vec3, rot cube.Rotation, damage float64, owner world.Entity, obtainArrowOnPickup bool, piercingLevel int, tip any) world.Entity {
+		a := NewTippedArrowWithDamage(pos, rot, damage, owner, tip.(potion.Potion))
+		b := a.conf.Behaviour.(*ProjectileBehaviour)
+		b.conf.Piercing = piercingLevel
+		if obtainArrowOnPickup {
+			b.conf.PickupItem = item.NewStack(item.Arrow{Tip: tip.(potion.Potion)}, 1)
+		}
+		a.vel = vel
+		return a
+	},
+	CrossbowFirework: func(pos, vel mgl64.Vec3, rot cube.Rotation, firework world.Item, owner world.Entity) world.Entity {
+		return NewFireworkShot(pos, vel, rot, firework.(item.Firework), owner)
+	},
 }
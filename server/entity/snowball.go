@@ -23,7 +23,8 @@ var snowballConf = ProjectileBehaviourConfig{
 // SnowballType is a world.EntityType implementation for snowballs.
 type SnowballType struct{}
 
-func (SnowballType) EncodeEntity() string { return "minecraft:snowball" }
+func (SnowballType) EncodeEntity() string                 { return "minecraft:snowball" }
+func (SnowballType) EntityCategory() world.EntityCategory { return world.EntityCategoryProjectile }
 func (SnowballType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
 }
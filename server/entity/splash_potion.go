@@ -33,8 +33,9 @@ var splashPotionConf = ProjectileBehaviourConfig{
 // SplashPotionType is a world.EntityType implementation for SplashPotion.
 type SplashPotionType struct{}
 
-func (SplashPotionType) EncodeEntity() string { return "minecraft:splash_potion" }
-func (SplashPotionType) Glint() bool          { return true }
+func (SplashPotionType) EncodeEntity() string                 { return "minecraft:splash_potion" }
+func (SplashPotionType) EntityCategory() world.EntityCategory { return world.EntityCategoryProjectile }
+func (SplashPotionType) Glint() bool                          { return true }
 func (SplashPotionType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.125, 0, -0.125, 0.125, 0.25, 0.125)
 }
@@ -59,12 +59,12 @@ func potionSplash(durMul float64, pot potion.Potion, linger bool) func(e *Ent, r
 			case trace.BlockResult:
 				blockPos := result.BlockPosition().Side(result.Face())
 				if w.Block(blockPos) == fire() {
-					w.SetBlock(blockPos, nil, nil)
+					w.SetBlockByEntity(e, blockPos, nil)
 				}
 
 				for _, f := range cube.HorizontalFaces() {
 					if h := blockPos.Side(f); w.Block(h) == fire() {
-						w.SetBlock(h, nil, nil)
+						w.SetBlockByEntity(e, h, nil)
 					}
 				}
 			case trace.EntityResult:
@@ -13,8 +13,18 @@ import (
 
 // NewTNT creates a new primed TNT entity.
 func NewTNT(pos mgl64.Vec3, fuse time.Duration) *Ent {
+	return NewTNTWithIgniter(pos, fuse, nil)
+}
+
+// NewTNTWithIgniter creates a new primed TNT entity that was ignited by the
+// igniter passed. Damage dealt by the explosion of the TNT is attributed to
+// the igniter.
+func NewTNTWithIgniter(pos mgl64.Vec3, fuse time.Duration, igniter world.Entity) *Ent {
 	config := tntConf
 	config.ExistenceDuration = fuse
+	config.Expire = func(e *Ent) {
+		explodeTNT(e, igniter)
+	}
 	ent := Config{Behaviour: config.New()}.New(TNTType{}, pos)
 
 	angle := rand.Float64() * math.Pi * 2
@@ -25,12 +35,12 @@ func NewTNT(pos mgl64.Vec3, fuse time.Duration) *Ent {
 var tntConf = PassiveBehaviourConfig{
 	Gravity: 0.04,
 	Drag:    0.02,
-	Expire:  explodeTNT,
 }
 
-// explodeTNT creates an explosion at the position of e.
-func explodeTNT(e *Ent) {
-	var config block.ExplosionConfig
+// explodeTNT creates an explosion at the position of e, owned by the igniter
+// of the TNT.
+func explodeTNT(e *Ent, igniter world.Entity) {
+	config := block.ExplosionConfig{Owner: igniter}
 	config.Explode(e.World(), e.Position())
 }
 
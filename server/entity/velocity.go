@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// SetVelocity sets the velocity of the world.Entity passed and immediately shows the new velocity to all
+// viewers of the entity, so that it may be used to launch entities, for example for launch pads or jump
+// boosts. For players with a client, the velocity is sent to the client, which moves the player accordingly.
+// False is returned if the velocity of the entity cannot be changed.
+func SetVelocity(e world.Entity, vel mgl64.Vec3) bool {
+	ent, ok := e.(*Ent)
+	if !ok {
+		// Players and other entity implementations show their velocity to viewers themselves.
+		v, ok := e.(interface{ SetVelocity(vel mgl64.Vec3) })
+		if !ok || !velocityChangeable(e) {
+			return false
+		}
+		v.SetVelocity(vel)
+		return true
+	}
+	ent.SetVelocity(vel)
+	if w, ok := world.OfEntity(e); ok {
+		// Viewers would otherwise only see the new velocity after the entity's next movement tick, if at all
+		// when the change in velocity is computed relative to the velocity already set.
+		for _, v := range w.Viewers(ent.Position()) {
+			v.ViewEntityVelocity(e, vel)
+		}
+	}
+	return true
+}
+
+// velocityChangeable checks if the velocity of the world.Entity passed may currently be changed. Entities such as
+// players ignore changes in velocity while they are dead or immobile.
+func velocityChangeable(e world.Entity) bool {
+	if d, ok := e.(interface{ Dead() bool }); ok && d.Dead() {
+		return false
+	}
+	if i, ok := e.(interface{ Immobile() bool }); ok && i.Immobile() {
+		return false
+	}
+	return true
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/df-mc/dragonfly/server/block/customblock"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"golang.org/x/exp/maps"
+	"slices"
 )
 
 // Components returns all the components for the custom block, including permutations and properties.
@@ -37,8 +39,13 @@ func Components(identifier string, b world.CustomBlock) map[string]any {
 		})
 	}
 	if permutable, ok := b.(block.Permutable); ok {
-		for name, values := range permutable.States() {
-			builder.AddProperty(name, values)
+		// The properties are sorted by their names, as the client creates the states of the block in the order of
+		// its properties, which must match the order in which world.RegisterBlock registers them.
+		states := permutable.States()
+		names := maps.Keys(states)
+		slices.Sort(names)
+		for _, name := range names {
+			builder.AddProperty(name, states[name])
 		}
 		for _, permutation := range permutable.Permutations() {
 			builder.AddPermutation(permutation.Condition, componentsFromProperties(permutation.Properties))
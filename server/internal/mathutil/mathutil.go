@@ -0,0 +1,10 @@
+package mathutil
+
+// FloorDiv returns a divided by b, rounded towards negative infinity rather
+// than towards zero like the / operator. b must be positive.
+func FloorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
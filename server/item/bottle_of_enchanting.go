@@ -11,7 +11,9 @@ type BottleOfEnchanting struct{}
 // Use ...
 func (b BottleOfEnchanting) Use(w *world.World, user User, ctx *UseContext) bool {
 	create := w.EntityRegistry().Config().BottleOfEnchanting
-	w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(0.7), user))
+	if !w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(0.7), user)) {
+		return false
+	}
 	w.PlaySound(user.Position(), sound.ItemThrow{})
 
 	ctx.SubtractFromCount(1)
@@ -88,13 +88,15 @@ func (Bow) Release(releaser Releaser, duration time.Duration, ctx *UseContext) {
 		f.SetOnFire(burnDuration)
 	}
 
+	if !releaser.World().AddEntity(projectile) {
+		return
+	}
 	ctx.DamageItem(1)
 	if consume {
 		ctx.Consume(arrow.Grow(-arrow.Count() + 1))
 	}
 
 	releaser.PlaySound(sound.BowShoot{})
-	releaser.World().AddEntity(projectile)
 }
 
 // EnchantmentValue ...
@@ -10,8 +10,9 @@ import (
 
 // BucketContent is the content of a bucket.
 type BucketContent struct {
-	liquid world.Liquid
-	milk   bool
+	liquid     world.Liquid
+	milk       bool
+	powderSnow bool
 }
 
 // LiquidBucketContent returns a new BucketContent with the liquid passed in.
@@ -24,6 +25,16 @@ func MilkBucketContent() BucketContent {
 	return BucketContent{milk: true}
 }
 
+// PowderSnowBucketContent returns a new BucketContent with the powder snow flag set.
+func PowderSnowBucketContent() BucketContent {
+	return BucketContent{powderSnow: true}
+}
+
+// PowderSnow checks if a Bucket with this BucketContent holds powder snow.
+func (b BucketContent) PowderSnow() bool {
+	return b.powderSnow
+}
+
 // Liquid returns the world.Liquid that a Bucket with this BucketContent places.
 // If this BucketContent does not place a liquid block, false is returned.
 func (b BucketContent) Liquid() (world.Liquid, bool) {
@@ -34,6 +45,8 @@ func (b BucketContent) Liquid() (world.Liquid, bool) {
 func (b BucketContent) String() string {
 	if b.milk {
 		return "milk"
+	} else if b.powderSnow {
+		return "powder_snow"
 	} else if b.liquid != nil {
 		return b.liquid.LiquidType()
 	}
@@ -44,6 +57,8 @@ func (b BucketContent) String() string {
 func (b BucketContent) LiquidType() string {
 	if b.liquid != nil {
 		return b.liquid.LiquidType()
+	} else if b.powderSnow {
+		return "powder_snow"
 	}
 	return "milk"
 }
@@ -87,7 +102,7 @@ func (b Bucket) Consume(_ *world.World, c Consumer) Stack {
 
 // Empty returns true if the bucket is empty.
 func (b Bucket) Empty() bool {
-	return b.Content.liquid == nil && !b.Content.milk
+	return b.Content.liquid == nil && !b.Content.milk && !b.Content.powderSnow
 }
 
 // FuelInfo ...
@@ -106,6 +121,9 @@ func (b Bucket) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.
 	if b.Empty() {
 		return b.fillFrom(pos, w, ctx)
 	}
+	if b.Content.powderSnow {
+		return b.placePowderSnow(pos, face, w, ctx)
+	}
 	liq := b.Content.liquid.WithDepth(8, false)
 	if bl := w.Block(pos); canDisplace(bl, liq) || replaceableWith(bl, liq) {
 		w.SetLiquid(pos, liq)
@@ -122,6 +140,26 @@ func (b Bucket) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.
 	return true
 }
 
+// placePowderSnow places the powder snow held by the bucket in the world, either at the position passed or at the
+// side of it, if the block at the position cannot be replaced.
+func (b Bucket) placePowderSnow(pos cube.Pos, face cube.Face, w *world.World, ctx *UseContext) bool {
+	snow, ok := world.BlockByName("minecraft:powder_snow", nil)
+	if !ok {
+		return false
+	}
+	if !replaceableWith(w.Block(pos), snow) {
+		if pos = pos.Side(face); !replaceableWith(w.Block(pos), snow) {
+			return false
+		}
+	}
+	w.SetBlock(pos, snow, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.PowderSnowBucketEmpty{})
+	ctx.NewItem = NewStack(Bucket{}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
 // fillFrom fills a bucket from the liquid at the position passed in the world. If there is no liquid or if
 // the liquid is no source, fillFrom returns false.
 func (b Bucket) fillFrom(pos cube.Pos, w *world.World, ctx *UseContext) bool {
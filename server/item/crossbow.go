@@ -0,0 +1,208 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"time"
+)
+
+// Crossbow is a ranged weapon similar to a bow. Instead of firing when released, a crossbow is loaded with an
+// arrow or a firework rocket by charging it, after which it may be shot at any time.
+type Crossbow struct {
+	// Item is the projectile that the crossbow is loaded with. The crossbow is not loaded if Item is empty.
+	Item Stack
+}
+
+// Loaded checks if the crossbow is loaded with a projectile.
+func (c Crossbow) Loaded() bool {
+	return !c.Item.Empty()
+}
+
+// MaxCount always returns 1.
+func (Crossbow) MaxCount() int {
+	return 1
+}
+
+// DurabilityInfo ...
+func (Crossbow) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 464,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// FuelInfo ...
+func (Crossbow) FuelInfo() FuelInfo {
+	return newFuelInfo(time.Second * 15)
+}
+
+// EnchantmentValue ...
+func (Crossbow) EnchantmentValue() int {
+	return 1
+}
+
+// Requirements returns the required items to release this item. A crossbow may be charged with either arrows or
+// fireworks, so no specific item is required to start charging it.
+func (Crossbow) Requirements() []Stack {
+	return nil
+}
+
+// Release loads the crossbow if it was charged for long enough. A firework held in the off-hand is loaded before
+// any arrows in the inventory.
+func (c Crossbow) Release(releaser Releaser, duration time.Duration, ctx *UseContext) {
+	if c.Loaded() {
+		return
+	}
+	held, left := releaser.HeldItems()
+	chargeDuration, quickCharge := time.Millisecond*1250, false
+	for _, enchant := range held.Enchantments() {
+		if q, ok := enchant.Type().(interface{ ChargeDuration(int) time.Duration }); ok {
+			chargeDuration, quickCharge = q.ChargeDuration(enchant.Level()), true
+		}
+	}
+	if duration < chargeDuration {
+		// The crossbow was not charged for long enough to be loaded.
+		return
+	}
+
+	creative := releaser.GameMode().CreativeInventory()
+	projectile, ok := left, false
+	if _, ok = left.Item().(Firework); !ok {
+		projectile, ok = ctx.FirstFunc(func(stack Stack) bool {
+			_, ok := stack.Item().(Arrow)
+			return ok
+		})
+	}
+	if !ok {
+		if !creative {
+			// No projectiles in inventory and not in creative mode.
+			return
+		}
+		projectile = NewStack(Arrow{}, 1)
+	}
+
+	c.Item = projectile.Grow(1 - projectile.Count())
+	if !creative {
+		ctx.Consume(c.Item)
+	}
+	held.item = c
+	releaser.SetHeldItems(held, left)
+	releaser.PlaySound(sound.CrossbowLoad{Stage: 2, QuickCharge: quickCharge})
+}
+
+// Use shoots the projectile that the crossbow is loaded with. Use does nothing if the crossbow is not loaded.
+func (c Crossbow) Use(w *world.World, user User, ctx *UseContext) bool {
+	if !c.Loaded() {
+		return false
+	}
+	held, left := user.HeldItems()
+	creative := false
+	if g, ok := user.(interface{ GameMode() world.GameMode }); ok {
+		creative = g.GameMode().CreativeInventory()
+	}
+
+	count, piercing := 1, 0
+	for _, enchant := range held.Enchantments() {
+		if m, ok := enchant.Type().(interface{ ProjectileCount(int) int }); ok {
+			count = m.ProjectileCount(enchant.Level())
+		}
+		if p, ok := enchant.Type().(interface{ PiercedEntities(int) int }); ok {
+			piercing = p.PiercedEntities(enchant.Level())
+		}
+	}
+
+	conf, pos, rot := w.EntityRegistry().Config(), eyePosition(user), user.Rotation()
+	for i := 0; i < count; i++ {
+		// Additional projectiles are spread out evenly to the left and right of the first one.
+		offset := float64((i+1)/2) * 10
+		if i%2 == 1 {
+			offset = -offset
+		}
+		r := cube.Rotation{rot[0] + offset, rot[1]}
+
+		var projectile world.Entity
+		switch it := c.Item.Item().(type) {
+		case Firework:
+			if conf.CrossbowFirework != nil {
+				projectile = conf.CrossbowFirework(pos, r.Vec3().Mul(1.6), r, it, user)
+			} else {
+				projectile = conf.Firework(pos, r, false, it, user)
+			}
+		case Arrow:
+			// Only the first arrow shot may be picked up again: Additional arrows shot with Multishot are
+			// created without being consumed.
+			arrowRot := cube.Rotation{-r[0], -r[1]}
+			if arrowRot[0] > 180 {
+				arrowRot[0] = 360 - arrowRot[0]
+			}
+			pickup := i == 0 && !creative
+			if conf.CrossbowArrow != nil {
+				projectile = conf.CrossbowArrow(pos, r.Vec3().Mul(3.15), arrowRot, 9, user, pickup, piercing, it.Tip)
+			} else {
+				projectile = conf.Arrow(pos, r.Vec3().Mul(3.15), arrowRot, 9, user, false, false, pickup, 0, it.Tip)
+			}
+		}
+		if projectile == nil || !w.AddEntity(projectile) {
+			if i == 0 {
+				// The crossbow stays charged if not even the first projectile could be shot.
+				return false
+			}
+			continue
+		}
+		if _, ok := c.Item.Item().(Firework); ok {
+			w.PlaySound(pos, sound.FireworkLaunch{})
+		}
+	}
+	w.PlaySound(pos, sound.CrossbowShoot{})
+
+	ctx.DamageItem(1)
+	if _, ok := c.Item.Item().(Firework); ok {
+		// Shooting a firework damages the crossbow more than shooting an arrow.
+		ctx.DamageItem(2)
+	}
+	c.Item = Stack{}
+	held.item = c
+	user.SetHeldItems(held, left)
+	return true
+}
+
+// EncodeItem ...
+func (Crossbow) EncodeItem() (name string, meta int16) {
+	return "minecraft:crossbow", 0
+}
+
+// DecodeNBT ...
+func (c Crossbow) DecodeNBT(data map[string]any) any {
+	c.Item = Stack{}
+	charged, ok := data["chargedItem"].(map[string]any)
+	if !ok {
+		return c
+	}
+	name, _ := charged["Name"].(string)
+	meta, _ := charged["Damage"].(int16)
+	it, ok := world.ItemByName(name, meta)
+	if !ok {
+		return c
+	}
+	if tag, ok := charged["tag"].(map[string]any); ok {
+		if nbt, ok := it.(world.NBTer); ok {
+			it = nbt.DecodeNBT(tag).(world.Item)
+		}
+	}
+	c.Item = NewStack(it, 1)
+	return c
+}
+
+// EncodeNBT ...
+func (c Crossbow) EncodeNBT() map[string]any {
+	if !c.Loaded() {
+		return nil
+	}
+	name, meta := c.Item.Item().EncodeItem()
+	charged := map[string]any{"Name": name, "Damage": meta, "Count": byte(1)}
+	if nbt, ok := c.Item.Item().(world.NBTer); ok {
+		charged["tag"] = nbt.EncodeNBT()
+	}
+	return map[string]any{"chargedItem": charged}
+}
@@ -16,7 +16,9 @@ func (e Egg) MaxCount() int {
 // Use ...
 func (e Egg) Use(w *world.World, user User, ctx *UseContext) bool {
 	create := w.EntityRegistry().Config().Egg
-	w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(1.5), user))
+	if !w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(1.5), user)) {
+		return false
+	}
 	w.PlaySound(user.Position(), sound.ItemThrow{})
 
 	ctx.SubtractFromCount(1)
@@ -0,0 +1,48 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Piercing is a crossbow enchantment which allows arrows to pass through
+// multiple entities.
+type Piercing struct{}
+
+// Name ...
+func (Piercing) Name() string {
+	return "Piercing"
+}
+
+// MaxLevel ...
+func (Piercing) MaxLevel() int {
+	return 4
+}
+
+// Cost ...
+func (Piercing) Cost(level int) (int, int) {
+	return 1 + (level-1)*10, 50
+}
+
+// Rarity ...
+func (Piercing) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityCommon
+}
+
+// PiercedEntities returns the amount of entities an arrow may pass through
+// after hitting an entity for the level passed.
+func (Piercing) PiercedEntities(level int) int {
+	return level
+}
+
+// CompatibleWithEnchantment ...
+func (Piercing) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, multishot := t.(Multishot)
+	return !multishot
+}
+
+// CompatibleWithItem ...
+func (Piercing) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(item.Crossbow)
+	return ok
+}
@@ -0,0 +1,49 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"time"
+)
+
+// QuickCharge is a crossbow enchantment which decreases the time it takes to
+// load the crossbow.
+type QuickCharge struct{}
+
+// Name ...
+func (QuickCharge) Name() string {
+	return "Quick Charge"
+}
+
+// MaxLevel ...
+func (QuickCharge) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (QuickCharge) Cost(level int) (int, int) {
+	min := 12 + (level-1)*20
+	return min, 50
+}
+
+// Rarity ...
+func (QuickCharge) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityUncommon
+}
+
+// ChargeDuration returns the duration it takes to load a crossbow with the
+// level of the enchantment passed.
+func (QuickCharge) ChargeDuration(level int) time.Duration {
+	return time.Millisecond*1250 - time.Millisecond*250*time.Duration(level)
+}
+
+// CompatibleWithEnchantment ...
+func (QuickCharge) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (QuickCharge) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(item.Crossbow)
+	return ok
+}
@@ -12,7 +12,9 @@ type EnderPearl struct{}
 // Use ...
 func (e EnderPearl) Use(w *world.World, user User, ctx *UseContext) bool {
 	create := w.EntityRegistry().Config().EnderPearl
-	w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(1.5), user))
+	if !w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(1.5), user)) {
+		return false
+	}
 	w.PlaySound(user.Position(), sound.ItemThrow{})
 
 	ctx.SubtractFromCount(1)
@@ -28,9 +28,11 @@ func (f Firework) Use(w *world.World, user User, ctx *UseContext) bool {
 
 	pos := user.Position()
 
-	w.PlaySound(pos, sound.FireworkLaunch{})
 	create := w.EntityRegistry().Config().Firework
-	w.AddEntity(create(pos, user.Rotation(), true, f, user))
+	if !w.AddEntity(create(pos, user.Rotation(), true, f, user)) {
+		return false
+	}
+	w.PlaySound(pos, sound.FireworkLaunch{})
 
 	ctx.SubtractFromCount(1)
 	return true
@@ -40,7 +42,9 @@ func (f Firework) Use(w *world.World, user User, ctx *UseContext) bool {
 func (f Firework) UseOnBlock(blockPos cube.Pos, _ cube.Face, clickPos mgl64.Vec3, w *world.World, user User, ctx *UseContext) bool {
 	pos := blockPos.Vec3().Add(clickPos)
 	create := w.EntityRegistry().Config().Firework
-	w.AddEntity(create(pos, cube.Rotation{rand.Float64() * 360, 90}, false, f, user))
+	if !w.AddEntity(create(pos, cube.Rotation{rand.Float64() * 360, 90}, false, f, user)) {
+		return false
+	}
 	w.PlaySound(pos, sound.FireworkLaunch{})
 
 	ctx.SubtractFromCount(1)
@@ -0,0 +1,66 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"time"
+)
+
+// FishingRod is a tool used to cast a fishing hook. Using the fishing rod while its hook is out reels the hook
+// back in. In worlds with world.CombatConfig.FishingRodHits set, the hook knocks back entities it hits.
+type FishingRod struct{}
+
+// fishingHookRange is the maximum distance from its owner at which a fishing hook may be reeled in.
+const fishingHookRange = 32
+
+// Use casts a fishing hook in the direction the user is looking, or reels in the fishing hook the user cast
+// before if it is still out.
+func (FishingRod) Use(w *world.World, user User, ctx *UseContext) bool {
+	box := user.Type().BBox(user).Translate(user.Position()).Grow(fishingHookRange)
+	hooks := w.EntitiesWithin(box, func(e world.Entity) bool {
+		o, ok := e.(interface{ Owner() world.Entity })
+		return e.Type().EncodeEntity() != "minecraft:fishing_hook" || !ok || o.Owner() != user
+	})
+	if len(hooks) > 0 {
+		for _, hook := range hooks {
+			w.RemoveEntity(hook)
+		}
+		ctx.DamageItem(1)
+		return true
+	}
+
+	create := w.EntityRegistry().Config().FishingHook
+	if create == nil || !w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(1.5), user)) {
+		return false
+	}
+	w.PlaySound(user.Position(), sound.ItemThrow{})
+	return true
+}
+
+// MaxCount always returns 1.
+func (FishingRod) MaxCount() int {
+	return 1
+}
+
+// DurabilityInfo ...
+func (FishingRod) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 384,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// FuelInfo ...
+func (FishingRod) FuelInfo() FuelInfo {
+	return newFuelInfo(time.Second * 15)
+}
+
+// EnchantmentValue ...
+func (FishingRod) EnchantmentValue() int {
+	return 1
+}
+
+// EncodeItem ...
+func (FishingRod) EncodeItem() (name string, meta int16) {
+	return "minecraft:fishing_rod", 0
+}
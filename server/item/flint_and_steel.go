@@ -27,14 +27,15 @@ func (f FlintAndSteel) DurabilityInfo() DurabilityInfo {
 
 // ignitable represents a block that can be lit by a fire emitter, such as flint and steel.
 type ignitable interface {
-	// Ignite is called when the block is lit by flint and steel.
-	Ignite(pos cube.Pos, w *world.World) bool
+	// Ignite is called when the block is lit by flint and steel. The igniter passed is the entity that lit
+	// the block and may be nil.
+	Ignite(pos cube.Pos, w *world.World, igniter world.Entity) bool
 }
 
 // UseOnBlock ...
-func (f FlintAndSteel) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+func (f FlintAndSteel) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user User, ctx *UseContext) bool {
 	ctx.DamageItem(1)
-	if l, ok := w.Block(pos).(ignitable); ok && l.Ignite(pos, w) {
+	if l, ok := w.Block(pos).(ignitable); ok && l.Ignite(pos, w, user) {
 		return true
 	} else if s := pos.Side(face); w.Block(s) == air() {
 		w.PlaySound(s.Vec3Centre(), sound.Ignite{})
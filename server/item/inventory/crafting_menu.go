@@ -0,0 +1,140 @@
+package inventory
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"slices"
+)
+
+// CraftingMenu is a crafting grid style UI that may be opened for a player, for example to implement a custom
+// crafting system. The item in its result slot is not computed from the recipes of the server, but by the
+// Result function of the CraftingMenu, which is passed the items currently placed in the grid.
+// Every player that opens a CraftingMenu has its own grid. Items left in the grid are returned to the player
+// when the menu is closed.
+type CraftingMenu struct {
+	// Width and Height are the amount of columns and rows of the grid. Both must be in the range 1-3. If left
+	// at 0, a 3x3 grid is used.
+	Width, Height int
+	// Result computes the result of crafting the items in the grid passed for the crafter passed. It is
+	// called every time the grid changes to update the result slot, and again when the crafter takes the
+	// result, so that the result is always validated against the actual contents of the grid. If the Output
+	// of the CraftingResult returned is empty, nothing can be crafted.
+	Result func(crafter world.Entity, grid CraftingGrid) CraftingResult
+}
+
+// Size returns the width and height of the grid of the CraftingMenu, taking the defaults into account.
+func (m CraftingMenu) Size() (width, height int) {
+	width, height = m.Width, m.Height
+	if width <= 0 || width > 3 {
+		width = 3
+	}
+	if height <= 0 || height > 3 {
+		height = 3
+	}
+	return width, height
+}
+
+// Craft computes the result of crafting the items in the grid passed for the crafter passed using the Result
+// function of the CraftingMenu. The amount of items consumed from every slot of the grid is returned with
+// the Output. An empty Output is returned if nothing can be crafted, or if the CraftingResult returned by the
+// Result function is invalid.
+func (m CraftingMenu) Craft(crafter world.Entity, grid CraftingGrid) (item.Stack, []int) {
+	if m.Result == nil {
+		return item.Stack{}, nil
+	}
+	res := m.Result(crafter, grid)
+	if res.Output.Empty() {
+		return item.Stack{}, nil
+	}
+	consumed, err := res.consumed(grid)
+	if err != nil {
+		return item.Stack{}, nil
+	}
+	return res.Output, consumed
+}
+
+// CraftingResult is the result of crafting the items in the grid of a CraftingMenu.
+type CraftingResult struct {
+	// Output is the item shown in the result slot of the CraftingMenu, which the crafter receives once it
+	// takes it. If empty, the items in the grid cannot be crafted.
+	Output item.Stack
+	// Consume holds the amount of items consumed from every slot of the grid when the Output is taken, in the
+	// same order as CraftingGrid.Items. If nil, a single item is consumed from every slot of the grid that is
+	// not empty.
+	Consume []int
+}
+
+// consumed returns the amount of items consumed from every slot of the grid passed when crafting the
+// CraftingResult. An error is returned if the CraftingResult would consume more items than are in the grid.
+func (r CraftingResult) consumed(grid CraftingGrid) ([]int, error) {
+	if r.Consume == nil {
+		consumed := make([]int, len(grid.items))
+		for i, it := range grid.items {
+			if !it.Empty() {
+				consumed[i] = 1
+			}
+		}
+		return consumed, nil
+	}
+	if len(r.Consume) != len(grid.items) {
+		return nil, fmt.Errorf("crafting result consumes %v slots, but grid has %v", len(r.Consume), len(grid.items))
+	}
+	for i, n := range r.Consume {
+		if n < 0 || n > grid.items[i].Count() {
+			return nil, fmt.Errorf("crafting result consumes %v items from slot %v, but it holds %v", n, i, grid.items[i].Count())
+		}
+	}
+	return slices.Clone(r.Consume), nil
+}
+
+// CraftingGrid holds the items placed in the grid of a CraftingMenu. The items are ordered from left to right
+// and from top to bottom.
+type CraftingGrid struct {
+	width, height int
+	items         []item.Stack
+}
+
+// NewCraftingGrid creates a CraftingGrid with the width and height passed, holding the items passed. The
+// items are ordered from left to right and from top to bottom. Slots that are not covered by the items passed
+// are empty.
+func NewCraftingGrid(width, height int, items []item.Stack) CraftingGrid {
+	g := CraftingGrid{width: width, height: height, items: make([]item.Stack, width*height)}
+	copy(g.items, items)
+	return g
+}
+
+// Width returns the amount of columns of the CraftingGrid.
+func (g CraftingGrid) Width() int {
+	return g.width
+}
+
+// Height returns the amount of rows of the CraftingGrid.
+func (g CraftingGrid) Height() int {
+	return g.height
+}
+
+// Item returns the item in the column x and row y of the CraftingGrid, starting at the top left. An empty
+// stack is returned if x or y are out of range.
+func (g CraftingGrid) Item(x, y int) item.Stack {
+	if x < 0 || y < 0 || x >= g.width || y >= g.height {
+		return item.Stack{}
+	}
+	return g.items[y*g.width+x]
+}
+
+// Items returns all items in the CraftingGrid, including empty stacks, ordered from left to right and from
+// top to bottom.
+func (g CraftingGrid) Items() []item.Stack {
+	return slices.Clone(g.items)
+}
+
+// Empty checks if no items are placed in the CraftingGrid.
+func (g CraftingGrid) Empty() bool {
+	for _, it := range g.items {
+		if !it.Empty() {
+			return false
+		}
+	}
+	return true
+}
@@ -305,6 +305,19 @@ func (inv *Inventory) Handle(h Handler) {
 	inv.h = h
 }
 
+// SlotFunc sets the function that is called every time a slot in the Inventory is changed, replacing the
+// function passed to New. Nil may be passed if nothing needs to be done.
+func (inv *Inventory) SlotFunc(f func(slot int, before, after item.Stack)) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	inv.check()
+	if f == nil {
+		f = func(slot int, before, after item.Stack) {}
+	}
+	inv.f = f
+}
+
 // Handler returns the Handler currently assigned to the Inventory. This is the NopHandler by default.
 func (inv *Inventory) Handler() Handler {
 	inv.mu.RLock()
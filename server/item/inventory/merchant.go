@@ -0,0 +1,200 @@
+package inventory
+
+import (
+	"errors"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"slices"
+	"sync"
+)
+
+// Offer is a trade offered by a Merchant. A customer gives the items BuyA and, optionally, BuyB to the Merchant
+// in exchange for the item Sell.
+type Offer struct {
+	// BuyA is the first item that the customer must give to the Merchant.
+	BuyA item.Stack
+	// BuyB is the second item that the customer must give to the Merchant. BuyB may be empty if only one item
+	// is required.
+	BuyB item.Stack
+	// Sell is the item that the customer receives in exchange.
+	Sell item.Stack
+	// MaxUses is the amount of times the Offer may be traded before it is out of stock. If 0, the Offer may be
+	// traded an unlimited amount of times.
+	MaxUses int
+	// Uses is the amount of times the Offer has been traded since it was last restocked.
+	Uses int
+	// XP is the amount of experience that the customer receives every time the Offer is traded.
+	XP int
+}
+
+// OutOfStock checks if the Offer was traded the maximum amount of times and can no longer be traded until it
+// is restocked.
+func (o Offer) OutOfStock() bool {
+	return o.MaxUses > 0 && o.Uses >= o.MaxUses
+}
+
+// MerchantViewer is a viewer of a Merchant, such as a player that has the trading UI of the Merchant opened.
+type MerchantViewer interface {
+	// ViewOffers views the Offers of the Merchant passed. It is called every time the Offers of the Merchant
+	// change, for example because one of them is traded.
+	ViewOffers(m *Merchant)
+}
+
+// MerchantHandler is a type that may be used to handle trades with a Merchant.
+type MerchantHandler interface {
+	// HandleTrade handles the customer passed trading the Offer at the index passed. ctx.Cancel() may be called
+	// to cancel the trade.
+	HandleTrade(ctx *event.Context, customer world.Entity, index int, offer Offer)
+}
+
+// Check to make sure NopMerchantHandler implements MerchantHandler.
+var _ MerchantHandler = NopMerchantHandler{}
+
+// NopMerchantHandler is an implementation of MerchantHandler that does not run any code in any of its methods.
+// It is the default MerchantHandler of a Merchant.
+type NopMerchantHandler struct{}
+
+func (NopMerchantHandler) HandleTrade(*event.Context, world.Entity, int, Offer) {}
+
+var (
+	// ErrOfferNotFound is returned by methods on a Merchant when an index is passed for which no Offer exists.
+	ErrOfferNotFound = errors.New("offer not found: index must be in range 0 <= index < len(merchant.Offers())")
+	// ErrOutOfStock is returned by Merchant.Use when the Offer used is out of stock.
+	ErrOutOfStock = errors.New("offer is out of stock")
+)
+
+// Merchant holds a list of Offers that may be traded by customers through the trading UI, such as the offers
+// of a villager or a shop NPC. A Merchant keeps track of the amount of times each Offer is traded, so that
+// offers go out of stock once traded the maximum amount of times.
+// Merchant is safe for concurrent usage: Its values are protected by a mutex.
+type Merchant struct {
+	mu      sync.RWMutex
+	h       MerchantHandler
+	name    string
+	offers  []Offer
+	viewers map[MerchantViewer]struct{}
+}
+
+// NewMerchant creates a new Merchant with the name and Offers passed. The name is displayed at the top of the
+// trading UI.
+func NewMerchant(name string, offers ...Offer) *Merchant {
+	return &Merchant{h: NopMerchantHandler{}, name: name, offers: slices.Clone(offers), viewers: make(map[MerchantViewer]struct{})}
+}
+
+// Name returns the name of the Merchant as displayed at the top of the trading UI.
+func (m *Merchant) Name() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.name
+}
+
+// Offers returns all Offers of the Merchant.
+func (m *Merchant) Offers() []Offer {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.offers)
+}
+
+// Offer returns the Offer at the index passed. If no Offer exists at that index, ErrOfferNotFound is returned.
+func (m *Merchant) Offer(index int) (Offer, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if index < 0 || index >= len(m.offers) {
+		return Offer{}, ErrOfferNotFound
+	}
+	return m.offers[index], nil
+}
+
+// SetOffers replaces all Offers of the Merchant with the Offers passed.
+func (m *Merchant) SetOffers(offers ...Offer) {
+	m.mu.Lock()
+	m.offers = slices.Clone(offers)
+	m.mu.Unlock()
+	m.update()
+}
+
+// AddOffer adds an Offer to the end of the Offers of the Merchant.
+func (m *Merchant) AddOffer(o Offer) {
+	m.mu.Lock()
+	m.offers = append(m.offers, o)
+	m.mu.Unlock()
+	m.update()
+}
+
+// Use registers a trade of the Offer at the index passed, increasing its uses by one. ErrOutOfStock is
+// returned if the Offer is out of stock. Use is called automatically when a customer trades an Offer through
+// the trading UI.
+func (m *Merchant) Use(index int) error {
+	m.mu.Lock()
+	if index < 0 || index >= len(m.offers) {
+		m.mu.Unlock()
+		return ErrOfferNotFound
+	}
+	if m.offers[index].OutOfStock() {
+		m.mu.Unlock()
+		return ErrOutOfStock
+	}
+	m.offers[index].Uses++
+	m.mu.Unlock()
+	m.update()
+	return nil
+}
+
+// Restock resets the uses of all Offers of the Merchant, so that offers that were out of stock may be traded
+// again.
+func (m *Merchant) Restock() {
+	m.mu.Lock()
+	for i := range m.offers {
+		m.offers[i].Uses = 0
+	}
+	m.mu.Unlock()
+	m.update()
+}
+
+// Handle assigns a MerchantHandler to the Merchant so that trades with the Merchant are passed to it. If nil
+// is passed, the NopMerchantHandler is used.
+func (m *Merchant) Handle(h MerchantHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if h == nil {
+		h = NopMerchantHandler{}
+	}
+	m.h = h
+}
+
+// Handler returns the MerchantHandler currently assigned to the Merchant.
+func (m *Merchant) Handler() MerchantHandler {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.h
+}
+
+// AddViewer adds a MerchantViewer to the Merchant, so that it is updated whenever the Offers of the Merchant
+// change.
+func (m *Merchant) AddViewer(v MerchantViewer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a MerchantViewer from the Merchant, so that it is no longer updated when the Offers of
+// the Merchant change.
+func (m *Merchant) RemoveViewer(v MerchantViewer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.viewers, v)
+}
+
+// update updates all viewers of the Merchant with its current Offers.
+func (m *Merchant) update() {
+	m.mu.RLock()
+	viewers := make([]MerchantViewer, 0, len(m.viewers))
+	for v := range m.viewers {
+		viewers = append(viewers, v)
+	}
+	m.mu.RUnlock()
+	for _, v := range viewers {
+		v.ViewOffers(m)
+	}
+}
@@ -209,14 +209,14 @@ func (d defaultFood) ConsumeDuration() time.Duration {
 	return DefaultConsumeDuration
 }
 
-// DisplayName returns the display name of the item as shown in game in the language passed. It panics if an unknown
-// item is passed in.
+// DisplayName returns the display name of the item as shown in game in the language passed. If the language
+// is not supported, the American English name is returned. It panics if an unknown item is passed in.
 func DisplayName(item world.Item, locale language.Tag) string {
 	if c, ok := item.(world.CustomItem); ok {
 		return c.Name()
 	}
-	name, ok := lang.DisplayName(item, locale)
-	if !ok {
+	name, _ := lang.DisplayName(item, locale)
+	if name == "" {
 		panic("should never happen")
 	}
 	return name
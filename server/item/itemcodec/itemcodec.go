@@ -0,0 +1,254 @@
+// Package itemcodec implements the encoding of item stacks and inventories to portable formats, so that they
+// may, for example, be defined in configuration files or be sent to web panels and loaded back into real
+// item stacks afterwards.
+//
+// Two formats are supported: A textual JSON format that is easy to read and write by hand, and the NBT format
+// that is also used by worlds to store items.
+package itemcodec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	"strings"
+)
+
+// Stack is the JSON representation of an item.Stack. Fields that hold their zero value are omitted when
+// encoding.
+//
+// An example of a Stack in JSON:
+//
+//	{"name": "minecraft:diamond_sword", "damage": 20, "custom_name": "Sword", "enchantments": [{"name": "Sharpness", "level": 2}]}
+type Stack struct {
+	// Name is the name of the item, such as 'minecraft:diamond'.
+	Name string `json:"name"`
+	// Meta is the metadata value of the item. For most items, this is 0.
+	Meta int16 `json:"meta,omitempty"`
+	// Count is the count of the stack. If 0, a count of 1 is assumed.
+	Count int `json:"count,omitempty"`
+	// Damage is the damage the item has taken. It only applies to items with durability.
+	Damage int `json:"damage,omitempty"`
+	// CustomName is the custom name of the item.
+	CustomName string `json:"custom_name,omitempty"`
+	// Lore holds the lines of lore of the item.
+	Lore []string `json:"lore,omitempty"`
+	// Enchantments holds the enchantments applied to the item.
+	Enchantments []Enchantment `json:"enchantments,omitempty"`
+	// AnvilCost is the repair cost of the item in an anvil.
+	AnvilCost int `json:"anvil_cost,omitempty"`
+	// NBT holds additional data of items that implement world.NBTer, such as written books. It is encoded in
+	// the little endian NBT format, which in turn is encoded as a base64 string in JSON.
+	NBT []byte `json:"nbt,omitempty"`
+}
+
+// Enchantment is the JSON representation of an item.Enchantment.
+type Enchantment struct {
+	// Name is the name of the enchantment type, such as 'Sharpness'. Names are matched case-insensitively.
+	Name string `json:"name"`
+	// Level is the level of the enchantment. If 0, a level of 1 is assumed.
+	Level int `json:"level,omitempty"`
+}
+
+// FromStack converts an item.Stack to its JSON representation. Values set using item.Stack.WithValue are not
+// included.
+func FromStack(s item.Stack) (Stack, error) {
+	if s.Empty() {
+		return Stack{}, fmt.Errorf("cannot encode empty item stack")
+	}
+	name, meta := s.Item().EncodeItem()
+	data := Stack{
+		Name:       name,
+		Meta:       meta,
+		Count:      s.Count(),
+		CustomName: s.CustomName(),
+		Lore:       s.Lore(),
+		AnvilCost:  s.AnvilCost(),
+	}
+	if s.MaxDurability() != -1 {
+		data.Damage = s.MaxDurability() - s.Durability()
+	}
+	for _, e := range s.Enchantments() {
+		data.Enchantments = append(data.Enchantments, Enchantment{Name: e.Type().Name(), Level: e.Level()})
+	}
+	if n, ok := s.Item().(world.NBTer); ok {
+		if m := n.EncodeNBT(); len(m) != 0 {
+			b, err := nbt.MarshalEncoding(m, nbt.LittleEndian)
+			if err != nil {
+				return Stack{}, fmt.Errorf("encode nbt of item %v: %w", name, err)
+			}
+			data.NBT = b
+		}
+	}
+	return data, nil
+}
+
+// ToStack converts the JSON representation of an item stack to an item.Stack. An error is returned if the
+// item or any of its enchantments is not registered, if the count is negative or exceeds the maximum count of
+// the item, or if the damage is not within the durability of the item.
+func (data Stack) ToStack() (item.Stack, error) {
+	it, ok := world.ItemByName(data.Name, data.Meta)
+	if !ok {
+		return item.Stack{}, fmt.Errorf("unknown item %v:%v", data.Name, data.Meta)
+	}
+	if len(data.NBT) != 0 {
+		n, ok := it.(world.NBTer)
+		if !ok {
+			return item.Stack{}, fmt.Errorf("item %v does not support nbt", data.Name)
+		}
+		var m map[string]any
+		if err := nbt.UnmarshalEncoding(data.NBT, &m, nbt.LittleEndian); err != nil {
+			return item.Stack{}, fmt.Errorf("decode nbt of item %v: %w", data.Name, err)
+		}
+		it = n.DecodeNBT(m).(world.Item)
+	}
+	count := data.Count
+	if count == 0 {
+		count = 1
+	}
+	s := item.NewStack(it, 1)
+	if count < 0 || count > s.MaxCount() {
+		return item.Stack{}, fmt.Errorf("count %v of item %v out of range [1, %v]", count, data.Name, s.MaxCount())
+	}
+	if d := s.MaxDurability(); data.Damage != 0 && (d == -1 || data.Damage < 0 || data.Damage >= d) {
+		return item.Stack{}, fmt.Errorf("damage %v of item %v out of range [0, %v)", data.Damage, data.Name, max(d, 0))
+	}
+	s = s.Grow(count - 1).Damage(data.Damage).WithLore(data.Lore...).WithAnvilCost(data.AnvilCost)
+	if data.CustomName != "" {
+		s = s.WithCustomName(data.CustomName)
+	}
+	for _, e := range data.Enchantments {
+		t, ok := enchantmentByName(e.Name)
+		if !ok {
+			return item.Stack{}, fmt.Errorf("unknown enchantment %v", e.Name)
+		}
+		lvl := e.Level
+		if lvl == 0 {
+			lvl = 1
+		}
+		s = s.WithEnchantments(item.NewEnchantment(t, lvl))
+	}
+	return s, nil
+}
+
+// enchantmentByName looks up a registered item.EnchantmentType by its name, ignoring case.
+func enchantmentByName(name string) (item.EnchantmentType, bool) {
+	for _, t := range item.Enchantments() {
+		if strings.EqualFold(t.Name(), name) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalStack encodes an item.Stack to JSON. Values set using item.Stack.WithValue are not included.
+func MarshalStack(s item.Stack) ([]byte, error) {
+	data, err := FromStack(s)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalStack decodes an item.Stack from JSON produced by MarshalStack or written by hand in the format of
+// Stack.
+func UnmarshalStack(b []byte) (item.Stack, error) {
+	var data Stack
+	if err := json.Unmarshal(b, &data); err != nil {
+		return item.Stack{}, fmt.Errorf("decode item stack: %w", err)
+	}
+	return data.ToStack()
+}
+
+// MarshalInventory encodes the contents of an inventory.Inventory to JSON. The JSON produced is an object with
+// the slots of all non-empty items as keys and the items in the format of Stack as values, such as
+// {"0": {"name": "minecraft:diamond", "count": 3}}.
+func MarshalInventory(inv *inventory.Inventory) ([]byte, error) {
+	m := make(map[int]Stack)
+	for slot, s := range inv.Slots() {
+		if s.Empty() {
+			continue
+		}
+		data, err := FromStack(s)
+		if err != nil {
+			return nil, fmt.Errorf("encode slot %v: %w", slot, err)
+		}
+		m[slot] = data
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalInventory decodes JSON produced by MarshalInventory into the inventory.Inventory passed. The
+// existing contents of the inventory are replaced. If an error is returned, the inventory is left unchanged.
+func UnmarshalInventory(b []byte, inv *inventory.Inventory) error {
+	var m map[int]Stack
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("decode inventory: %w", err)
+	}
+	items := make([]item.Stack, inv.Size())
+	for slot, data := range m {
+		if slot < 0 || slot >= inv.Size() {
+			return fmt.Errorf("slot %v out of range for inventory of size %v", slot, inv.Size())
+		}
+		s, err := data.ToStack()
+		if err != nil {
+			return fmt.Errorf("decode slot %v: %w", slot, err)
+		}
+		items[slot] = s
+	}
+	return setItems(inv, items)
+}
+
+// MarshalStackNBT encodes an item.Stack to little endian NBT, using the same format used to store items in
+// worlds. Unlike MarshalStack, values set using item.Stack.WithValue are included.
+func MarshalStackNBT(s item.Stack) ([]byte, error) {
+	return nbt.MarshalEncoding(nbtconv.WriteItem(s, true), nbt.LittleEndian)
+}
+
+// UnmarshalStackNBT decodes an item.Stack from little endian NBT produced by MarshalStackNBT.
+func UnmarshalStackNBT(b []byte) (item.Stack, error) {
+	var m map[string]any
+	if err := nbt.UnmarshalEncoding(b, &m, nbt.LittleEndian); err != nil {
+		return item.Stack{}, fmt.Errorf("decode item stack: %w", err)
+	}
+	s := nbtconv.Item(m, nil)
+	if s.Empty() {
+		return item.Stack{}, fmt.Errorf("unknown item %v:%v", nbtconv.String(m, "Name"), nbtconv.Int16(m, "Damage"))
+	}
+	return s, nil
+}
+
+// MarshalInventoryNBT encodes the contents of an inventory.Inventory to little endian NBT, using the same
+// format used to store the contents of containers in worlds.
+func MarshalInventoryNBT(inv *inventory.Inventory) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	err := nbt.NewEncoderWithEncoding(buf, nbt.LittleEndian).Encode(map[string]any{"Items": nbtconv.InvToNBT(inv)})
+	return buf.Bytes(), err
+}
+
+// UnmarshalInventoryNBT decodes little endian NBT produced by MarshalInventoryNBT into the inventory.Inventory
+// passed. The existing contents of the inventory are replaced. If an error is returned, the inventory is left
+// unchanged.
+func UnmarshalInventoryNBT(b []byte, inv *inventory.Inventory) error {
+	var m map[string]any
+	if err := nbt.UnmarshalEncoding(b, &m, nbt.LittleEndian); err != nil {
+		return fmt.Errorf("decode inventory: %w", err)
+	}
+	decoded := inventory.New(inv.Size(), nil)
+	nbtconv.InvFromNBT(decoded, nbtconv.Slice(m, "Items"))
+	return setItems(inv, decoded.Slots())
+}
+
+// setItems replaces the contents of an inventory.Inventory with the items passed.
+func setItems(inv *inventory.Inventory, items []item.Stack) error {
+	for slot, s := range items {
+		if err := inv.SetItem(slot, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
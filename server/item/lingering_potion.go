@@ -21,7 +21,9 @@ func (l LingeringPotion) MaxCount() int {
 // Use ...
 func (l LingeringPotion) Use(w *world.World, user User, ctx *UseContext) bool {
 	create := w.EntityRegistry().Config().LingeringPotion
-	w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(0.5), l.Type, user))
+	if !w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(0.5), l.Type, user)) {
+		return false
+	}
 	w.PlaySound(user.Position(), sound.ItemThrow{})
 
 	ctx.SubtractFromCount(1)
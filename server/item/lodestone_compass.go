@@ -0,0 +1,128 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"sync"
+)
+
+// LodestoneCompass is a compass that was bound to a lodestone by using a compass on it. A lodestone compass
+// points towards the lodestone it was bound to, as long as it is held in the same dimension as the lodestone.
+// If the lodestone is removed, the compass spins randomly.
+type LodestoneCompass struct {
+	// Pos is the position of the lodestone that the compass is bound to.
+	Pos cube.Pos
+	// Dimension is the dimension of the lodestone that the compass is bound to. If nil, the lodestone is
+	// assumed to be in the world.Overworld.
+	Dimension world.Dimension
+}
+
+// TrackingHandle returns the tracking handle of the LodestoneCompass. The handle is unique for the position
+// and dimension of the lodestone and is sent to the client, which uses it to request the position of the
+// lodestone from the server. The handle is valid until the server is stopped.
+func (c LodestoneCompass) TrackingHandle() int32 {
+	return lodestoneTracking.handle(c.target())
+}
+
+// LodestoneCompassByHandle finds the LodestoneCompass that the tracking handle passed was returned for by
+// LodestoneCompass.TrackingHandle. False is returned if no LodestoneCompass had this handle.
+func LodestoneCompassByHandle(handle int32) (LodestoneCompass, bool) {
+	t, ok := lodestoneTracking.target(handle)
+	if !ok {
+		return LodestoneCompass{}, false
+	}
+	dim, _ := world.DimensionByID(int(t.dim))
+	return LodestoneCompass{Pos: t.pos, Dimension: dim}, true
+}
+
+// MaxCount ...
+func (LodestoneCompass) MaxCount() int {
+	return 1
+}
+
+// target returns the lodestoneTarget of the LodestoneCompass.
+func (c LodestoneCompass) target() lodestoneTarget {
+	dim := 0
+	if c.Dimension != nil {
+		dim, _ = world.DimensionID(c.Dimension)
+	}
+	return lodestoneTarget{pos: c.Pos, dim: int32(dim)}
+}
+
+// EncodeNBT ...
+func (c LodestoneCompass) EncodeNBT() map[string]any {
+	t := c.target()
+	return map[string]any{
+		"trackingHandle":     c.TrackingHandle(),
+		"LodestonePosX":      int32(t.pos[0]),
+		"LodestonePosY":      int32(t.pos[1]),
+		"LodestonePosZ":      int32(t.pos[2]),
+		"LodestoneDimension": t.dim,
+	}
+}
+
+// DecodeNBT ...
+func (c LodestoneCompass) DecodeNBT(data map[string]any) any {
+	x, ok := data["LodestonePosX"].(int32)
+	if !ok {
+		// The compass might have been sent by the client, in which case only the tracking handle is present.
+		handle, _ := data["trackingHandle"].(int32)
+		if compass, ok := LodestoneCompassByHandle(handle); ok {
+			return compass
+		}
+		return c
+	}
+	y, _ := data["LodestonePosY"].(int32)
+	z, _ := data["LodestonePosZ"].(int32)
+	dim, _ := data["LodestoneDimension"].(int32)
+
+	c.Pos = cube.Pos{int(x), int(y), int(z)}
+	c.Dimension, _ = world.DimensionByID(int(dim))
+	return c
+}
+
+// EncodeItem ...
+func (LodestoneCompass) EncodeItem() (name string, meta int16) {
+	return "minecraft:lodestone_compass", 0
+}
+
+// lodestoneTarget is the position and dimension ID of a lodestone that compasses may be bound to.
+type lodestoneTarget struct {
+	pos cube.Pos
+	dim int32
+}
+
+// lodestoneTracking holds the tracking handles of all lodestones that compasses were bound to.
+var lodestoneTracking = &lodestoneTracker{handles: map[lodestoneTarget]int32{}}
+
+// lodestoneTracker assigns tracking handles to lodestone positions, so that clients can look up the position
+// of the lodestone that a compass is bound to.
+type lodestoneTracker struct {
+	mu      sync.Mutex
+	handles map[lodestoneTarget]int32
+	targets []lodestoneTarget
+}
+
+// handle returns the tracking handle of the lodestoneTarget passed, creating a new one if the target did not
+// yet have a handle.
+func (l *lodestoneTracker) handle(t lodestoneTarget) int32 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if h, ok := l.handles[t]; ok {
+		return h
+	}
+	l.targets = append(l.targets, t)
+	h := int32(len(l.targets))
+	l.handles[t] = h
+	return h
+}
+
+// target returns the lodestoneTarget that had the tracking handle passed assigned.
+func (l *lodestoneTracker) target(h int32) (lodestoneTarget, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if h <= 0 || int(h) > len(l.targets) {
+		return lodestoneTarget{}, false
+	}
+	return l.targets[h-1], true
+}
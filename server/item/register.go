@@ -40,6 +40,7 @@ func init() {
 	world.RegisterItem(Compass{})
 	world.RegisterItem(Cookie{})
 	world.RegisterItem(CopperIngot{})
+	world.RegisterItem(Crossbow{})
 	world.RegisterItem(Diamond{})
 	world.RegisterItem(DiscFragment{})
 	world.RegisterItem(DragonBreath{})
@@ -55,6 +56,7 @@ func init() {
 	world.RegisterItem(FermentedSpiderEye{})
 	world.RegisterItem(FireCharge{})
 	world.RegisterItem(Firework{})
+	world.RegisterItem(FishingRod{})
 	world.RegisterItem(FlintAndSteel{})
 	world.RegisterItem(Flint{})
 	world.RegisterItem(GhastTear{})
@@ -74,6 +76,7 @@ func init() {
 	world.RegisterItem(IronNugget{})
 	world.RegisterItem(LapisLazuli{})
 	world.RegisterItem(Leather{})
+	world.RegisterItem(LodestoneCompass{})
 	world.RegisterItem(MagmaCream{})
 	world.RegisterItem(MelonSlice{})
 	world.RegisterItem(MushroomStew{})
@@ -109,6 +112,7 @@ func init() {
 	world.RegisterItem(Salmon{})
 	world.RegisterItem(Scute{})
 	world.RegisterItem(Shears{})
+	world.RegisterItem(Shield{})
 	world.RegisterItem(ShulkerShell{})
 	world.RegisterItem(Slimeball{})
 	world.RegisterItem(Snowball{})
@@ -116,6 +120,7 @@ func init() {
 	world.RegisterItem(Spyglass{})
 	world.RegisterItem(Stick{})
 	world.RegisterItem(Sugar{})
+	world.RegisterItem(Totem{})
 	world.RegisterItem(TropicalFish{})
 	world.RegisterItem(TurtleShell{})
 	world.RegisterItem(WarpedFungusOnAStick{})
@@ -0,0 +1,40 @@
+package item
+
+import "time"
+
+// Shield is a tool used for protecting the user against attacks. A shield is raised by sneaking while holding
+// it in either hand, blocking attacks and projectiles coming from the front.
+type Shield struct{}
+
+// MaxCount always returns 1.
+func (Shield) MaxCount() int {
+	return 1
+}
+
+// OffHand ...
+func (Shield) OffHand() bool {
+	return true
+}
+
+// DurabilityInfo ...
+func (Shield) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 337,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// RepairableBy ...
+func (Shield) RepairableBy(i Stack) bool {
+	return i.HasTag(TagPlanks)
+}
+
+// FuelInfo ...
+func (Shield) FuelInfo() FuelInfo {
+	return newFuelInfo(time.Second * 15)
+}
+
+// EncodeItem ...
+func (Shield) EncodeItem() (name string, meta int16) {
+	return "minecraft:shield", 0
+}
@@ -1,6 +1,7 @@
 package item
 
 import (
+	"github.com/df-mc/dragonfly/server/world"
 	"time"
 )
 
@@ -38,6 +39,43 @@ func newSmeltInfo(product Stack, experience float64) SmeltInfo {
 	}
 }
 
+// smeltKey is the key used to index smelting recipes registered using RegisterSmeltInfo.
+type smeltKey struct {
+	name string
+	meta int16
+}
+
+// smeltRecipes holds all smelting recipes registered using RegisterSmeltInfo.
+var smeltRecipes = map[smeltKey]SmeltInfo{}
+
+// RegisterSmeltInfo registers a smelting recipe for the world.Item passed, so that smelters turn it into the
+// product of the SmeltInfo passed. Recipes registered take precedence over the SmeltInfo returned by items
+// implementing Smeltable, meaning RegisterSmeltInfo may also be used to change or, by passing an empty
+// SmeltInfo, remove vanilla smelting recipes. Items are matched by their name and metadata.
+// RegisterSmeltInfo is not safe for concurrent use and should be called before the server is started.
+func RegisterSmeltInfo(input world.Item, info SmeltInfo) {
+	name, meta := input.EncodeItem()
+	smeltRecipes[smeltKey{name: name, meta: meta}] = info
+}
+
+// SmeltInfoOf returns the SmeltInfo used when smelting the world.Item passed. The SmeltInfo registered using
+// RegisterSmeltInfo is returned if present. Otherwise, the SmeltInfo of the item is returned if it implements
+// Smeltable. False is returned if the item cannot be smelted.
+func SmeltInfoOf(input world.Item) (SmeltInfo, bool) {
+	if input == nil {
+		return SmeltInfo{}, false
+	}
+	name, meta := input.EncodeItem()
+	if info, ok := smeltRecipes[smeltKey{name: name, meta: meta}]; ok {
+		return info, !info.Product.Empty()
+	}
+	if s, ok := input.(Smeltable); ok {
+		info := s.SmeltInfo()
+		return info, !info.Product.Empty()
+	}
+	return SmeltInfo{}, false
+}
+
 // newFoodSmeltInfo returns a new SmeltInfo with the given values that allows smelting in a smelter.
 func newFoodSmeltInfo(product Stack, experience float64) SmeltInfo {
 	return SmeltInfo{
@@ -0,0 +1,36 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+)
+
+// SpawnEgg is an item that spawns an entity of its Type when used on a block. Using a spawn egg on a mob
+// spawner changes the entity spawned by the spawner.
+// Spawn eggs are not registered by default and must be registered using world.RegisterItem for every entity
+// type that should have one. Type must implement world.SaveableEntityType for the egg to spawn entities.
+type SpawnEgg struct {
+	// Type is the type of the entity spawned by the spawn egg. It must not be nil.
+	Type world.EntityType
+}
+
+// UseOnBlock ...
+func (s SpawnEgg) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user User, ctx *UseContext) bool {
+	spawnPos := pos.Side(face).Vec3Middle()
+	e, ok := world.NewEntity(s.Type, spawnPos, cube.Rotation{user.Rotation().Yaw() + 180})
+	if !ok {
+		return false
+	}
+	if !w.AddEntity(e) {
+		return false
+	}
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (s SpawnEgg) EncodeItem() (name string, meta int16) {
+	return "minecraft:" + strings.TrimPrefix(s.Type.EncodeEntity(), "minecraft:") + "_spawn_egg", 0
+}
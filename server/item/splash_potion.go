@@ -20,7 +20,9 @@ func (s SplashPotion) MaxCount() int {
 // Use ...
 func (s SplashPotion) Use(w *world.World, user User, ctx *UseContext) bool {
 	create := w.EntityRegistry().Config().SplashPotion
-	w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(0.5), s.Type, user))
+	if !w.AddEntity(create(eyePosition(user), user.Rotation().Vec3().Mul(0.5), s.Type, user)) {
+		return false
+	}
 	w.PlaySound(user.Position(), sound.ItemThrow{})
 
 	ctx.SubtractFromCount(1)
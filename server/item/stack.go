@@ -3,6 +3,8 @@ package item
 import (
 	"fmt"
 	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/text"
+	"golang.org/x/text/language"
 	"reflect"
 	"slices"
 	"sort"
@@ -158,6 +160,16 @@ func (s Stack) Item() world.Item {
 	return s.item
 }
 
+// WithItem returns a copy of the Stack with the item type replaced by the one passed. The count, custom name,
+// lore, damage, values and enchantments of the Stack are kept.
+func (s Stack) WithItem(t world.Item) Stack {
+	if t == nil {
+		panic("cannot have a stack with item type nil")
+	}
+	s.item = t
+	return s
+}
+
 // AttackDamage returns the attack damage to the stack. By default, the value returned is 1.0. If the item
 // held implements the item.Weapon interface, this damage may be different.
 func (s Stack) AttackDamage() float64 {
@@ -172,11 +184,13 @@ func (s Stack) AttackDamage() float64 {
 }
 
 // WithCustomName returns a copy of the Stack with the custom name passed. The custom name is formatted
-// according to the rules of fmt.Sprintln.
+// according to the rules of fmt.Sprintln. Both § formatting codes and the HTML-like markup supported by
+// text.Colourf (such as <red>Sword</red>) may be used. Custom names longer than MaxCustomNameLength are
+// truncated.
 func (s Stack) WithCustomName(a ...any) Stack {
-	s.customName = format(a)
+	s.customName = truncateText(formatText(format(a)), MaxCustomNameLength)
 	if nameable, ok := s.Item().(nameable); ok {
-		s.item = nameable.WithName(a...)
+		s.item = nameable.WithName(s.customName)
 	}
 	return s
 }
@@ -187,11 +201,32 @@ func (s Stack) CustomName() string {
 	return s.customName
 }
 
+// DisplayName returns the name of the Stack as displayed in game. If the Stack has a custom name set, the
+// custom name is returned. Otherwise, the name of the item in the language passed is returned. An empty
+// string is returned if the Stack is empty.
+func (s Stack) DisplayName(locale language.Tag) string {
+	if s.customName != "" {
+		return s.customName
+	}
+	if s.Empty() {
+		return ""
+	}
+	return DisplayName(s.item, locale)
+}
+
 // WithLore returns a copy of the Stack with the lore passed. Each string passed is put on a different line,
-// where the first string is at the top and the last at the bottom.
+// where the first string is at the top and the last at the bottom. Similarly to WithCustomName, the lines
+// may contain both § formatting codes and text.Colourf markup. Lines beyond MaxLoreLines are dropped and
+// lines longer than MaxLoreLineLength are truncated.
 // The lore may be cleared by passing no lines into the Stack.
 func (s Stack) WithLore(lines ...string) Stack {
-	s.lore = lines
+	if len(lines) > MaxLoreLines {
+		lines = lines[:MaxLoreLines]
+	}
+	s.lore = make([]string, 0, len(lines))
+	for _, line := range lines {
+		s.lore = append(s.lore, truncateText(formatText(line), MaxLoreLineLength))
+	}
 	return s
 }
 
@@ -319,9 +354,14 @@ func (s Stack) AddStack(s2 Stack) (a, b Stack) {
 	return s, s2
 }
 
-// Equal checks if the two stacks are equal. Equal is equivalent to a Stack.Comparable check while also
-// checking the count and durability.
+// Equal checks if the two stacks are strictly equal. Unlike Stack.Comparable, which is used to check if two
+// stacks may be merged, Equal also checks the count and durability of the stacks, on top of the item type,
+// enchantments, lore, custom name and custom values. Two empty stacks are always equal, but an empty stack
+// is never equal to a non-empty one.
 func (s Stack) Equal(s2 Stack) bool {
+	if s.Empty() || s2.Empty() {
+		return s.Empty() && s2.Empty()
+	}
 	return s.Comparable(s2) && s.count == s2.count && s.damage == s2.damage
 }
 
@@ -338,7 +378,7 @@ func (s Stack) Comparable(s2 Stack) bool {
 	if name != name2 || meta != meta2 || s.anvilCost != s2.anvilCost || s.customName != s2.customName {
 		return false
 	}
-	for !slices.Equal(s.lore, s2.lore) {
+	if !slices.Equal(s.lore, s2.lore) {
 		return false
 	}
 	if len(s.enchantments) != len(s2.enchantments) {
@@ -349,9 +389,16 @@ func (s Stack) Comparable(s2 Stack) bool {
 			return false
 		}
 	}
-	if !reflect.DeepEqual(s.data, s2.data) {
+	if len(s.data) != len(s2.data) {
 		return false
 	}
+	for k, v := range s.data {
+		// Compare the values key by key so that a nil map and an empty map (for example after clearing a
+		// value using WithValue) are treated the same.
+		if v2, ok := s2.data[k]; !ok || !reflect.DeepEqual(v, v2) {
+			return false
+		}
+	}
 	if nbt, ok := s.Item().(world.NBTer); ok {
 		nbt2, ok := s2.Item().(world.NBTer)
 		return ok && reflect.DeepEqual(nbt.EncodeNBT(), nbt2.EncodeNBT())
@@ -392,6 +439,41 @@ func id(s Stack) int32 {
 	return s.id
 }
 
+const (
+	// MaxCustomNameLength is the maximum length in characters of a custom name of a Stack. Custom names
+	// exceeding this length are truncated by Stack.WithCustomName.
+	MaxCustomNameLength = 256
+	// MaxLoreLines is the maximum amount of lines of lore a Stack can have. Additional lines passed to
+	// Stack.WithLore are dropped.
+	MaxLoreLines = 32
+	// MaxLoreLineLength is the maximum length in characters of a single line of lore. Lines exceeding this
+	// length are truncated by Stack.WithLore.
+	MaxLoreLineLength = 256
+)
+
+// formatText converts text.Colourf style markup in the string passed to Minecraft formatting codes. Strings
+// without any markup are returned as is.
+func formatText(s string) string {
+	if !strings.ContainsRune(s, '<') {
+		return s
+	}
+	return text.Colourf("%s", s)
+}
+
+// truncateText truncates the string passed so that it holds at most n characters. If the string is
+// truncated in the middle of a § formatting code, the dangling § is removed too.
+func truncateText(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	r = r[:n]
+	if r[len(r)-1] == '§' {
+		r = r[:len(r)-1]
+	}
+	return string(r)
+}
+
 // format is a utility function to format a list of Values to have spaces between them, but no newline at the
 // end, which is typically used for sending messages, popups and tips.
 func format(a []any) string {
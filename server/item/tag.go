@@ -0,0 +1,67 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"slices"
+)
+
+// Tag is a tag that may be attached to items to group them, such as TagPlanks for all types of planks. Tags
+// may be used to write logic, such as recipes or fuel, against a group of items rather than individual item
+// types.
+type Tag string
+
+const (
+	// TagPlanks is the tag of all types of planks.
+	TagPlanks Tag = "minecraft:planks"
+	// TagLogs is the tag of all types of logs and wood, stripped or not.
+	TagLogs Tag = "minecraft:logs"
+	// TagWool is the tag of wool of all colours.
+	TagWool Tag = "minecraft:wool"
+	// TagArrows is the tag of arrows, including tipped arrows.
+	TagArrows Tag = "minecraft:arrow"
+	// TagCoals is the tag of coal and charcoal.
+	TagCoals Tag = "minecraft:coals"
+)
+
+// tags holds all tags registered using RegisterTag, indexed by the name of the item that they are attached to.
+var tags = map[string][]Tag{}
+
+// RegisterTag attaches a Tag to the items passed. Tags are attached to the name of the item, as returned by
+// its EncodeItem method, so the tag applies to all variants of the item with the same name.
+// RegisterTag is not safe for concurrent use and should generally be called in an init function.
+func RegisterTag(t Tag, items ...world.Item) {
+	for _, it := range items {
+		name, _ := it.EncodeItem()
+		if !slices.Contains(tags[name], t) {
+			tags[name] = append(tags[name], t)
+		}
+	}
+}
+
+// Tags returns all tags attached to the item passed. The slice returned is empty if no tags are attached to
+// the item.
+func Tags(it world.Item) []Tag {
+	name, _ := it.EncodeItem()
+	return slices.Clone(tags[name])
+}
+
+// HasTag checks if the Tag passed is attached to the item passed.
+func HasTag(it world.Item, t Tag) bool {
+	name, _ := it.EncodeItem()
+	return slices.Contains(tags[name], t)
+}
+
+// HasTag checks if the Tag passed is attached to the item of the Stack. False is always returned if the Stack
+// is empty.
+func (s Stack) HasTag(t Tag) bool {
+	if s.Empty() {
+		return false
+	}
+	return HasTag(s.item, t)
+}
+
+// init registers the tags of items implemented in the item package.
+func init() {
+	RegisterTag(TagArrows, Arrow{})
+	RegisterTag(TagCoals, Coal{}, Charcoal{})
+}
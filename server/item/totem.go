@@ -0,0 +1,20 @@
+package item
+
+// Totem is a totem of undying. When held in either hand, it prevents the holder from dying once, restoring
+// some of its health and granting it several effects instead.
+type Totem struct{}
+
+// MaxCount always returns 1.
+func (Totem) MaxCount() int {
+	return 1
+}
+
+// OffHand ...
+func (Totem) OffHand() bool {
+	return true
+}
+
+// EncodeItem ...
+func (Totem) EncodeItem() (name string, meta int16) {
+	return "minecraft:totem_of_undying", 0
+}
@@ -30,12 +30,13 @@ func (uc UserConfig) listenerFunc(conf Config) (Listener, error) {
 		ResourcePacks:          conf.Resources,
 		Biomes:                 biomes(),
 		TexturePacksRequired:   conf.ResourcesRequired,
+		PacketFunc:             countPayload,
 	}
 	if l, ok := conf.Log.(*logrus.Logger); ok {
 		cfg.ErrorLog = log.Default()
 		log.SetOutput(l.WithField("src", "gophertunnel").WriterLevel(logrus.DebugLevel))
 	}
-	l, err := cfg.Listen("raknet", uc.Network.Address)
+	l, err := cfg.Listen(trafficNetworkID, uc.Network.Address)
 	if err != nil {
 		return nil, fmt.Errorf("create minecraft listener: %w", err)
 	}
@@ -56,10 +57,17 @@ func (l listener) Accept() (session.Conn, error) {
 	if err != nil {
 		return nil, err
 	}
-	return conn.(session.Conn), err
+	c := conn.(*minecraft.Conn)
+	if t, ok := trafficOf(c.RemoteAddr()); ok {
+		return countedConn{Conn: c, t: t}, nil
+	}
+	return c, nil
 }
 
 // Disconnect disconnects a connection from the Listener with a reason.
 func (l listener) Disconnect(conn session.Conn, reason string) error {
+	if c, ok := conn.(countedConn); ok {
+		return l.Listener.Disconnect(c.Conn, reason)
+	}
 	return l.Listener.Disconnect(conn.(*minecraft.Conn), reason)
 }
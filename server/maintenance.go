@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/session"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// maintenance holds the maintenance mode state of a Server. While enabled, only operators and players that are
+// exempt from maintenance are able to join. The names of exempt players are stored in lower case, so that
+// checking if a player is exempt is case-insensitive.
+type maintenance struct {
+	file string
+
+	mu      sync.Mutex
+	enabled bool
+	exempt  map[string]struct{}
+}
+
+// maintenanceEntry is the maintenance mode state as stored in the maintenance file.
+type maintenanceEntry struct {
+	Enabled bool     `json:"enabled"`
+	Exempt  []string `json:"exempt"`
+}
+
+// loadMaintenance loads the maintenance mode state stored in the JSON file passed. If file is empty, the state is
+// not persisted. If the file does not exist, the enabled state and exempt player names passed are used instead.
+// The state returned is always usable, even if an error is returned, but it is not saved in that case, so that
+// the file is not overwritten.
+func loadMaintenance(file string, enabled bool, exempt []string) (*maintenance, error) {
+	m := &maintenance{file: file, enabled: enabled, exempt: make(map[string]struct{}, len(exempt))}
+	for _, name := range exempt {
+		m.exempt[strings.ToLower(name)] = struct{}{}
+	}
+	if file == "" {
+		return m, nil
+	}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	} else if err != nil {
+		m.file = ""
+		return m, fmt.Errorf("read maintenance: %w", err)
+	}
+	var state maintenanceEntry
+	if err := json.Unmarshal(data, &state); err != nil {
+		m.file = ""
+		return m, fmt.Errorf("decode maintenance: %w", err)
+	}
+	m.enabled, m.exempt = state.Enabled, make(map[string]struct{}, len(state.Exempt))
+	for _, name := range state.Exempt {
+		m.exempt[strings.ToLower(name)] = struct{}{}
+	}
+	return m, nil
+}
+
+// save writes the maintenance mode state to its file, if set. The file is replaced atomically, so that the state
+// is not lost if the server stops while writing. save must be called while holding m.mu.
+func (m *maintenance) save() error {
+	if m.file == "" {
+		return nil
+	}
+	state := maintenanceEntry{Enabled: m.enabled, Exempt: make([]string, 0, len(m.exempt))}
+	for name := range m.exempt {
+		state.Exempt = append(state.Exempt, name)
+	}
+	slices.Sort(state.Exempt)
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode maintenance: %w", err)
+	}
+	if err := writeFileAtomic(m.file, data); err != nil {
+		return fmt.Errorf("write maintenance: %w", err)
+	}
+	return nil
+}
+
+// Maintenance checks if the Server is currently in maintenance mode. While in maintenance mode, only operators
+// and players exempt from maintenance may join the Server.
+func (srv *Server) Maintenance() bool {
+	srv.maintenance.mu.Lock()
+	defer srv.maintenance.mu.Unlock()
+	return srv.maintenance.enabled
+}
+
+// SetMaintenance enables or disables maintenance mode of the Server. When enabled, all online players that are
+// not operators or exempt from maintenance are disconnected, and new players that are not exempt are refused
+// with the maintenance message of the Server. Sources other than players, such as the console, are not affected.
+// The change is saved to the maintenance file of the Server, if it has one. If saving fails, the change still
+// takes effect and an error is returned.
+func (srv *Server) SetMaintenance(enabled bool) error {
+	srv.maintenance.mu.Lock()
+	srv.maintenance.enabled = enabled
+	err := srv.maintenance.save()
+	srv.maintenance.mu.Unlock()
+
+	if !enabled {
+		return err
+	}
+	for _, p := range srv.Players() {
+		if !srv.MaintenanceExempt(p.Name(), p.XUID()) {
+			p.Disconnect(srv.maintenanceDisconnection())
+		}
+	}
+	return err
+}
+
+// MaintenanceExempt checks if the player with the name and XUID passed may join the Server while it is in
+// maintenance mode. Operators, identified by their XUID, are always exempt.
+func (srv *Server) MaintenanceExempt(name, xuid string) bool {
+	if srv.Operator(xuid) {
+		return true
+	}
+	srv.maintenance.mu.Lock()
+	defer srv.maintenance.mu.Unlock()
+	_, ok := srv.maintenance.exempt[strings.ToLower(name)]
+	return ok
+}
+
+// SetMaintenanceExempt exempts the player with the name passed from maintenance mode if exempt is true, or
+// removes the exemption otherwise. Removing the exemption of a player that is online while the Server is in
+// maintenance mode does not disconnect it. The change is saved to the maintenance file of the Server, if it has
+// one. If saving fails, the change still takes effect and an error is returned.
+func (srv *Server) SetMaintenanceExempt(name string, exempt bool) error {
+	srv.maintenance.mu.Lock()
+	defer srv.maintenance.mu.Unlock()
+	if exempt {
+		srv.maintenance.exempt[strings.ToLower(name)] = struct{}{}
+	} else {
+		delete(srv.maintenance.exempt, strings.ToLower(name))
+	}
+	return srv.maintenance.save()
+}
+
+// maintenanceDisconnection returns the session.Disconnection used to refuse or kick players while the Server is
+// in maintenance mode.
+func (srv *Server) maintenanceDisconnection() session.Disconnection {
+	d := session.Disconnection{Reason: session.DisconnectReasonMaintenance}
+	if srv.conf.MaintenanceMessage != "" {
+		d.Message = chat.Translation{Fallback: "%v", Params: []string{srv.conf.MaintenanceMessage}}
+	}
+	return d
+}
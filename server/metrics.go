@@ -0,0 +1,86 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/session"
+	"github.com/df-mc/dragonfly/server/world"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// playerMetric is a metric exported for every player online by
+// Server.WriteMetrics.
+type playerMetric struct {
+	name, kind, help string
+	value            func(s session.Stats) float64
+}
+
+// playerMetrics holds all metrics exported for every player online.
+var playerMetrics = []playerMetric{
+	{"dragonfly_player_packets_received_total", "counter", "Packets received from the client.", func(s session.Stats) float64 { return float64(s.PacketsReceived) }},
+	{"dragonfly_player_packets_sent_total", "counter", "Packets sent to the client.", func(s session.Stats) float64 { return float64(s.PacketsSent) }},
+	{"dragonfly_player_payload_received_bytes_total", "counter", "Size of the packets received before decompression.", func(s session.Stats) float64 { return float64(s.PayloadReceived) }},
+	{"dragonfly_player_payload_sent_bytes_total", "counter", "Size of the packets sent before compression.", func(s session.Stats) float64 { return float64(s.PayloadSent) }},
+	{"dragonfly_player_received_bytes_total", "counter", "Bytes received over the network.", func(s session.Stats) float64 { return float64(s.BytesReceived) }},
+	{"dragonfly_player_sent_bytes_total", "counter", "Bytes sent over the network.", func(s session.Stats) float64 { return float64(s.BytesSent) }},
+	{"dragonfly_player_compression_ratio", "gauge", "Ratio between the size of the packets sent and the bytes sent.", func(s session.Stats) float64 { return s.CompressionRatio() }},
+	{"dragonfly_player_latency_seconds", "gauge", "Latency of the connection.", func(s session.Stats) float64 { return s.Latency.Seconds() }},
+}
+
+// labelEscaper escapes the value of a label in the Prometheus text format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WriteMetrics writes the network statistics of all players online, as
+// returned by player.Player.NetworkStats, and the state of the overworld,
+// nether and end to w in the Prometheus text exposition format. Every player
+// is labelled with its name and XUID and every world with its dimension.
+func (srv *Server) WriteMetrics(w io.Writer) error {
+	players := srv.Players()
+	slices.SortFunc(players, func(a, b *player.Player) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	stats := make([]session.Stats, len(players))
+	for i, p := range players {
+		stats[i] = p.NetworkStats()
+	}
+
+	buf := bytes.NewBuffer(nil)
+	_, _ = fmt.Fprintf(buf, "# HELP dragonfly_players_online Players currently online.\n# TYPE dragonfly_players_online gauge\ndragonfly_players_online %v\n", len(players))
+	for _, m := range playerMetrics {
+		_, _ = fmt.Fprintf(buf, "# HELP %v %v\n# TYPE %v %v\n", m.name, m.help, m.name, m.kind)
+		for i, p := range players {
+			_, _ = fmt.Fprintf(buf, "%v{player=\"%v\",xuid=\"%v\"} %v\n", m.name, labelEscaper.Replace(p.Name()), labelEscaper.Replace(p.XUID()), m.value(stats[i]))
+		}
+	}
+
+	worlds := []*world.World{srv.World(), srv.Nether(), srv.End()}
+	_, _ = fmt.Fprintf(buf, "# HELP dragonfly_world_entities Entities in the world.\n# TYPE dragonfly_world_entities gauge\n")
+	for _, wo := range worlds {
+		_, _ = fmt.Fprintf(buf, "dragonfly_world_entities{dimension=\"%v\"} %v\n", wo.Dimension(), len(wo.Entities()))
+	}
+	_, _ = fmt.Fprintf(buf, "# HELP dragonfly_world_emergency Whether the watchdog of the world is in emergency mode.\n# TYPE dragonfly_world_emergency gauge\n")
+	for _, wo := range worlds {
+		emergency := 0
+		if wo.Emergency() {
+			emergency = 1
+		}
+		_, _ = fmt.Fprintf(buf, "dragonfly_world_emergency{dimension=\"%v\"} %v\n", wo.Dimension(), emergency)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// MetricsHandler returns an http.Handler that serves the metrics written by
+// WriteMetrics, so that they may be scraped by a monitoring system such as
+// Prometheus. The Server does not serve the handler itself: It should be
+// registered on an http.Server set up by the user.
+func (srv *Server) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = srv.WriteMetrics(w)
+	})
+}
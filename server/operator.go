@@ -0,0 +1,167 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// operators holds all players that are operators of a Server. Operators are allowed to run administration
+// commands such as /kick and /stop. Operators are identified by their XUID, so that a player keeps its
+// operator status when changing its name and another player cannot obtain it by taking the name.
+type operators struct {
+	file string
+
+	mu sync.Mutex
+	// names maps the XUIDs of operators to the name they had when they were made operator.
+	names map[string]string
+}
+
+// operatorEntry is an operator as stored in the operators file.
+type operatorEntry struct {
+	XUID string `json:"xuid"`
+	Name string `json:"name"`
+}
+
+// loadOperators loads the operators stored in the JSON file passed. If file is empty, operators are not
+// persisted. If the file does not exist, no operators are loaded. The operators returned are always usable,
+// even if an error is returned, but they are not saved in that case, so that the file is not overwritten.
+func loadOperators(file string) (*operators, error) {
+	ops := &operators{file: file, names: make(map[string]string)}
+	if file == "" {
+		return ops, nil
+	}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return ops, nil
+	} else if err != nil {
+		ops.file = ""
+		return ops, fmt.Errorf("read operators: %w", err)
+	}
+	var entries []operatorEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		ops.file = ""
+		return ops, fmt.Errorf("decode operators: %w", err)
+	}
+	for _, e := range entries {
+		if e.XUID != "" {
+			ops.names[e.XUID] = e.Name
+		}
+	}
+	return ops, nil
+}
+
+// operator checks if the player with the XUID passed is an operator. Players without XUID are never
+// operators.
+func (ops *operators) operator(xuid string) bool {
+	if xuid == "" {
+		return false
+	}
+	ops.mu.Lock()
+	defer ops.mu.Unlock()
+	_, ok := ops.names[xuid]
+	return ok
+}
+
+// xuid looks up the XUID of the operator that had the name passed when it was made operator. The name is
+// compared case-insensitively.
+func (ops *operators) xuid(name string) (string, bool) {
+	ops.mu.Lock()
+	defer ops.mu.Unlock()
+	for xuid, n := range ops.names {
+		if strings.EqualFold(n, name) {
+			return xuid, true
+		}
+	}
+	return "", false
+}
+
+// set makes the player with the XUID and name passed an operator if op is true, or removes its operator
+// status otherwise. The operators are saved to disk if they changed.
+func (ops *operators) set(xuid, name string, op bool) error {
+	if xuid == "" {
+		return fmt.Errorf("player %v has no XUID", name)
+	}
+	ops.mu.Lock()
+	defer ops.mu.Unlock()
+
+	if current, ok := ops.names[xuid]; ok == op && (!op || current == name) {
+		return nil
+	}
+	if op {
+		ops.names[xuid] = name
+	} else {
+		delete(ops.names, xuid)
+	}
+	return ops.save()
+}
+
+// save writes the operators to their file, if set. The file is replaced atomically, so that the operators
+// are not lost if the server stops while writing. save must be called while holding ops.mu.
+func (ops *operators) save() error {
+	if ops.file == "" {
+		return nil
+	}
+	entries := make([]operatorEntry, 0, len(ops.names))
+	for xuid, name := range ops.names {
+		entries = append(entries, operatorEntry{XUID: xuid, Name: name})
+	}
+	slices.SortFunc(entries, func(a, b operatorEntry) int {
+		return strings.Compare(a.XUID, b.XUID)
+	})
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode operators: %w", err)
+	}
+	if err := writeFileAtomic(ops.file, data); err != nil {
+		return fmt.Errorf("write operators: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to the file with the name passed by writing it to a temporary file in the
+// same directory first and renaming it to the file once it is synced to disk. The file therefore either
+// holds its old or its new contents, even if writing is interrupted.
+func writeFileAtomic(name string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp, name)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+	}
+	return err
+}
+
+// Operator checks if the player with the XUID passed is an operator of the Server. Operators are allowed to
+// run administration commands, such as /kick and /stop. Players without XUID, such as players joining a
+// Server with authentication disabled, are never operators.
+func (srv *Server) Operator(xuid string) bool {
+	return srv.ops.operator(xuid)
+}
+
+// SetOperator makes the player with the XUID passed an operator of the Server if op is true, or removes its
+// operator status if op is false. The name passed is stored along with the XUID, so that the operator may
+// later be looked up by that name, for example by /deop. The change is saved to the operators file of the
+// Server, if it has one. The player does not need to be online.
+func (srv *Server) SetOperator(xuid, name string, op bool) error {
+	return srv.ops.set(xuid, name, op)
+}
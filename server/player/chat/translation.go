@@ -0,0 +1,49 @@
+package chat
+
+import "fmt"
+
+// Translation is a message that is translated by the client of the subscriber that receives it. Subscribers
+// that are unable to translate messages, such as the StdoutSubscriber, receive the Fallback of the Translation
+// instead.
+type Translation struct {
+	// Key is the translation key of the message, for example 'death.attack.player'. If Key is empty, the
+	// message is never translated and the Fallback is sent to all subscribers instead.
+	Key string
+	// Params are the parameters of the message. They are filled into the translated message in the order
+	// that they are in.
+	Params []string
+	// Fallback is the format of the message used if it cannot be translated. Params are formatted into the
+	// Fallback according to the fmt.Sprintf formatting rules.
+	Fallback string
+}
+
+// String returns the Fallback of the Translation with its Params formatted into it.
+func (t Translation) String() string {
+	params := make([]any, len(t.Params))
+	for i, p := range t.Params {
+		params[i] = p
+	}
+	return fmt.Sprintf(t.Fallback, params...)
+}
+
+// Translator is a Subscriber that is able to receive messages that are translated on its side. Subscribers
+// that do not implement Translator receive Translation.String() instead.
+type Translator interface {
+	Subscriber
+	// MessageTranslation sends a Translation to the subscriber.
+	MessageTranslation(t Translation)
+}
+
+// WriteTranslation writes a Translation to the chat. Subscribers that implement Translator receive the
+// Translation as is, while other subscribers receive its fallback message.
+func (chat *Chat) WriteTranslation(t Translation) {
+	chat.m.Lock()
+	defer chat.m.Unlock()
+	for subscriber := range chat.subscribers {
+		if tr, ok := subscriber.(Translator); ok {
+			tr.MessageTranslation(t)
+			continue
+		}
+		subscriber.Message(t)
+	}
+}
@@ -0,0 +1,82 @@
+package cutscene
+
+import (
+	"github.com/df-mc/dragonfly/server/player"
+	"sync"
+)
+
+// Cutscene is a sequence of Steps that are played to a player one after another. A Cutscene may be played to
+// multiple players at the same time, each with their own Playback.
+type Cutscene struct {
+	steps []Step
+}
+
+// New creates a Cutscene that plays the Steps passed in order.
+func New(steps ...Step) Cutscene {
+	return Cutscene{steps: steps}
+}
+
+// Then returns a copy of the Cutscene with the Steps passed added after its current Steps.
+func (c Cutscene) Then(steps ...Step) Cutscene {
+	c.steps = append(append(make([]Step, 0, len(c.steps)+len(steps)), c.steps...), steps...)
+	return c
+}
+
+// Steps returns the Steps of the Cutscene.
+func (c Cutscene) Steps() []Step {
+	return append([]Step(nil), c.steps...)
+}
+
+// Play starts playing the Cutscene to the player passed and returns the Playback of the Cutscene. The Steps of the
+// Cutscene are played on a separate goroutine, so Play returns immediately. The Cutscene is not stopped
+// automatically if the player leaves the server: Playback.Stop should be called when the player quits.
+func (c Cutscene) Play(p *player.Player) *Playback {
+	pb := &Playback{stop: make(chan struct{}), done: make(chan struct{})}
+	go pb.run(p, c.steps)
+	return pb
+}
+
+// Playback is a Cutscene being played to a player. It may be used to stop the Cutscene or to wait for it to
+// finish. Playback is safe for concurrent usage.
+type Playback struct {
+	once sync.Once
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Stop stops the Cutscene. The Step currently playing is interrupted and no further Steps are played. Stop does
+// nothing if the Cutscene was already stopped or finished.
+func (pb *Playback) Stop() {
+	pb.once.Do(func() {
+		close(pb.stop)
+	})
+}
+
+// Stopped checks if the Cutscene was stopped using Stop before it finished.
+func (pb *Playback) Stopped() bool {
+	select {
+	case <-pb.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// Done returns a channel that is closed once the Cutscene finished playing or was stopped. By then, the camera of
+// the player has been returned to the player.
+func (pb *Playback) Done() <-chan struct{} {
+	return pb.done
+}
+
+// run plays the Steps passed to the player one after another until all Steps are played or the Playback is
+// stopped. The camera of the player is returned to the player afterwards, in case a Step moved it.
+func (pb *Playback) run(p *player.Player, steps []Step) {
+	defer close(pb.done)
+	defer p.ClearCamera()
+	for _, s := range steps {
+		if pb.Stopped() {
+			return
+		}
+		s.Play(p, pb.stop)
+	}
+}
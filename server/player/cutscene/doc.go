@@ -0,0 +1,16 @@
+// Package cutscene implements cutscenes: Sequences of steps, such as camera movements, titles, sounds and emotes,
+// that are played to a player one after another. Cutscenes may be used for tutorials, intros and story-driven
+// servers.
+//
+// A Cutscene is created from its steps using New and may be played to any number of players at the same time.
+// Playing a Cutscene returns a Playback, which may be used to stop the Cutscene before it finishes.
+//
+//	c := cutscene.New(
+//		cutscene.Camera(mgl64.Vec3{0, 80, 0}, cube.Rotation{90, 30}),
+//		cutscene.Title(title.New("Welcome!")),
+//		cutscene.Pan(mgl64.Vec3{20, 80, 0}, cube.Rotation{90, 30}, time.Second*5),
+//		cutscene.Emote(npc, wave),
+//		cutscene.Wait(time.Second),
+//	)
+//	playback := c.Play(p)
+package cutscene
@@ -0,0 +1,105 @@
+package cutscene
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/player/title"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Step is a single step of a Cutscene. Custom Steps may be implemented to extend the steps available.
+type Step interface {
+	// Play plays the Step to the player passed. Play should return once the Step is finished, or as soon as
+	// possible after the stop channel passed is closed.
+	Play(p *player.Player, stop <-chan struct{})
+}
+
+// StepFunc is a function that implements Step. The function is called when the Step is played. It should return
+// immediately after stop is closed.
+type StepFunc func(p *player.Player, stop <-chan struct{})
+
+// Play ...
+func (f StepFunc) Play(p *player.Player, stop <-chan struct{}) {
+	f(p, stop)
+}
+
+// Func returns a Step that calls the function passed with the player that the Cutscene is played to. It may be
+// used to run arbitrary code in between other Steps, such as making the player immobile at the start of a
+// Cutscene.
+func Func(f func(p *player.Player)) Step {
+	return StepFunc(func(p *player.Player, _ <-chan struct{}) {
+		f(p)
+	})
+}
+
+// Wait returns a Step that waits for the duration passed before the next Step is played.
+func Wait(d time.Duration) Step {
+	return StepFunc(func(_ *player.Player, stop <-chan struct{}) {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-stop:
+		}
+	})
+}
+
+// Title returns a Step that sends the title.Title passed to the player. The Step does not wait for the title to
+// disappear: Wait may be used to do so.
+func Title(t title.Title) Step {
+	return Func(func(p *player.Player) {
+		p.SendTitle(t)
+	})
+}
+
+// Message returns a Step that sends a chat message to the player. The arguments are formatted like fmt.Sprintln.
+func Message(a ...any) Step {
+	return Func(func(p *player.Player) {
+		p.Message(a...)
+	})
+}
+
+// Sound returns a Step that plays the world.Sound passed to the player only.
+func Sound(s world.Sound) Step {
+	return Func(func(p *player.Player) {
+		p.PlaySound(s)
+	})
+}
+
+// Emote returns a Step that makes the player passed, typically an NPC, perform the emote with the UUID passed.
+// The emote is shown to all viewers of that player.
+func Emote(npc *player.Player, emote uuid.UUID) Step {
+	return Func(func(*player.Player) {
+		npc.Emote(emote)
+	})
+}
+
+// Shake returns a Step that shakes the camera of the player with the intensity passed for the duration passed.
+// The Step does not wait for the shaking to stop.
+func Shake(intensity float64, duration time.Duration, rotational bool) Step {
+	return Func(func(p *player.Player) {
+		p.ShakeCamera(intensity, duration, rotational)
+	})
+}
+
+// Camera returns a Step that instantly moves the camera of the player to the position passed and makes it look in
+// the direction of the rotation passed. The player itself does not move. The camera is returned to the player once
+// the Cutscene finishes or is stopped.
+func Camera(pos mgl64.Vec3, rot cube.Rotation) Step {
+	return Func(func(p *player.Player) {
+		p.SetCamera(pos, rot, 0)
+	})
+}
+
+// Pan returns a Step that smoothly moves the camera of the player from its current position and rotation to the
+// position and rotation passed over the duration passed. The Step waits until the camera reaches its destination.
+// The camera is returned to the player once the Cutscene finishes or is stopped.
+func Pan(pos mgl64.Vec3, rot cube.Rotation, d time.Duration) Step {
+	return StepFunc(func(p *player.Player, stop <-chan struct{}) {
+		p.SetCamera(pos, rot, d)
+		Wait(d).Play(p, stop)
+	})
+}
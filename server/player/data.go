@@ -54,6 +54,9 @@ type Data struct {
 	FireTicks int64
 	// FallDistance is the distance the player has currently been falling. This is used to calculate fall damage.
 	FallDistance float64
+	// SeenCredits specifies if the player has finished watching the end credits, which are only shown the first
+	// time a player leaves the End.
+	SeenCredits bool
 	// World is the world the player was last in.
 	World *world.World
 }
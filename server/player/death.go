@@ -0,0 +1,183 @@
+package player
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/world"
+	"strings"
+	"sync"
+	"time"
+)
+
+// killAttributionDuration is the duration after being damaged by an entity within which a death of the
+// player is still attributed to that entity.
+const killAttributionDuration = time.Second * 5
+
+// attackTracker keeps track of the last entity that damaged a player, so that a death may be attributed to
+// it even if the damage that killed the player was not directly dealt by it.
+type attackTracker struct {
+	mu       sync.Mutex
+	attacker world.Entity
+	at       time.Time
+}
+
+// track stores the entity responsible for the world.DamageSource passed, if any, as the last attacker.
+func (t *attackTracker) track(src world.DamageSource, self world.Entity) {
+	if attacker := damageOwner(src); attacker != nil && attacker != self {
+		t.mu.Lock()
+		t.attacker, t.at = attacker, time.Now()
+		t.mu.Unlock()
+	}
+}
+
+// last returns the last attacker tracked if it attacked within the killAttributionDuration.
+func (t *attackTracker) last() (world.Entity, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.attacker == nil || time.Since(t.at) > killAttributionDuration {
+		return nil, false
+	}
+	return t.attacker, true
+}
+
+// reset clears the last attacker tracked.
+func (t *attackTracker) reset() {
+	t.mu.Lock()
+	t.attacker = nil
+	t.mu.Unlock()
+}
+
+// LastAttacker returns the entity that last dealt damage to the player, either directly or, for example,
+// through a projectile or an explosion of TNT it ignited. The bool returned is false if no entity damaged the
+// player in the last 5 seconds.
+func (p *Player) LastAttacker() (world.Entity, bool) {
+	return p.attackers.last()
+}
+
+// killer returns the entity that a death by the world.DamageSource passed is attributed to. If the source
+// itself does not have an entity responsible for it, the last attacker of the player is returned.
+func (p *Player) killer(src world.DamageSource) world.Entity {
+	if owner := damageOwner(src); owner != nil && owner != p {
+		return owner
+	}
+	attacker, _ := p.attackers.last()
+	return attacker
+}
+
+// damageOwner returns the entity responsible for a world.DamageSource, or nil if no entity was responsible.
+func damageOwner(src world.DamageSource) world.Entity {
+	switch s := src.(type) {
+	case entity.AttackDamageSource:
+		return s.Attacker
+	case entity.ProjectileDamageSource:
+		return s.Owner
+	case entity.ExplosionDamageSource:
+		return s.Owner
+	case enchantment.ThornsDamageSource:
+		return s.Owner
+	}
+	return nil
+}
+
+// deathMessage returns the chat.Translation of the message broadcast when the player dies to the
+// world.DamageSource passed. The killer passed may be nil.
+func (p *Player) deathMessage(src world.DamageSource, killer world.Entity) chat.Translation {
+	msg := func(key, fallback string, params ...string) chat.Translation {
+		return chat.Translation{Key: key, Params: append([]string{p.Name()}, params...), Fallback: fallback}
+	}
+	withKiller := func(key, fallback, keyKiller, fallbackKiller string) chat.Translation {
+		if killer == nil {
+			return msg(key, fallback)
+		}
+		return msg(keyKiller, fallbackKiller, entityName(killer))
+	}
+
+	switch s := src.(type) {
+	case entity.AttackDamageSource:
+		if _, ok := s.Attacker.(*Player); !ok {
+			return msg("death.attack.mob", "%v was slain by %v", entityName(s.Attacker))
+		}
+		if name, ok := heldItemName(s.Attacker); ok {
+			return msg("death.attack.player.item", "%v was slain by %v using %v", entityName(s.Attacker), name)
+		}
+		return msg("death.attack.player", "%v was slain by %v", entityName(s.Attacker))
+	case entity.ProjectileDamageSource:
+		if s.Projectile != nil && s.Projectile.Type().EncodeEntity() == "minecraft:fireworks_rocket" {
+			return msg("death.attack.fireworks", "%v went off with a bang")
+		}
+		if killer == nil {
+			break
+		}
+		if s.Projectile == nil || s.Projectile.Type().EncodeEntity() != "minecraft:arrow" {
+			return msg("death.attack.thrown", "%v was pelted by %v", entityName(killer))
+		}
+		if name, ok := heldItemName(killer); ok {
+			return msg("death.attack.arrow.item", "%v was shot by %v using %v", entityName(killer), name)
+		}
+		return msg("death.attack.arrow", "%v was shot by %v", entityName(killer))
+	case entity.ExplosionDamageSource:
+		return withKiller("death.attack.explosion", "%v blew up", "death.attack.explosion.player", "%v was blown up by %v")
+	case enchantment.ThornsDamageSource:
+		return msg("death.attack.thorns", "%v was killed trying to hurt %v", entityName(s.Owner))
+	case entity.FallDamageSource:
+		return withKiller("death.attack.fall", "%v hit the ground too hard", "death.fell.assist", "%v was doomed to fall by %v")
+	case entity.VoidDamageSource:
+		return msg("death.attack.outOfWorld", "%v fell out of the world")
+	case entity.SuffocationDamageSource:
+		return msg("death.attack.inWall", "%v suffocated in a wall")
+	case entity.DrowningDamageSource:
+		return withKiller("death.attack.drown", "%v drowned", "death.attack.drown.player", "%v drowned whilst trying to escape %v")
+	case entity.FreezingDamageSource:
+		return withKiller("death.attack.freeze", "%v froze to death", "death.attack.freeze.player", "%v was frozen to death by %v")
+	case entity.GlideDamageSource:
+		return msg("death.attack.flyIntoWall", "%v experienced kinetic energy")
+	case entity.LightningDamageSource:
+		return msg("death.attack.lightningBolt", "%v was struck by lightning")
+	case block.LavaDamageSource:
+		return withKiller("death.attack.lava", "%v tried to swim in lava", "death.attack.lava.player", "%v tried to swim in lava to escape %v")
+	case block.FireDamageSource:
+		return withKiller("death.attack.onFire", "%v burned to death", "death.attack.onFire.player", "%v was burnt to a crisp whilst fighting %v")
+	case block.DamageSource:
+		switch s.Block.(type) {
+		case block.Cactus:
+			return withKiller("death.attack.cactus", "%v was pricked to death", "death.attack.cactus.player", "%v walked into a cactus whilst trying to escape %v")
+		case block.Anvil:
+			return msg("death.attack.anvil", "%v was squashed by a falling anvil")
+		}
+		return msg("death.attack.fallingBlock", "%v was squashed by a falling block")
+	case StarvationDamageSource:
+		return msg("death.attack.starve", "%v starved to death")
+	case effect.WitherDamageSource:
+		return msg("death.attack.wither", "%v withered away")
+	case effect.PoisonDamageSource, effect.InstantDamageSource:
+		return withKiller("death.attack.magic", "%v was killed by magic", "death.attack.indirectMagic", "%v was killed by %v using magic")
+	}
+	return withKiller("death.attack.generic", "%v died", "death.attack.player", "%v was slain by %v")
+}
+
+// entityName returns the name used to refer to an entity in a death message. If the entity has no name or
+// name tag, the translation key of its type is returned.
+func entityName(e world.Entity) string {
+	if n, ok := e.(interface{ Name() string }); ok && n.Name() != "" {
+		return n.Name()
+	}
+	if n, ok := e.(interface{ NameTag() string }); ok && n.NameTag() != "" {
+		return n.NameTag()
+	}
+	return "%entity." + strings.TrimPrefix(e.Type().EncodeEntity(), "minecraft:") + ".name"
+}
+
+// heldItemName returns the custom name of the item held by an entity. False is returned if the entity does
+// not hold an item with a custom name.
+func heldItemName(e world.Entity) (string, bool) {
+	c, ok := e.(item.Carrier)
+	if !ok {
+		return "", false
+	}
+	held, _ := c.HeldItems()
+	return held.CustomName(), held.CustomName() != ""
+}
@@ -0,0 +1,4 @@
+// Package device implements types that describe the device that a player is playing on, such as its OS, the
+// InputMode it uses to play and the UIProfile of its user interface. These may be used to adapt forms, menus and
+// other features to the device of a player, for example by using bigger buttons for players on a touch screen.
+package device
@@ -0,0 +1,63 @@
+package device
+
+// InputMode is the way in which a client provides input to the game, such as with a keyboard and mouse or with a
+// touch screen. Clients may switch between input modes at any time, for example when a controller is connected.
+type InputMode struct {
+	inputMode
+}
+
+// KeyboardAndMouse is the InputMode of clients that play using a keyboard and mouse.
+func KeyboardAndMouse() InputMode {
+	return InputMode{1}
+}
+
+// Touch is the InputMode of clients that play using a touch screen, typically on mobile devices.
+func Touch() InputMode {
+	return InputMode{2}
+}
+
+// GamePad is the InputMode of clients that play using a controller, typically on consoles.
+func GamePad() InputMode {
+	return InputMode{3}
+}
+
+// MotionController is the InputMode of clients that play using motion controllers, such as in virtual reality.
+func MotionController() InputMode {
+	return InputMode{4}
+}
+
+// InputModes returns all input modes.
+func InputModes() []InputMode {
+	return []InputMode{KeyboardAndMouse(), Touch(), GamePad(), MotionController()}
+}
+
+// InputModeByID returns the InputMode with the ID passed, as sent by the client. If no InputMode with the ID
+// exists, false is returned.
+func InputModeByID(id int) (InputMode, bool) {
+	if id < 1 || id > 4 {
+		return InputMode{}, false
+	}
+	return InputMode{inputMode(id)}, true
+}
+
+type inputMode uint8
+
+// Uint8 returns the input mode as a uint8.
+func (m inputMode) Uint8() uint8 {
+	return uint8(m)
+}
+
+// String returns the input mode as a string.
+func (m inputMode) String() string {
+	switch m {
+	case 1:
+		return "keyboard_and_mouse"
+	case 2:
+		return "touch"
+	case 3:
+		return "gamepad"
+	case 4:
+		return "motion_controller"
+	}
+	return "unknown"
+}
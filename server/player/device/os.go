@@ -0,0 +1,157 @@
+package device
+
+// OS is the operating system of the device that a client plays on, such as Android or Windows.
+type OS struct {
+	os
+}
+
+// Android is the OS of clients playing on Android devices.
+func Android() OS {
+	return OS{1}
+}
+
+// IOS is the OS of clients playing on iPhones and iPads.
+func IOS() OS {
+	return OS{2}
+}
+
+// MacOS is the OS of clients playing on Macs.
+func MacOS() OS {
+	return OS{3}
+}
+
+// FireOS is the OS of clients playing on Amazon Fire devices.
+func FireOS() OS {
+	return OS{4}
+}
+
+// GearVR is the OS of clients playing on Samsung Gear VR headsets.
+func GearVR() OS {
+	return OS{5}
+}
+
+// HoloLens is the OS of clients playing on Microsoft HoloLens headsets.
+func HoloLens() OS {
+	return OS{6}
+}
+
+// Windows is the OS of clients playing on Windows 10 or later.
+func Windows() OS {
+	return OS{7}
+}
+
+// Win32 is the OS of clients playing the Win32 edition of the game, such as the education edition.
+func Win32() OS {
+	return OS{8}
+}
+
+// Dedicated is the OS of dedicated servers.
+func Dedicated() OS {
+	return OS{9}
+}
+
+// TVOS is the OS of clients playing on Apple TVs.
+func TVOS() OS {
+	return OS{10}
+}
+
+// PlayStation is the OS of clients playing on PlayStation consoles.
+func PlayStation() OS {
+	return OS{11}
+}
+
+// NintendoSwitch is the OS of clients playing on Nintendo Switch consoles.
+func NintendoSwitch() OS {
+	return OS{12}
+}
+
+// Xbox is the OS of clients playing on Xbox consoles.
+func Xbox() OS {
+	return OS{13}
+}
+
+// WindowsPhone is the OS of clients playing on Windows Phones.
+func WindowsPhone() OS {
+	return OS{14}
+}
+
+// Linux is the OS of clients playing on Linux.
+func Linux() OS {
+	return OS{15}
+}
+
+// OSes returns all operating systems.
+func OSes() []OS {
+	return []OS{Android(), IOS(), MacOS(), FireOS(), GearVR(), HoloLens(), Windows(), Win32(), Dedicated(), TVOS(), PlayStation(), NintendoSwitch(), Xbox(), WindowsPhone(), Linux()}
+}
+
+// OSByID returns the OS with the ID passed, as sent by the client. If no OS with the ID exists, false is
+// returned.
+func OSByID(id int) (OS, bool) {
+	if id < 1 || id > 15 {
+		return OS{}, false
+	}
+	return OS{os(id)}, true
+}
+
+// Mobile checks if the OS is that of a mobile device, such as a phone or a tablet.
+func (o OS) Mobile() bool {
+	switch o {
+	case Android(), IOS(), FireOS(), WindowsPhone():
+		return true
+	}
+	return false
+}
+
+// Console checks if the OS is that of a game console.
+func (o OS) Console() bool {
+	switch o {
+	case PlayStation(), NintendoSwitch(), Xbox():
+		return true
+	}
+	return false
+}
+
+type os uint8
+
+// Uint8 returns the OS as a uint8.
+func (o os) Uint8() uint8 {
+	return uint8(o)
+}
+
+// String returns the OS as a string.
+func (o os) String() string {
+	switch o {
+	case 1:
+		return "Android"
+	case 2:
+		return "iOS"
+	case 3:
+		return "macOS"
+	case 4:
+		return "FireOS"
+	case 5:
+		return "Gear VR"
+	case 6:
+		return "HoloLens"
+	case 7:
+		return "Windows"
+	case 8:
+		return "Win32"
+	case 9:
+		return "Dedicated"
+	case 10:
+		return "tvOS"
+	case 11:
+		return "PlayStation"
+	case 12:
+		return "Nintendo Switch"
+	case 13:
+		return "Xbox"
+	case 14:
+		return "Windows Phone"
+	case 15:
+		return "Linux"
+	}
+	return "unknown"
+}
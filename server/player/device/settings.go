@@ -0,0 +1,8 @@
+package device
+
+// Settings holds settings of a client that it may change while it is connected, such as its render distance.
+type Settings struct {
+	// RenderDistance is the render distance in chunks that the client requested, limited to the maximum chunk
+	// radius of the server.
+	RenderDistance int
+}
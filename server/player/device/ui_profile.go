@@ -0,0 +1,51 @@
+package device
+
+// UIProfile is the layout of the user interface that a client uses. The UIProfile is selected in the settings of
+// the client and does not change while the client is connected.
+type UIProfile struct {
+	uiProfile
+}
+
+// ClassicUI is the UIProfile of clients using the classic user interface, which is the default on desktop and
+// console devices.
+func ClassicUI() UIProfile {
+	return UIProfile{0}
+}
+
+// PocketUI is the UIProfile of clients using the pocket user interface, which has larger buttons and is the
+// default on mobile devices.
+func PocketUI() UIProfile {
+	return UIProfile{1}
+}
+
+// UIProfiles returns all UI profiles.
+func UIProfiles() []UIProfile {
+	return []UIProfile{ClassicUI(), PocketUI()}
+}
+
+// UIProfileByID returns the UIProfile with the ID passed, as sent by the client. If no UIProfile with the ID
+// exists, false is returned.
+func UIProfileByID(id int) (UIProfile, bool) {
+	if id < 0 || id > 1 {
+		return UIProfile{}, false
+	}
+	return UIProfile{uiProfile(id)}, true
+}
+
+type uiProfile uint8
+
+// Uint8 returns the UI profile as a uint8.
+func (p uiProfile) Uint8() uint8 {
+	return uint8(p)
+}
+
+// String returns the UI profile as a string.
+func (p uiProfile) String() string {
+	switch p {
+	case 0:
+		return "classic"
+	case 1:
+		return "pocket"
+	}
+	panic("should never happen")
+}
@@ -0,0 +1,80 @@
+package player
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/player/skin"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"testing"
+	"time"
+)
+
+// newTestPlayer returns a new Player added to an empty world at the position passed. The world is closed once
+// the test finishes.
+func newTestPlayer(t *testing.T, pos mgl64.Vec3) (*Player, *world.World) {
+	t.Helper()
+	w := world.Config{Generator: world.NopGenerator{}}.New()
+	t.Cleanup(func() {
+		_ = w.Close()
+	})
+	p := New("test", skin.Skin{}, pos)
+	w.AddEntity(p)
+	return p, w
+}
+
+func TestFireResistanceLavaImmunity(t *testing.T) {
+	p, _ := newTestPlayer(t, mgl64.Vec3{0, 10, 0})
+	if _, vulnerable := p.Hurt(4, block.LavaDamageSource{}); !vulnerable {
+		t.Fatalf("player without fire resistance should be hurt by lava")
+	}
+	health := p.Health()
+
+	p.AddEffect(effect.New(effect.FireResistance{}, 1, time.Minute))
+	if dmg, vulnerable := p.Hurt(4, block.LavaDamageSource{}); vulnerable || dmg != 0 {
+		t.Fatalf("player with fire resistance should not be hurt by lava, got %v damage", dmg)
+	}
+	if p.Health() != health {
+		t.Fatalf("health of player with fire resistance changed from %v to %v", health, p.Health())
+	}
+}
+
+func TestWaterBreathingAirSupply(t *testing.T) {
+	p, w := newTestPlayer(t, mgl64.Vec3{0.5, 10, 0.5})
+	for y := 10; y <= 12; y++ {
+		w.SetBlock(cube.Pos{0, y, 0}, block.Water{Still: true, Depth: 8}, nil)
+	}
+	if p.canBreathe(w) {
+		t.Fatalf("player under water without water breathing should not be able to breathe")
+	}
+
+	p.AddEffect(effect.New(effect.WaterBreathing{}, 1, time.Minute))
+	if !p.canBreathe(w) {
+		t.Fatalf("player under water with water breathing should be able to breathe")
+	}
+}
+
+func TestLevitationFallDistance(t *testing.T) {
+	p, _ := newTestPlayer(t, mgl64.Vec3{0, 10, 0})
+	p.AddEffect(effect.New(effect.Levitation{}, 1, time.Minute))
+	p.updateFallState(-5)
+	if d := p.fallDistance.Load(); d != 0 {
+		t.Fatalf("levitating player should not build up fall distance, got %v", d)
+	}
+}
+
+func TestSlowFallingFallDistance(t *testing.T) {
+	p, _ := newTestPlayer(t, mgl64.Vec3{0, 10, 0})
+	p.updateFallState(-5)
+	if d := p.fallDistance.Load(); d != 5 {
+		t.Fatalf("falling player should build up a fall distance of 5, got %v", d)
+	}
+	p.ResetFallDistance()
+
+	p.AddEffect(effect.New(effect.SlowFalling{}, 1, time.Minute))
+	p.updateFallState(-5)
+	if d := p.fallDistance.Load(); d != 0 {
+		t.Fatalf("player with slow falling should not build up fall distance, got %v", d)
+	}
+}
@@ -109,6 +109,9 @@ func (f Custom) SubmitJSON(b []byte, submitter Submitter) error {
 		fieldV.Set(elem)
 		data = data[1:]
 	}
+	if len(data) != 0 {
+		return fmt.Errorf("form JSON data array has %v values too many", len(data))
+	}
 
 	v.Interface().(Submittable).Submit(submitter)
 
@@ -5,6 +5,8 @@ import (
 	"github.com/df-mc/dragonfly/server/cmd"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/player/device"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
@@ -30,13 +32,31 @@ type Handler interface {
 	// HandleToggleSneak handles when the player starts or stops sneaking.
 	// After is true if the player is sneaking after toggling (changing their sneaking state).
 	HandleToggleSneak(ctx *event.Context, after bool)
+	// HandleSleep handles the player going to sleep in the bed at the position passed. ctx.Cancel() may be
+	// called to prevent the player from sleeping.
+	HandleSleep(ctx *event.Context, pos cube.Pos)
+	// HandleToggleFlight handles when the player starts or stops flying.
+	// After is true if the player is flying after toggling (changing their flying state).
+	HandleToggleFlight(ctx *event.Context, after bool)
 	// HandleChat handles a message sent in the chat by a player. ctx.Cancel() may be called to cancel the
 	// message being sent in chat.
 	// The message may be changed by assigning to *message.
 	HandleChat(ctx *event.Context, message *string)
+	// HandleExhaust handles the player being exhausted by the world.ExhaustionSource passed, such as
+	// SprintExhaustionSource or RegenerationExhaustionSource. Once the total exhaustion of the player exceeds
+	// 4, a saturation point, or a food point if the player has no saturation left, is lost. ctx.Cancel() may be
+	// called to cancel the exhaustion.
+	// The exhaustion added may be changed by assigning to *points.
+	HandleExhaust(ctx *event.Context, points *float64, src world.ExhaustionSource)
 	// HandleFoodLoss handles the food bar of a player depleting naturally, for example because the player was
 	// sprinting and jumping. ctx.Cancel() may be called to cancel the food points being lost.
-	HandleFoodLoss(ctx *event.Context, from int, to *int)
+	// The food level after the loss may be changed by assigning to *to. src is the world.ExhaustionSource
+	// that caused the loss.
+	HandleFoodLoss(ctx *event.Context, from int, to *int, src world.ExhaustionSource)
+	// HandleFoodGain handles the player gaining food and saturation points, for example by eating food.
+	// ctx.Cancel() may be called to cancel the food being gained.
+	// The food and saturation points added may be changed by assigning to *food and *saturation.
+	HandleFoodGain(ctx *event.Context, food *int, saturation *float64, src FoodSource)
 	// HandleHeal handles the player being healed by a healing source. ctx.Cancel() may be called to cancel
 	// the healing.
 	// The health added may be changed by assigning to *health.
@@ -47,6 +67,16 @@ type Handler interface {
 	HandleHurt(ctx *event.Context, damage *float64, attackImmunity *time.Duration, src world.DamageSource)
 	// HandleDeath handles the player dying to a particular damage cause.
 	HandleDeath(src world.DamageSource, keepInv *bool)
+	// HandleDeathMessage handles the death message broadcast when the player dies to the damage source passed.
+	// The killer passed is the entity the death is attributed to and may be nil. The message may be changed by
+	// assigning to *message. ctx.Cancel() may be called to prevent the message from being broadcast.
+	HandleDeathMessage(ctx *event.Context, src world.DamageSource, killer world.Entity, message *chat.Translation)
+	// HandleDeathDrops handles the items and experience of the player being dropped on the ground after it dies.
+	// The items and the amount of experience dropped may be changed by assigning to *items and *xp. ctx.Cancel()
+	// may be called to prevent anything from being dropped, for example to place the items in a grave chest
+	// instead. The inventories of the player are cleared either way. src is nil if the items are dropped because
+	// they were added to the inventory of the player after it died.
+	HandleDeathDrops(ctx *event.Context, src world.DamageSource, items *[]item.Stack, xp *int)
 	// HandleRespawn handles the respawning of the player in the world. The spawn position passed may be
 	// changed by assigning to *pos. The world.World in which the Player is respawned may be modifying by assigning to
 	// *w. This world may be the world the Player died in, but it might also point to a different world (the overworld)
@@ -55,6 +85,13 @@ type Handler interface {
 	// HandleSkinChange handles the player changing their skin. ctx.Cancel() may be called to cancel the skin
 	// change.
 	HandleSkinChange(ctx *event.Context, skin *skin.Skin)
+	// HandleInputModeChange handles the player switching to a different device.InputMode, for example because it
+	// connected a controller. The input mode the player used before and the one it uses after the change are
+	// passed.
+	HandleInputModeChange(before, after device.InputMode)
+	// HandleClientSettings handles the player changing the settings of its client, such as its render distance.
+	// The settings of the client before and after the change are passed.
+	HandleClientSettings(before, after device.Settings)
 	// HandleStartBreak handles the player starting to break a block at the position passed. ctx.Cancel() may
 	// be called to stop the player from breaking the block completely.
 	HandleStartBreak(ctx *event.Context, pos cube.Pos)
@@ -102,14 +139,27 @@ type Handler interface {
 	// the gain.
 	// The amount is also provided which can be modified.
 	HandleExperienceGain(ctx *event.Context, amount *int)
+	// HandleExperienceSpend handles the player spending experience levels, for example to enchant an item in an
+	// enchanting table or to repair an item in an anvil. ctx.Cancel() may be called to cancel the action that
+	// the levels are spent on. The amount of levels may be modified, for example to discount the action.
+	HandleExperienceSpend(ctx *event.Context, levels *int, src world.LevelCostSource)
 	// HandlePunchAir handles the player punching air.
 	HandlePunchAir(ctx *event.Context)
-	// HandleSignEdit handles the player editing a sign. It is called for every keystroke while editing a sign and
-	// has both the old text passed and the text after the edit. This typically only has a change of one character.
-	HandleSignEdit(ctx *event.Context, frontSide bool, oldText, newText string)
+	// HandleSignEdit handles the player editing a sign at the position passed. It is called for every keystroke while
+	// editing a sign and has both the old text passed and the text after the edit. This typically only has a change of
+	// one character. ctx.Cancel() may be called to cancel the edit.
+	// The text after the edit may be changed by assigning to *newText, for example to filter it.
+	HandleSignEdit(ctx *event.Context, pos cube.Pos, frontSide bool, oldText string, newText *string)
 	// HandleLecternPageTurn handles the player turning a page in a lectern. ctx.Cancel() may be called to cancel the
 	// page turn. The page number may be changed by assigning to *page.
 	HandleLecternPageTurn(ctx *event.Context, pos cube.Pos, oldPage int, newPage *int)
+	// HandleContainerOpen handles the player opening a block container, such as a chest or a furnace, at the
+	// position passed. ctx.Cancel() may be called to prevent the player from opening the container.
+	HandleContainerOpen(ctx *event.Context, pos cube.Pos, container world.Block)
+	// HandleContainerClose handles the player closing the block container it had opened at the position
+	// passed. It is called both when the player closes the container itself and when the container is closed
+	// by the server, for example because the player opened another container.
+	HandleContainerClose(pos cube.Pos, container world.Block)
 	// HandleItemDamage handles the event wherein the item either held by the player or as armour takes
 	// damage through usage.
 	// The type of the item may be checked to determine whether it was armour or a tool used. The damage to
@@ -128,6 +178,14 @@ type Handler interface {
 	// HandleCommandExecution handles the command execution of a player, who wrote a command in the chat.
 	// ctx.Cancel() may be called to cancel the command execution.
 	HandleCommandExecution(ctx *event.Context, command cmd.Command, args []string)
+	// HandleJoinMessage handles the join message broadcast when the player joins the server. The message may
+	// be changed by assigning to *message. ctx.Cancel() may be called to prevent the message from being
+	// broadcast.
+	HandleJoinMessage(ctx *event.Context, message *string)
+	// HandleQuitMessage handles the quit message broadcast when the player leaves the server. The message may
+	// be changed by assigning to *message. ctx.Cancel() may be called to prevent the message from being
+	// broadcast.
+	HandleQuitMessage(ctx *event.Context, message *string)
 	// HandleQuit handles the closing of a player. It is always called when the player is disconnected,
 	// regardless of the reason.
 	HandleQuit()
@@ -148,16 +206,22 @@ func (NopHandler) HandleTeleport(*event.Context, mgl64.Vec3)
 func (NopHandler) HandleChangeWorld(*world.World, *world.World)                               {}
 func (NopHandler) HandleToggleSprint(*event.Context, bool)                                    {}
 func (NopHandler) HandleToggleSneak(*event.Context, bool)                                     {}
+func (NopHandler) HandleSleep(*event.Context, cube.Pos)                                       {}
+func (NopHandler) HandleToggleFlight(*event.Context, bool)                                    {}
 func (NopHandler) HandleCommandExecution(*event.Context, cmd.Command, []string)               {}
 func (NopHandler) HandleTransfer(*event.Context, *net.UDPAddr)                                {}
 func (NopHandler) HandleChat(*event.Context, *string)                                         {}
 func (NopHandler) HandleSkinChange(*event.Context, *skin.Skin)                                {}
+func (NopHandler) HandleInputModeChange(device.InputMode, device.InputMode)                   {}
+func (NopHandler) HandleClientSettings(device.Settings, device.Settings)                      {}
 func (NopHandler) HandleStartBreak(*event.Context, cube.Pos)                                  {}
 func (NopHandler) HandleBlockBreak(*event.Context, cube.Pos, *[]item.Stack, *int)             {}
 func (NopHandler) HandleBlockPlace(*event.Context, cube.Pos, world.Block)                     {}
 func (NopHandler) HandleBlockPick(*event.Context, cube.Pos, world.Block)                      {}
-func (NopHandler) HandleSignEdit(*event.Context, bool, string, string)                        {}
+func (NopHandler) HandleSignEdit(*event.Context, cube.Pos, bool, string, *string)             {}
 func (NopHandler) HandleLecternPageTurn(*event.Context, cube.Pos, int, *int)                  {}
+func (NopHandler) HandleContainerOpen(*event.Context, cube.Pos, world.Block)                  {}
+func (NopHandler) HandleContainerClose(cube.Pos, world.Block)                                 {}
 func (NopHandler) HandleItemPickup(*event.Context, *item.Stack)                               {}
 func (NopHandler) HandleItemUse(*event.Context)                                               {}
 func (NopHandler) HandleItemUseOnBlock(*event.Context, cube.Pos, cube.Face, mgl64.Vec3)       {}
@@ -166,10 +230,20 @@ func (NopHandler) HandleItemConsume(*event.Context, item.Stack)
 func (NopHandler) HandleItemDamage(*event.Context, item.Stack, int)                           {}
 func (NopHandler) HandleAttackEntity(*event.Context, world.Entity, *float64, *float64, *bool) {}
 func (NopHandler) HandleExperienceGain(*event.Context, *int)                                  {}
+func (NopHandler) HandleExperienceSpend(*event.Context, *int, world.LevelCostSource)          {}
 func (NopHandler) HandlePunchAir(*event.Context)                                              {}
 func (NopHandler) HandleHurt(*event.Context, *float64, *time.Duration, world.DamageSource)    {}
 func (NopHandler) HandleHeal(*event.Context, *float64, world.HealingSource)                   {}
-func (NopHandler) HandleFoodLoss(*event.Context, int, *int)                                   {}
+func (NopHandler) HandleExhaust(*event.Context, *float64, world.ExhaustionSource)             {}
+func (NopHandler) HandleFoodLoss(*event.Context, int, *int, world.ExhaustionSource)           {}
+func (NopHandler) HandleFoodGain(*event.Context, *int, *float64, FoodSource)                  {}
+func (NopHandler) HandleDeathDrops(*event.Context, world.DamageSource, *[]item.Stack, *int)   {}
 func (NopHandler) HandleDeath(world.DamageSource, *bool)                                      {}
 func (NopHandler) HandleRespawn(*mgl64.Vec3, **world.World)                                   {}
+func (NopHandler) HandleJoinMessage(*event.Context, *string)                                  {}
+func (NopHandler) HandleQuitMessage(*event.Context, *string)                                  {}
 func (NopHandler) HandleQuit()                                                                {}
+
+// HandleDeathMessage ...
+func (NopHandler) HandleDeathMessage(*event.Context, world.DamageSource, world.Entity, *chat.Translation) {
+}
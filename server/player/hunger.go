@@ -158,3 +158,57 @@ type StarvationDamageSource struct{}
 func (StarvationDamageSource) ReducedByArmour() bool     { return false }
 func (StarvationDamageSource) ReducedByResistance() bool { return false }
 func (StarvationDamageSource) Fire() bool                { return false }
+
+type (
+	// SprintExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by sprinting.
+	SprintExhaustionSource struct{}
+	// SwimExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by swimming.
+	SwimExhaustionSource struct{}
+	// JumpExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by jumping.
+	JumpExhaustionSource struct {
+		// Sprinting specifies if the player was sprinting while jumping.
+		Sprinting bool
+	}
+	// AttackExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by attacking an
+	// entity.
+	AttackExhaustionSource struct{}
+	// DamageExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by taking damage
+	// that is reduced by armour.
+	DamageExhaustionSource struct{}
+	// BlockBreakExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by breaking a
+	// block.
+	BlockBreakExhaustionSource struct{}
+	// RegenerationExhaustionSource is the world.ExhaustionSource passed when a player is exhausted by
+	// regenerating health from a full food bar.
+	RegenerationExhaustionSource struct{}
+	// CustomExhaustionSource is a world.ExhaustionSource that may be passed when exhausting a player for
+	// reasons not covered by any of the other sources.
+	CustomExhaustionSource struct{}
+)
+
+func (SprintExhaustionSource) ExhaustionSource()       {}
+func (SwimExhaustionSource) ExhaustionSource()         {}
+func (JumpExhaustionSource) ExhaustionSource()         {}
+func (AttackExhaustionSource) ExhaustionSource()       {}
+func (DamageExhaustionSource) ExhaustionSource()       {}
+func (BlockBreakExhaustionSource) ExhaustionSource()   {}
+func (RegenerationExhaustionSource) ExhaustionSource() {}
+func (CustomExhaustionSource) ExhaustionSource()       {}
+
+// FoodSource represents a source of food and saturation points gained by a player. It is passed to
+// Handler.HandleFoodGain.
+type FoodSource interface {
+	FoodSource()
+}
+
+type (
+	// ConsumptionFoodSource is the FoodSource passed when a player gains food and saturation through
+	// Player.Saturate, for example by eating food or from the Saturation effect.
+	ConsumptionFoodSource struct{}
+	// PeacefulFoodSource is the FoodSource passed when the food bar of a player regenerates naturally because
+	// the world is in a difficulty in which food regenerates.
+	PeacefulFoodSource struct{}
+)
+
+func (ConsumptionFoodSource) FoodSource() {}
+func (PeacefulFoodSource) FoodSource()    {}
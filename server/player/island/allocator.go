@@ -0,0 +1,254 @@
+package island
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/mathutil"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Config holds the configuration of an Allocator. An Allocator may be created by calling Config.New.
+type Config struct {
+	// World is the world that islands are allocated in.
+	World *world.World
+	// Size is the length in blocks of the sides of every island. If set to 0, islands are 128x128 blocks.
+	Size int
+	// Gap is the amount of blocks between two neighbouring islands that is not part of any island, so that
+	// islands are isolated from each other.
+	Gap int
+	// Anchor is the position relative to the centre of an island that players are teleported to. The Y value
+	// is absolute. If left empty, players are teleported to Y 64 at the centre of the island.
+	Anchor mgl64.Vec3
+	// File is the path of the JSON file that the islands allocated are stored in, so that players keep their
+	// island after a restart. If left empty, islands are not persisted.
+	File string
+}
+
+// New creates an Allocator using the fields of the Config. If the File of the Config exists, the islands
+// stored in it are loaded. An error is returned if the file could not be read or decoded.
+func (conf Config) New() (*Allocator, error) {
+	if conf.World == nil {
+		return nil, errors.New("new island allocator: world must not be nil")
+	}
+	if conf.Size <= 0 {
+		conf.Size = 128
+	}
+	if conf.Anchor == (mgl64.Vec3{}) {
+		conf.Anchor = mgl64.Vec3{0, 64}
+	}
+	a := &Allocator{conf: conf, owners: make(map[uuid.UUID]int), taken: make(map[int]uuid.UUID)}
+	if conf.File == "" {
+		return a, nil
+	}
+	data, err := os.ReadFile(conf.File)
+	if errors.Is(err, os.ErrNotExist) {
+		return a, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read islands: %w", err)
+	}
+	var owners map[uuid.UUID]int
+	if err := json.Unmarshal(data, &owners); err != nil {
+		return nil, fmt.Errorf("decode islands: %w", err)
+	}
+	for owner, i := range owners {
+		if _, ok := a.taken[i]; ok || i < 0 {
+			return nil, fmt.Errorf("decode islands: invalid index %v for %v", i, owner)
+		}
+		a.owners[owner], a.taken[i] = i, owner
+	}
+	return a, nil
+}
+
+// Allocator allocates islands in a world to players. Every player may own at most one island. Players are
+// identified by their UUID, so that they keep their island when changing their name.
+// Allocator is safe for concurrent usage.
+type Allocator struct {
+	conf Config
+
+	mu sync.Mutex
+	// owners maps the UUIDs of owners to the index of their island and taken maps the indices of all
+	// allocated islands to the UUIDs of their owners.
+	owners map[uuid.UUID]int
+	taken  map[int]uuid.UUID
+}
+
+// World returns the world that the Allocator allocates islands in.
+func (a *Allocator) World() *world.World {
+	return a.conf.World
+}
+
+// Allocate allocates an island to the player with the UUID passed. If the player already owns an island, that
+// island is returned. Otherwise, the free island closest to the origin of the world is allocated. The blocks
+// of the island are not changed: Islands should be built, for example by pasting a structure, once allocated.
+// An error is returned if the islands could not be saved, in which case the island is not allocated.
+func (a *Allocator) Allocate(owner uuid.UUID) (Island, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if i, ok := a.owners[owner]; ok {
+		return a.island(i), nil
+	}
+	i := 0
+	for {
+		if _, ok := a.taken[i]; !ok {
+			break
+		}
+		i++
+	}
+	a.owners[owner], a.taken[i] = i, owner
+	if err := a.save(); err != nil {
+		delete(a.owners, owner)
+		delete(a.taken, i)
+		return Island{}, err
+	}
+	return a.island(i), nil
+}
+
+// Release releases the island of the player with the UUID passed, so that it may be allocated to a different
+// player. The blocks of the island are not changed, so they should be cleared before the island is allocated
+// again. Release does nothing if the player does not own an island.
+func (a *Allocator) Release(owner uuid.UUID) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	i, ok := a.owners[owner]
+	if !ok {
+		return nil
+	}
+	delete(a.owners, owner)
+	delete(a.taken, i)
+	return a.save()
+}
+
+// Island returns the island owned by the player with the UUID passed. False is returned if the player does
+// not own an island.
+func (a *Allocator) Island(owner uuid.UUID) (Island, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	i, ok := a.owners[owner]
+	if !ok {
+		return Island{}, false
+	}
+	return a.island(i), true
+}
+
+// Islands returns all islands that are currently allocated.
+func (a *Allocator) Islands() []Island {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	islands := make([]Island, 0, len(a.taken))
+	for i := range a.taken {
+		islands = append(islands, a.island(i))
+	}
+	return islands
+}
+
+// At returns the allocated island that the block position passed is part of. False is returned if the position
+// is not part of an island, for example because it is in the gap between islands, or if the island at the
+// position is not allocated.
+func (a *Allocator) At(pos cube.Pos) (Island, bool) {
+	cell := a.conf.Size + a.conf.Gap
+	// Islands are centred around multiples of the cell size, so the position is offset by half the size of an
+	// island to find the cell it is in.
+	half := a.conf.Size / 2
+	gx, gz := mathutil.FloorDiv(pos[0]+half, cell), mathutil.FloorDiv(pos[2]+half, cell)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := range a.taken {
+		if x, z := gridPos(i); x == gx && z == gz {
+			if is := a.island(i); is.Contains(pos) {
+				return is, true
+			}
+			break
+		}
+	}
+	return Island{}, false
+}
+
+// CanEdit checks if the player with the UUID passed may edit the block at the position passed. This is the case
+// if the position is part of the island owned by the player.
+func (a *Allocator) CanEdit(id uuid.UUID, pos cube.Pos) bool {
+	is, ok := a.At(pos)
+	return ok && is.Owner == id
+}
+
+// Teleport teleports the player passed to the anchor of the Island passed. If the player is in a different world
+// than the World of the Allocator, it is first added to it.
+func (a *Allocator) Teleport(p *player.Player, is Island) {
+	if p.World() != a.conf.World {
+		a.conf.World.AddEntity(p)
+	}
+	p.Teleport(is.Anchor)
+}
+
+// island returns the Island at the index passed. island must be called while holding a.mu.
+func (a *Allocator) island(i int) Island {
+	x, z := gridPos(i)
+	cell, half := a.conf.Size+a.conf.Gap, a.conf.Size/2
+	r := a.conf.World.Range()
+
+	min := cube.Pos{x*cell - half, r.Min(), z*cell - half}
+	return Island{
+		Index:  i,
+		Owner:  a.taken[i],
+		Min:    min,
+		Max:    cube.Pos{min[0] + a.conf.Size - 1, r.Max(), min[2] + a.conf.Size - 1},
+		Anchor: mgl64.Vec3{float64(x*cell) + a.conf.Anchor[0] + 0.5, a.conf.Anchor[1], float64(z*cell) + a.conf.Anchor[2] + 0.5},
+	}
+}
+
+// save writes the islands allocated to the File of the Allocator, if set. save must be called while holding
+// a.mu.
+func (a *Allocator) save() error {
+	if a.conf.File == "" {
+		return nil
+	}
+	owners := make(map[uuid.UUID]int, len(a.taken))
+	for i, owner := range a.taken {
+		owners[owner] = i
+	}
+	data, err := json.MarshalIndent(owners, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode islands: %w", err)
+	}
+	if err := writeFileAtomic(a.conf.File, data); err != nil {
+		return fmt.Errorf("write islands: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to the file with the name passed by writing it to a temporary file in the same
+// directory first and renaming it to the file once it is synced to disk. The file therefore either holds its old
+// or its new contents, even if writing is interrupted.
+func writeFileAtomic(name string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp, name)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+	}
+	return err
+}
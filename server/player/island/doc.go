@@ -0,0 +1,17 @@
+// Package island allocates islands for players in a shared world, as used by skyblock and plot servers. The
+// world is divided into a grid of square islands separated by a gap. Islands are handed out in a spiral
+// around the origin, so that allocated islands stay close together, and are reused once released.
+//
+// Every Island has an anchor that players are teleported to and may be used to isolate players from each
+// other, for example by cancelling block edits outside the island of a player:
+//
+//	a, err := island.Config{World: w, Size: 128, Gap: 64, File: "islands.json"}.New()
+//	is, err := a.Allocate(p.UUID())
+//	a.Teleport(p, is)
+//
+//	func (h handler) HandleBlockBreak(ctx *event.Context, pos cube.Pos, drops *[]item.Stack, xp *int) {
+//		if !h.islands.CanEdit(h.p.UUID(), pos) {
+//			ctx.Cancel()
+//		}
+//	}
+package island
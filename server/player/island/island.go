@@ -0,0 +1,63 @@
+package island
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"math"
+)
+
+// Island is an area of a world allocated to a player by an Allocator. Island is a value type: It does not
+// change when the Allocator allocates or releases islands.
+type Island struct {
+	// Index is the index of the Island in the grid of the Allocator. Islands with a lower index are closer to
+	// the origin of the world.
+	Index int
+	// Owner is the UUID of the player that the Island is allocated to.
+	Owner uuid.UUID
+	// Min and Max are the corners of the Island. All blocks with X and Z coordinates between Min and Max,
+	// inclusively, are part of the Island, regardless of their Y coordinate.
+	Min, Max cube.Pos
+	// Anchor is the position that players are teleported to when teleported to the Island.
+	Anchor mgl64.Vec3
+}
+
+// Contains checks if the block position passed is part of the Island. The Y coordinate is not taken into
+// account.
+func (is Island) Contains(pos cube.Pos) bool {
+	return pos[0] >= is.Min[0] && pos[0] <= is.Max[0] && pos[2] >= is.Min[2] && pos[2] <= is.Max[2]
+}
+
+// Box returns a cube.BBox spanning the Island from its Min to its Max corner, including every block in those
+// columns. It may be used to find the entities on the Island, or to track players entering and leaving it
+// using a region.Config.
+func (is Island) Box() cube.BBox {
+	return cube.Box(float64(is.Min[0]), float64(is.Min[1]), float64(is.Min[2]), float64(is.Max[0]+1), float64(is.Max[1]+1), float64(is.Max[2]+1))
+}
+
+// gridPos returns the position in the grid of islands of the island with the index passed. Index 0 is at the
+// origin and the following indices spiral outwards around it, so that every index maps to a unique position.
+func gridPos(i int) (x, z int) {
+	if i == 0 {
+		return 0, 0
+	}
+	// n is the 1-based index of the island. Ring k holds all islands at a distance of k from the origin,
+	// ending with the island at index (2k+1)^2.
+	n := i + 1
+	k := int(math.Ceil((math.Sqrt(float64(n)) - 1) / 2))
+	t := 2*k + 1
+	m := t * t
+	t--
+	if n >= m-t {
+		return k - (m - n), -k
+	}
+	m -= t
+	if n >= m-t {
+		return -k, -k + (m - n)
+	}
+	m -= t
+	if n >= m-t {
+		return -k + (m - n), k
+	}
+	return k, k - (m - n - t)
+}
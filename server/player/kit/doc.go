@@ -0,0 +1,13 @@
+// Package kit implements kits: Named sets of items, such as starting loadouts, that may be given to players.
+// Kits may be given with a cooldown and a permission check, and are either given completely or not at all.
+//
+// Kits may be defined in JSON, using the item format of the itemcodec package, and loaded using Parse:
+//
+//	{
+//	  "name": "starter",
+//	  "cooldown": "10m",
+//	  "drop_overflow": true,
+//	  "items": [{"name": "minecraft:stone_sword"}, {"name": "minecraft:bread", "count": 16}],
+//	  "armour": {"helmet": {"name": "minecraft:leather_helmet"}}
+//	}
+package kit
@@ -0,0 +1,210 @@
+package kit
+
+import (
+	"errors"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNoPermission is returned by Kit.Give if the Holder passed is not permitted to receive the kit.
+	ErrNoPermission = errors.New("not permitted to receive kit")
+	// ErrInventoryFull is returned by Kit.Give if not all items of the kit fit in the inventory of the Holder
+	// and the kit does not drop overflowing items.
+	ErrInventoryFull = errors.New("not enough space in inventory to receive kit")
+)
+
+// CooldownError is returned by Kit.Give if the Holder passed received the kit too recently.
+type CooldownError struct {
+	// Remaining is the time remaining until the Holder may receive the kit again.
+	Remaining time.Duration
+}
+
+// Error ...
+func (err CooldownError) Error() string {
+	return fmt.Sprintf("kit is on cooldown for %v", err.Remaining.Round(time.Second))
+}
+
+// Holder represents an entity that may receive a kit, such as a *player.Player.
+type Holder interface {
+	// UUID returns the UUID of the Holder, which is used to keep track of the cooldown of kits.
+	UUID() uuid.UUID
+	// Inventory returns the inventory that items of the kit are added to.
+	Inventory() *inventory.Inventory
+	// Armour returns the armour inventory that armour of the kit is put in.
+	Armour() *inventory.Armour
+	// Position returns the position that items that do not fit in the inventory are dropped at.
+	Position() mgl64.Vec3
+	// World returns the world that items that do not fit in the inventory are dropped in.
+	World() *world.World
+}
+
+// Config holds the configuration of a Kit. A Kit may be created by calling Config.New.
+type Config struct {
+	// Name is the name of the kit.
+	Name string
+	// Items holds the items that are added to the inventory of a Holder receiving the kit.
+	Items []item.Stack
+	// Armour holds the helmet, chestplate, leggings and boots respectively that are put in the armour
+	// inventory of a Holder receiving the kit. Empty stacks are ignored. If the slot of an armour piece is
+	// already occupied, the piece is added to the inventory instead.
+	Armour [4]item.Stack
+	// Cooldown is the minimum duration between two times a Holder receives the kit. If 0, the kit may be
+	// received at any time.
+	Cooldown time.Duration
+	// Permission is called to check if a Holder may receive the kit. If nil, all Holders may receive it.
+	Permission func(h Holder) bool
+	// DropOverflow specifies if items that do not fit in the inventory of a Holder are dropped at its
+	// position. If false, the kit is refused instead if not all items fit.
+	DropOverflow bool
+}
+
+// New creates a Kit using the fields of the Config.
+func (conf Config) New() *Kit {
+	return &Kit{conf: conf, given: make(map[uuid.UUID]time.Time)}
+}
+
+// Kit is a named set of items that may be given to players, for example as a starting loadout. Kits may be
+// created using Config.New or loaded from JSON using Parse.
+type Kit struct {
+	conf Config
+
+	mu    sync.Mutex
+	given map[uuid.UUID]time.Time
+}
+
+// Name returns the name of the Kit.
+func (k *Kit) Name() string {
+	return k.conf.Name
+}
+
+// Items returns the items added to the inventory of a Holder receiving the Kit.
+func (k *Kit) Items() []item.Stack {
+	return append([]item.Stack(nil), k.conf.Items...)
+}
+
+// Armour returns the helmet, chestplate, leggings and boots given to a Holder receiving the Kit.
+func (k *Kit) Armour() [4]item.Stack {
+	return k.conf.Armour
+}
+
+// Allowed checks if the Holder passed is permitted to receive the Kit.
+func (k *Kit) Allowed(h Holder) bool {
+	return k.conf.Permission == nil || k.conf.Permission(h)
+}
+
+// Cooldown returns the time remaining until the Holder passed may receive the Kit again. If the Holder may
+// receive it immediately, 0 is returned.
+func (k *Kit) Cooldown(h Holder) time.Duration {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.cooldown(h)
+}
+
+// ResetCooldown resets the cooldown of the Kit for the Holder passed, so that it may receive the Kit again
+// immediately.
+func (k *Kit) ResetCooldown(h Holder) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	delete(k.given, h.UUID())
+}
+
+// Give gives the Kit to the Holder passed. The Kit is either given completely or not at all: If an error is
+// returned, the inventory of the Holder is left unchanged. ErrNoPermission is returned if the Holder is not
+// permitted to receive the Kit and a CooldownError is returned if the Holder received the Kit too recently.
+// If not all items fit in the inventory of the Holder, they are dropped if the Kit drops overflowing items,
+// or ErrInventoryFull is returned otherwise.
+func (k *Kit) Give(h Holder) error {
+	if !k.Allowed(h) {
+		return ErrNoPermission
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if remaining := k.cooldown(h); remaining > 0 {
+		return CooldownError{Remaining: remaining}
+	}
+
+	armour, items := h.Armour(), k.Items()
+	current := armour.Slots()
+	pieces := k.conf.Armour
+	for i, piece := range pieces {
+		if !piece.Empty() && !current[i].Empty() {
+			// The slot is already occupied, so try adding the piece to the inventory instead.
+			items, pieces[i] = append(items, piece), item.Stack{}
+		}
+	}
+
+	inv := h.Inventory()
+	if !k.conf.DropOverflow && len(overflow(inv, items)) != 0 {
+		return ErrInventoryFull
+	}
+	for i, piece := range pieces {
+		if !piece.Empty() {
+			_ = armour.Inventory().SetItem(i, piece)
+		}
+	}
+	var leftover []item.Stack
+	for _, it := range items {
+		if n, err := inv.AddItem(it); err != nil {
+			leftover = append(leftover, it.Grow(-n))
+		}
+	}
+	drop(h, leftover)
+
+	if k.conf.Cooldown > 0 {
+		k.given[h.UUID()] = time.Now()
+	}
+	return nil
+}
+
+// cooldown returns the time remaining until the Holder passed may receive the Kit again. cooldown must only
+// be called while holding k.mu.
+func (k *Kit) cooldown(h Holder) time.Duration {
+	t, ok := k.given[h.UUID()]
+	if !ok {
+		return 0
+	}
+	remaining := k.conf.Cooldown - time.Since(t)
+	if remaining <= 0 {
+		delete(k.given, h.UUID())
+		return 0
+	}
+	return remaining
+}
+
+// overflow returns the items out of the items passed that would not fit in the inventory passed. The
+// inventory itself is not changed.
+func overflow(inv *inventory.Inventory, items []item.Stack) []item.Stack {
+	sim := inventory.New(inv.Size(), nil)
+	for slot, it := range inv.Slots() {
+		_ = sim.SetItem(slot, it)
+	}
+	var leftover []item.Stack
+	for _, it := range items {
+		if n, err := sim.AddItem(it); err != nil {
+			leftover = append(leftover, it.Grow(-n))
+		}
+	}
+	return leftover
+}
+
+// drop drops the items passed at the position of the Holder.
+func drop(h Holder, items []item.Stack) {
+	w, pos := h.World(), h.Position()
+	if w == nil {
+		return
+	}
+	for _, it := range items {
+		ent := entity.NewItem(it, pos)
+		ent.SetVelocity(mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1})
+		w.AddEntityUncapped(ent)
+	}
+}
@@ -0,0 +1,79 @@
+package kit
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/itemcodec"
+	"time"
+)
+
+// definition is the JSON representation of a Config.
+type definition struct {
+	Name         string            `json:"name"`
+	Items        []itemcodec.Stack `json:"items"`
+	Armour       armourDefinition  `json:"armour"`
+	Cooldown     string            `json:"cooldown"`
+	DropOverflow bool              `json:"drop_overflow"`
+}
+
+// armourDefinition is the JSON representation of the armour of a Config.
+type armourDefinition struct {
+	Helmet     *itemcodec.Stack `json:"helmet"`
+	Chestplate *itemcodec.Stack `json:"chestplate"`
+	Leggings   *itemcodec.Stack `json:"leggings"`
+	Boots      *itemcodec.Stack `json:"boots"`
+}
+
+// Parse parses a Config from the JSON passed. The cooldown of the kit is parsed using time.ParseDuration. The
+// Permission field of the Config returned is nil and may be set before calling Config.New.
+func Parse(b []byte) (Config, error) {
+	var def definition
+	if err := json.Unmarshal(b, &def); err != nil {
+		return Config{}, fmt.Errorf("decode kit: %w", err)
+	}
+	conf := Config{Name: def.Name, DropOverflow: def.DropOverflow}
+	if def.Cooldown != "" {
+		cooldown, err := time.ParseDuration(def.Cooldown)
+		if err != nil {
+			return Config{}, fmt.Errorf("decode kit %v: parse cooldown: %w", def.Name, err)
+		}
+		conf.Cooldown = cooldown
+	}
+	for i, data := range def.Items {
+		s, err := data.ToStack()
+		if err != nil {
+			return Config{}, fmt.Errorf("decode kit %v: item %v: %w", def.Name, i, err)
+		}
+		conf.Items = append(conf.Items, s)
+	}
+	for i, data := range []*itemcodec.Stack{def.Armour.Helmet, def.Armour.Chestplate, def.Armour.Leggings, def.Armour.Boots} {
+		if data == nil {
+			continue
+		}
+		s, err := data.ToStack()
+		if err != nil {
+			return Config{}, fmt.Errorf("decode kit %v: armour %v: %w", def.Name, i, err)
+		}
+		if !fitsSlot(s, i) {
+			return Config{}, fmt.Errorf("decode kit %v: armour %v: %v cannot be worn in this slot", def.Name, i, data.Name)
+		}
+		conf.Armour[i] = s
+	}
+	return conf, nil
+}
+
+// fitsSlot checks if the item.Stack passed may be worn in the armour slot passed.
+func fitsSlot(s item.Stack, slot int) bool {
+	switch it := s.Item().(type) {
+	case item.HelmetType:
+		return slot == 0 && it.Helmet()
+	case item.ChestplateType:
+		return slot == 1 && it.Chestplate()
+	case item.LeggingsType:
+		return slot == 2 && it.Leggings()
+	case item.BootsType:
+		return slot == 3 && it.Boots()
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/player/bossbar"
 	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/player/device"
 	"github.com/df-mc/dragonfly/server/player/form"
 	"github.com/df-mc/dragonfly/server/player/scoreboard"
 	"github.com/df-mc/dragonfly/server/player/skin"
@@ -39,19 +41,22 @@ import (
 // Player is an implementation of a player entity. It has methods that implement the behaviour that players
 // need to play in the world.
 type Player struct {
-	name                                string
-	uuid                                uuid.UUID
-	xuid                                string
-	locale                              language.Tag
-	pos, vel                            atomic.Value[mgl64.Vec3]
-	nameTag                             atomic.Value[string]
-	scoreTag                            atomic.Value[string]
-	yaw, pitch, absorptionHealth, scale atomic.Float64
-	once                                sync.Once
+	name              string
+	uuid              uuid.UUID
+	xuid              string
+	locale            language.Tag
+	pos, vel          atomic.Value[mgl64.Vec3]
+	nameTag           atomic.Value[string]
+	scoreTag          atomic.Value[string]
+	yaw, pitch, scale atomic.Float64
+	once              sync.Once
 
 	gameMode atomic.Value[world.GameMode]
 
 	skin atomic.Value[skin.Skin]
+
+	inputMode      atomic.Value[device.InputMode]
+	clientSettings atomic.Value[device.Settings]
 	// s holds the session of the player. This field should not be used directly, but instead,
 	// Player.session() should be called.
 	s atomic.Value[*session.Session]
@@ -64,7 +69,8 @@ type Player struct {
 
 	sneaking, sprinting, swimming, gliding, flying,
 	invisible, immobile, onGround, usingItem atomic.Bool
-	usingSince atomic.Int64
+	hideNameTag atomic.Bool
+	usingSince  atomic.Int64
 
 	glideTicks   atomic.Int64
 	fireTicks    atomic.Int64
@@ -74,18 +80,38 @@ type Player struct {
 	airSupplyTicks    atomic.Int64
 	maxAirSupplyTicks atomic.Int64
 
+	freezing    atomic.Bool
+	freezeTicks atomic.Int64
+
 	cooldownMu sync.Mutex
 	cooldowns  map[string]time.Time
+
+	musicMu    sync.Mutex
+	music      sound.Music
+	musicStart time.Time
+
+	creditsMu sync.Mutex
+	// creditsDone is the function passed to ShowCredits while the player is watching the credits, or nil if it is
+	// not watching them.
+	creditsDone func()
+	seenCredits atomic.Bool
+
+	fogMu sync.Mutex
+	fog   []string
 	// lastTickedWorld holds the world that the player was in, in the last tick.
 	lastTickedWorld *world.World
 
-	speed      atomic.Float64
-	health     *entity.HealthManager
-	experience *entity.ExperienceManager
-	effects    *entity.EffectManager
+	speed                 atomic.Float64
+	horizontalFlightSpeed atomic.Float64
+	verticalFlightSpeed   atomic.Float64
+	mayFly                atomic.Bool
+	health                *entity.HealthManager
+	experience            *entity.ExperienceManager
+	effects               *entity.EffectManager
 
 	lastXPPickup  atomic.Value[time.Time]
 	immunityTicks atomic.Int64
+	attackers     attackTracker
 
 	deathMu        sync.Mutex
 	deathPos       *mgl64.Vec3
@@ -100,9 +126,15 @@ type Player struct {
 	breaking          atomic.Bool
 	breakingPos       atomic.Value[cube.Pos]
 	lastBreakDuration time.Duration
+	// breakProgress is the fraction of the block at breakingPos that was broken up to lastBreakUpdate.
+	breakProgress   float64
+	lastBreakUpdate time.Time
 
 	breakParticleCounter atomic.Uint32
 
+	sleeping atomic.Bool
+	sleepPos atomic.Value[cube.Pos]
+
 	hunger *hungerManager
 }
 
@@ -117,30 +149,31 @@ func New(name string, skin skin.Skin, pos mgl64.Vec3) *Player {
 				p.broadcastItems(slot, before, after)
 			}
 		}),
-		enderChest:        inventory.New(27, nil),
-		uuid:              uuid.New(),
-		offHand:           inventory.New(1, p.broadcastItems),
-		armour:            inventory.NewArmour(p.broadcastArmour),
-		hunger:            newHungerManager(),
-		health:            entity.NewHealthManager(20, 20),
-		experience:        entity.NewExperienceManager(),
-		effects:           entity.NewEffectManager(),
-		gameMode:          *atomic.NewValue[world.GameMode](world.GameModeSurvival),
-		h:                 *atomic.NewValue[Handler](NopHandler{}),
-		name:              name,
-		skin:              *atomic.NewValue(skin),
-		speed:             *atomic.NewFloat64(0.1),
-		nameTag:           *atomic.NewValue(name),
-		heldSlot:          atomic.NewUint32(0),
-		locale:            language.BritishEnglish,
-		breathing:         true,
-		airSupplyTicks:    *atomic.NewInt64(300),
-		maxAirSupplyTicks: *atomic.NewInt64(300),
-		enchantSeed:       *atomic.NewInt64(rand.Int63()),
-		scale:             *atomic.NewFloat64(1),
-		pos:               *atomic.NewValue(pos),
-		cooldowns:         make(map[string]time.Time),
-		mc:                &entity.MovementComputer{Gravity: 0.08, Drag: 0.02, DragBeforeGravity: true},
+		enderChest:            inventory.New(27, nil),
+		uuid:                  uuid.New(),
+		offHand:               inventory.New(1, p.broadcastItems),
+		armour:                inventory.NewArmour(p.broadcastArmour),
+		hunger:                newHungerManager(),
+		health:                entity.NewHealthManager(20, 20),
+		experience:            entity.NewExperienceManager(),
+		effects:               entity.NewEffectManager(),
+		gameMode:              *atomic.NewValue[world.GameMode](world.GameModeSurvival),
+		h:                     *atomic.NewValue[Handler](NopHandler{}),
+		name:                  name,
+		skin:                  *atomic.NewValue(skin),
+		speed:                 *atomic.NewFloat64(0.1),
+		horizontalFlightSpeed: *atomic.NewFloat64(0.05),
+		nameTag:               *atomic.NewValue(name),
+		heldSlot:              atomic.NewUint32(0),
+		locale:                language.BritishEnglish,
+		breathing:             true,
+		airSupplyTicks:        *atomic.NewInt64(300),
+		maxAirSupplyTicks:     *atomic.NewInt64(300),
+		enchantSeed:           *atomic.NewInt64(rand.Int63()),
+		scale:                 *atomic.NewFloat64(1),
+		pos:                   *atomic.NewValue(pos),
+		cooldowns:             make(map[string]time.Time),
+		mc:                    &entity.MovementComputer{Gravity: 0.08, Drag: 0.02, DragBeforeGravity: true},
 	}
 	return p
 }
@@ -155,12 +188,29 @@ func NewWithSession(name, xuid string, uuid uuid.UUID, skin skin.Skin, s *sessio
 	p.s, p.uuid, p.xuid, p.skin = *atomic.NewValue(s), uuid, xuid, *atomic.NewValue(skin)
 	p.inv, p.offHand, p.enderChest, p.armour, p.heldSlot = s.HandleInventories()
 	p.locale, _ = language.Parse(strings.Replace(s.ClientData().LanguageCode, "_", "-", 1))
+	mode, _ := device.InputModeByID(s.ClientData().CurrentInputMode)
+	p.inputMode.Store(mode)
+	p.clientSettings.Store(device.Settings{RenderDistance: s.ChunkRadius()})
 	if data != nil {
 		p.load(*data)
 	}
 	return p
 }
 
+// Resume gives control of the Player to the session.Session passed. It is used to give a Player that was
+// detached from its previous session, after losing its connection, to a new connection of the same client, so
+// that the Player and all of its state are kept. The session passed should be spawned using
+// session.Session.Spawn after calling Resume.
+func (p *Player) Resume(s *session.Session) {
+	p.s.Store(s)
+	s.ResumeInventories(p.inv, p.offHand, p.enderChest, p.armour, p.heldSlot)
+
+	s.SendHealth(p.health)
+	s.SendAbsorption(p.Absorption())
+	s.SendExperience(p.experience)
+	p.sendFood()
+}
+
 // Type returns the world.EntityType for the Player.
 func (p *Player) Type() world.EntityType {
 	return Type{}
@@ -208,6 +258,26 @@ func (p *Player) DeviceModel() string {
 	return p.session().ClientData().DeviceModel
 }
 
+// DeviceOS returns the device.OS of the device that the player plays on. If the Player is not connected to a network
+// session, the zero value of device.OS is returned. Otherwise, the OS the network session sent in the ClientData is
+// returned.
+func (p *Player) DeviceOS() device.OS {
+	if p.session() == session.Nop {
+		return device.OS{}
+	}
+	os, _ := device.OSByID(int(p.session().ClientData().DeviceOS))
+	return os
+}
+
+// GameVersion returns the version of the game that the player plays on, such as '1.20.50'. If the Player is not
+// connected to a network session, an empty string is returned.
+func (p *Player) GameVersion() string {
+	if p.session() == session.Nop {
+		return ""
+	}
+	return p.session().ClientData().GameVersion
+}
+
 // SelfSignedID returns the self-signed ID of the player. If the Player is not connected to a network session, an empty
 // string is returned. Otherwise, the self-signed ID the network session sent in the ClientData is returned.
 func (p *Player) SelfSignedID() string {
@@ -217,6 +287,47 @@ func (p *Player) SelfSignedID() string {
 	return p.session().ClientData().SelfSignedID
 }
 
+// InputMode returns the device.InputMode that the Player currently uses to play, such as a keyboard and mouse or a
+// touch screen. The input mode may change at any time, in which case Handler.HandleInputModeChange is called. If
+// the Player is not connected to a network session, the zero value of device.InputMode is returned.
+func (p *Player) InputMode() device.InputMode {
+	return p.inputMode.Load()
+}
+
+// UpdateInputMode updates the device.InputMode of the Player. It is called by the network session of the Player
+// when the client switches to a different input mode and calls Handler.HandleInputModeChange if the input mode
+// changed.
+func (p *Player) UpdateInputMode(mode device.InputMode) {
+	if before := p.inputMode.Swap(mode); before != mode {
+		p.Handler().HandleInputModeChange(before, mode)
+	}
+}
+
+// ClientSettings returns the device.Settings of the client of the Player, such as its render distance. If the
+// Player is not connected to a network session, the zero value of device.Settings is returned.
+func (p *Player) ClientSettings() device.Settings {
+	return p.clientSettings.Load()
+}
+
+// UpdateClientSettings updates the device.Settings of the Player. It is called by the network session of the Player
+// when the client changes its settings and calls Handler.HandleClientSettings if the settings changed.
+func (p *Player) UpdateClientSettings(settings device.Settings) {
+	if before := p.clientSettings.Swap(settings); before != settings {
+		p.Handler().HandleClientSettings(before, settings)
+	}
+}
+
+// UIProfile returns the device.UIProfile of the user interface of the Player. Players using device.PocketUI() have a
+// user interface with bigger buttons, which forms and menus may take into account. If the Player is not connected
+// to a network session, device.ClassicUI() is returned.
+func (p *Player) UIProfile() device.UIProfile {
+	if p.session() == session.Nop {
+		return device.ClassicUI()
+	}
+	profile, _ := device.UIProfileByID(p.session().ClientData().UIProfile)
+	return profile
+}
+
 // Addr returns the net.Addr of the Player. If the Player is not connected to a network session, nil is returned.
 func (p *Player) Addr() net.Addr {
 	if p.session() == session.Nop {
@@ -270,6 +381,16 @@ func (p *Player) Message(a ...any) {
 	p.session().SendMessage(format(a))
 }
 
+// MessageTranslation sends a chat.Translation to the player. The message is translated by the client of the
+// player. If the key of the translation is empty, the fallback message is sent instead.
+func (p *Player) MessageTranslation(t chat.Translation) {
+	if t.Key == "" {
+		p.session().SendMessage(t.String())
+		return
+	}
+	p.session().SendTranslation(t.Key, t.Params)
+}
+
 // Messagef sends a formatted message using a specific format to the player. The message is formatted
 // according to the fmt.Sprintf formatting rules.
 func (p *Player) Messagef(f string, a ...any) {
@@ -301,6 +422,43 @@ func (p *Player) SendToast(title, message string) {
 	p.session().SendToast(title, message)
 }
 
+// ShowCredits shows the end credits to the player, as is done the first time the player leaves the End through
+// an end portal. f is called once the player finished watching the credits, either by watching them until the end
+// or by skipping them. ShowCredits does nothing if the player is already watching the credits.
+func (p *Player) ShowCredits(f func()) {
+	p.creditsMu.Lock()
+	defer p.creditsMu.Unlock()
+	if p.creditsDone != nil {
+		return
+	}
+	if f == nil {
+		f = func() {}
+	}
+	p.creditsDone = f
+	p.session().SendCredits()
+}
+
+// FinishCredits finishes the end credits currently shown to the player using ShowCredits, as if the player skipped
+// them. The function passed to ShowCredits is called. FinishCredits is called automatically when the client of the
+// player closes the credits, and does nothing if the player is not watching the credits.
+func (p *Player) FinishCredits() {
+	p.creditsMu.Lock()
+	f := p.creditsDone
+	p.creditsDone = nil
+	p.creditsMu.Unlock()
+	if f == nil {
+		return
+	}
+	p.seenCredits.Store(true)
+	f()
+}
+
+// SeenCredits checks if the player finished watching the end credits shown using ShowCredits before. Players
+// only see the credits the first time they leave the End.
+func (p *Player) SeenCredits() bool {
+	return p.seenCredits.Load()
+}
+
 // ResetFallDistance resets the player's fall distance.
 func (p *Player) ResetFallDistance() {
 	p.fallDistance.Store(0)
@@ -373,7 +531,7 @@ func (p *Player) ExecuteCommand(commandLine string) {
 		return
 	}
 	args := strings.Split(commandLine, " ")
-	command, ok := cmd.ByAlias(args[0][1:])
+	command, ok := p.session().Command(args[0][1:])
 	if !ok {
 		o := &cmd.Output{}
 		o.Errorf("Unknown command: %v. Please check that the command exists and that you have permission to use it.", args[0])
@@ -474,6 +632,34 @@ func (p *Player) Speed() float64 {
 	return p.speed.Load()
 }
 
+// SetHorizontalFlightSpeed sets the horizontal flight speed of the player. The value passed is the blocks/tick
+// speed that the player obtains while flying. The client also scales its vertical flight speed with it, unless
+// a vertical flight speed is set using SetVerticalFlightSpeed.
+func (p *Player) SetHorizontalFlightSpeed(speed float64) {
+	p.horizontalFlightSpeed.Store(speed)
+	p.session().SendAbilities()
+}
+
+// HorizontalFlightSpeed returns the horizontal flight speed of the player, returning a value that indicates the
+// blocks/tick speed. The default horizontal flight speed of a player is 0.05.
+func (p *Player) HorizontalFlightSpeed() float64 {
+	return p.horizontalFlightSpeed.Load()
+}
+
+// SetVerticalFlightSpeed sets the maximum vertical flight speed of the player in blocks/tick. The client has no
+// separate vertical flight speed, so vertical movement faster than this speed while flying is held back by the
+// server. Setting a speed of 0 or lower removes the limit, so that the vertical flight speed only depends on the
+// horizontal flight speed.
+func (p *Player) SetVerticalFlightSpeed(speed float64) {
+	p.verticalFlightSpeed.Store(speed)
+}
+
+// VerticalFlightSpeed returns the maximum vertical flight speed of the player in blocks/tick, as set using
+// SetVerticalFlightSpeed. By default, the vertical flight speed is 0, meaning it is not limited.
+func (p *Player) VerticalFlightSpeed() float64 {
+	return p.verticalFlightSpeed.Load()
+}
+
 // Health returns the current health of the player. It will always be lower than Player.MaxHealth().
 func (p *Player) Health() float64 {
 	return p.health.Health()
@@ -486,13 +672,28 @@ func (p *Player) MaxHealth() float64 {
 }
 
 // SetMaxHealth sets the maximum health of the player. If the current health of the player is higher than the
-// new maximum health, the health is set to the new maximum.
-// SetMaxHealth panics if the max health passed is 0 or lower.
+// new maximum health, the health is set to the new maximum. The health boost set using SetMaxHealthBoost is
+// added on top of this maximum.
+// If the max health passed is 0 or lower, the max health is set to 1.
 func (p *Player) SetMaxHealth(health float64) {
 	p.health.SetMaxHealth(health)
 	p.session().SendHealth(p.health)
 }
 
+// MaxHealthBoost returns the health temporarily added to the maximum health of the player, for example by
+// the health boost effect.
+func (p *Player) MaxHealthBoost() float64 {
+	return p.health.MaxHealthBoost()
+}
+
+// SetMaxHealthBoost sets the health temporarily added to the maximum health of the player. Unlike the
+// maximum health set using SetMaxHealth, the boost is not saved with the data of the player. A negative boost
+// lowers the maximum health of the player.
+func (p *Player) SetMaxHealthBoost(boost float64) {
+	p.health.SetMaxHealthBoost(boost)
+	p.session().SendHealth(p.health)
+}
+
 // addHealth adds health to the player's current health.
 func (p *Player) addHealth(health float64) {
 	p.health.AddHealth(health)
@@ -515,8 +716,15 @@ func (p *Player) Heal(health float64, source world.HealingSource) {
 	p.addHealth(health)
 }
 
-// updateFallState is called to update the entities falling state.
+// updateFallState is called to update the entities falling state. Players with the SlowFalling or Levitation
+// effect do not build up fall distance, so that they do not take fall damage.
 func (p *Player) updateFallState(distanceThisTick float64) {
+	_, slowFalling := p.Effect(effect.SlowFalling{})
+	_, levitation := p.Effect(effect.Levitation{})
+	if slowFalling || levitation {
+		p.ResetFallDistance()
+		return
+	}
 	fallDistance := p.fallDistance.Load()
 	if p.OnGround() {
 		if fallDistance > 0 {
@@ -566,7 +774,7 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 	if _, ok := p.Effect(effect.FireResistance{}); (ok && src.Fire()) || p.Dead() || !p.GameMode().AllowsTakingDamage() {
 		return 0, false
 	}
-	immunity := time.Second / 2
+	immunity := p.World().Combat().Immunity()
 	ctx := event.C()
 	if p.Handler().HandleHurt(ctx, &dmg, &immunity, src); ctx.Cancelled() {
 		return 0, false
@@ -574,23 +782,23 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 	if dmg < 0 {
 		return 0, true
 	}
+	if p.blockWithShield(dmg, src) {
+		return 0, false
+	}
 
+	p.Wake()
 	totalDamage := p.FinalDamageFrom(dmg, src)
-	damageLeft := totalDamage
-
-	if a := p.Absorption(); a > 0 {
-		if damageLeft > a {
-			p.SetAbsorption(0)
-			damageLeft -= a
-		} else {
-			p.SetAbsorption(a - damageLeft)
-			damageLeft = 0
-		}
+	if p.Absorption() > 0 {
+		p.health.Damage(totalDamage)
+		p.session().SendAbsorption(p.Absorption())
+		p.session().SendHealth(p.health)
+	} else {
+		p.addHealth(-totalDamage)
 	}
-	p.addHealth(-damageLeft)
+	p.attackers.track(src, p)
 
 	if src.ReducedByArmour() {
-		p.Exhaust(0.1)
+		p.Exhaust(0.1, DamageExhaustionSource{})
 		p.Armour().Damage(dmg, p.damageItem)
 		var origin world.Entity
 		if s, ok := src.(entity.AttackDamageSource); ok {
@@ -606,23 +814,102 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 		}
 	}
 
-	w, pos := p.World(), p.Position()
+	pos := p.Position()
 	for _, viewer := range p.viewers() {
 		viewer.ViewEntityAction(p, entity.HurtAction{})
 	}
 	if src.Fire() {
-		w.PlaySound(pos, sound.Burning{})
+		p.playSound(pos, sound.Burning{})
 	} else if _, ok := src.(entity.DrowningDamageSource); ok {
-		w.PlaySound(pos, sound.Drowning{})
+		p.playSound(pos, sound.Drowning{})
 	}
 
 	p.SetAttackImmunity(immunity)
-	if p.Dead() {
+	if p.Dead() && !p.useTotem(src) {
 		p.kill(src)
 	}
 	return totalDamage, true
 }
 
+// Blocking checks if the player is currently blocking using a shield. A player blocks if it is sneaking while
+// holding a shield in either of its hands.
+func (p *Player) Blocking() bool {
+	if !p.Sneaking() || p.UsingItem() {
+		return false
+	}
+	held, left := p.HeldItems()
+	_, mainHand := held.Item().(item.Shield)
+	_, offHand := left.Item().(item.Shield)
+	return mainHand || offHand
+}
+
+// blockWithShield checks if the damage dealt by the source passed is blocked by a shield held by the player. This
+// is the case if the player is blocking and the damage originated from an attack or projectile in front of the
+// player. If the damage is blocked, the shield is damaged and true is returned.
+func (p *Player) blockWithShield(dmg float64, src world.DamageSource) bool {
+	if !p.Blocking() {
+		return false
+	}
+	var origin world.Entity
+	if s, ok := src.(entity.AttackDamageSource); ok {
+		origin = s.Attacker
+	} else if s, ok := src.(entity.ProjectileDamageSource); ok {
+		origin = s.Projectile
+	}
+	if origin == nil {
+		return false
+	}
+	dir, facing := origin.Position().Sub(p.Position()), p.Rotation().Vec3()
+	dir[1], facing[1] = 0, 0
+	if dir.Len() == 0 || facing.Len() == 0 || dir.Normalize().Dot(facing.Normalize()) <= 0 {
+		// The damage did not come from the front of the player, so the shield can't block it.
+		return false
+	}
+	if dmg >= 3 {
+		held, left := p.HeldItems()
+		if _, ok := held.Item().(item.Shield); ok {
+			held = p.damageItem(held, 1+int(math.Floor(dmg)))
+		} else {
+			left = p.damageItem(left, 1+int(math.Floor(dmg)))
+		}
+		p.SetHeldItems(held, left)
+	}
+	p.playSound(p.Position(), sound.ShieldBlock{})
+	return true
+}
+
+// useTotem attempts to prevent the death of the player by using a totem of undying held in either of its hands.
+// If a totem was found, it is consumed, the player is healed and given several effects and true is returned.
+// Totems do not protect against damage from the void.
+func (p *Player) useTotem(src world.DamageSource) bool {
+	if _, ok := src.(entity.VoidDamageSource); ok {
+		return false
+	}
+	held, left := p.HeldItems()
+	if _, ok := left.Item().(item.Totem); ok {
+		left = left.Grow(-1)
+	} else if _, ok := held.Item().(item.Totem); ok {
+		held = held.Grow(-1)
+	} else {
+		return false
+	}
+	p.SetHeldItems(held, left)
+
+	p.addHealth(1 - p.Health())
+	for _, e := range p.Effects() {
+		p.RemoveEffect(e.Type())
+	}
+	p.AddEffect(effect.New(effect.Regeneration{}, 2, time.Second*40))
+	p.AddEffect(effect.New(effect.FireResistance{}, 1, time.Second*40))
+	p.AddEffect(effect.New(effect.Absorption{}, 2, time.Second*5))
+
+	for _, viewer := range p.viewers() {
+		viewer.ViewEntityAction(p, entity.TotemUseAction{})
+	}
+	p.playSound(p.Position(), sound.Totem{})
+	return true
+}
+
 // FinalDamageFrom resolves the final damage received by the player if it is attacked by the source passed
 // with the damage passed. FinalDamageFrom takes into account things such as the armour worn and the
 // enchantments on the individual pieces.
@@ -640,7 +927,7 @@ func (p *Player) FinalDamageFrom(dmg float64, src world.DamageSource) float64 {
 // Explode ...
 func (p *Player) Explode(explosionPos mgl64.Vec3, impact float64, c block.ExplosionConfig) {
 	diff := p.Position().Sub(explosionPos)
-	p.Hurt(math.Floor((impact*impact+impact)*3.5*c.Size+1), entity.ExplosionDamageSource{})
+	p.Hurt(math.Floor((impact*impact+impact)*3.5*c.Size+1), entity.ExplosionDamageSource{Owner: c.Owner})
 	p.knockBack(explosionPos, impact, diff[1]/diff.Len()*impact)
 }
 
@@ -648,14 +935,13 @@ func (p *Player) Explode(explosionPos mgl64.Vec3, impact float64, c block.Explos
 // actually increase the maximum health. Once the hearts are lost, they will not regenerate.
 // Nothing happens if a negative number is passed.
 func (p *Player) SetAbsorption(health float64) {
-	health = math.Max(health, 0)
-	p.absorptionHealth.Store(health)
-	p.session().SendAbsorption(health)
+	p.health.SetAbsorption(health)
+	p.session().SendAbsorption(p.health.Absorption())
 }
 
 // Absorption returns the absorption health that the player has.
 func (p *Player) Absorption() float64 {
-	return p.absorptionHealth.Load()
+	return p.health.Absorption()
 }
 
 // KnockBack knocks the player back with a given force and height. A source is passed which indicates the
@@ -720,6 +1006,10 @@ func (p *Player) AddFood(points int) {
 // Saturate saturates the player's food bar with the amount of food points and saturation points passed. The
 // total saturation of the player will never exceed its total food level.
 func (p *Player) Saturate(food int, saturation float64) {
+	ctx := event.C()
+	if p.Handler().HandleFoodGain(ctx, &food, &saturation, ConsumptionFoodSource{}); ctx.Cancelled() {
+		return
+	}
 	p.hunger.saturate(food, saturation)
 	p.sendFood()
 }
@@ -766,10 +1056,16 @@ func (*Player) BeaconAffected() bool {
 
 // Exhaust exhausts the player by the amount of points passed if the player is in survival mode. If the total
 // exhaustion level exceeds 4, a saturation point, or food point, if saturation is 0, will be subtracted.
-func (p *Player) Exhaust(points float64) {
+// The world.ExhaustionSource passed is the cause of the exhaustion, such as SprintExhaustionSource. If none
+// of the sources in this package applies, CustomExhaustionSource may be passed.
+func (p *Player) Exhaust(points float64, src world.ExhaustionSource) {
 	if !p.GameMode().AllowsTakingDamage() || p.World().Difficulty().FoodRegenerates() {
 		return
 	}
+	ctx := event.C()
+	if p.Handler().HandleExhaust(ctx, &points, src); ctx.Cancelled() || points <= 0 {
+		return
+	}
 	before := p.hunger.Food()
 	p.hunger.exhaust(points)
 	if after := p.hunger.Food(); before != after {
@@ -777,7 +1073,7 @@ func (p *Player) Exhaust(points float64) {
 		p.hunger.SetFood(before)
 
 		ctx := event.C()
-		if p.Handler().HandleFoodLoss(ctx, before, &after); ctx.Cancelled() {
+		if p.Handler().HandleFoodLoss(ctx, before, &after, src); ctx.Cancelled() {
 			return
 		}
 		p.hunger.SetFood(after)
@@ -808,6 +1104,7 @@ func (p *Player) DeathPosition() (mgl64.Vec3, world.Dimension, bool) {
 
 // kill kills the player, clearing its inventories and resetting it to its base state.
 func (p *Player) kill(src world.DamageSource) {
+	p.Wake()
 	for _, viewer := range p.viewers() {
 		viewer.ViewEntityAction(p, entity.DeathAction{})
 	}
@@ -816,16 +1113,25 @@ func (p *Player) kill(src world.DamageSource) {
 
 	keepInv := false
 	p.Handler().HandleDeath(src, &keepInv)
+
+	killer := p.killer(src)
+	msg, ctx := p.deathMessage(src, killer), event.C()
+	if p.Handler().HandleDeathMessage(ctx, src, killer, &msg); !ctx.Cancelled() {
+		chat.Global.WriteTranslation(msg)
+	}
+	p.attackers.reset()
+
 	p.StopSneaking()
 	p.StopSprinting()
 
 	w, pos := p.World(), p.Position()
 	if !keepInv {
-		p.dropContents()
+		p.dropContents(src)
 	}
 	for _, e := range p.Effects() {
 		p.RemoveEffect(e.Type())
 	}
+	p.SetAbsorption(0)
 
 	p.deathMu.Lock()
 	defer p.deathMu.Unlock()
@@ -847,24 +1153,37 @@ func (p *Player) kill(src world.DamageSource) {
 	})
 }
 
-// dropContents drops all items and experience of the Player on the ground in random directions.
-func (p *Player) dropContents() {
+// dropContents drops all items and experience of the Player on the ground in random directions. The source of
+// the death of the player is passed to Handler.HandleDeathDrops. It is nil if the contents are dropped after the
+// player died, which happens if an item is added to its inventory while it is dead.
+func (p *Player) dropContents(src world.DamageSource) {
 	w, pos := p.World(), p.Position()
-	for _, orb := range entity.NewExperienceOrbs(pos, int(math.Min(float64(p.experience.Level()*7), 100))) {
-		orb.SetVelocity(mgl64.Vec3{(rand.Float64()*0.2 - 0.1) * 2, rand.Float64() * 0.4, (rand.Float64()*0.2 - 0.1) * 2})
-		w.AddEntity(orb)
-	}
+	xp := int(math.Min(float64(p.experience.Level()*7), 100))
 	p.experience.Reset()
 	p.session().SendExperience(p.experience)
 
 	p.session().EmptyUIInventory()
-	for _, it := range append(p.inv.Clear(), append(p.armour.Clear(), p.offHand.Clear()...)...) {
-		if _, ok := it.Enchantment(enchantment.CurseOfVanishing{}); ok {
+	items := slices.DeleteFunc(append(p.inv.Clear(), append(p.armour.Clear(), p.offHand.Clear()...)...), func(it item.Stack) bool {
+		_, ok := it.Enchantment(enchantment.CurseOfVanishing{})
+		return ok
+	})
+	ctx := event.C()
+	if p.Handler().HandleDeathDrops(ctx, src, &items, &xp); ctx.Cancelled() {
+		return
+	}
+	for _, orb := range entity.NewExperienceOrbs(pos, xp) {
+		orb.SetVelocity(mgl64.Vec3{(rand.Float64()*0.2 - 0.1) * 2, rand.Float64() * 0.4, (rand.Float64()*0.2 - 0.1) * 2})
+		w.AddEntity(orb)
+	}
+	for _, it := range items {
+		if it.Empty() {
 			continue
 		}
-		ent := entity.NewItem(it, pos)
+		ent := entity.NewItemConf(entity.ItemBehaviourConfig{Owner: p.uuid}, it, pos)
 		ent.SetVelocity(mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1})
-		w.AddEntity(ent)
+		// The items were already removed from the inventory of the player, so they must not be discarded by the
+		// entity caps of the world.
+		w.AddEntityUncapped(ent)
 	}
 }
 
@@ -884,7 +1203,19 @@ func (p *Player) Respawn() {
 	// We can use the principle here that returning through a portal of a specific dimension inside that dimension will
 	// always bring us back to the overworld.
 	w = w.PortalDestination(w.Dimension())
-	pos := w.PlayerSpawn(p.UUID()).Vec3Middle()
+	spawn := w.PlayerSpawn(p.UUID())
+	pos := spawn.Vec3Middle()
+	if w.HasPlayerSpawn(p.UUID()) {
+		// The spawn position was set by sleeping in a bed. If the bed was destroyed or something was placed on top
+		// of it, the player spawns at the world spawn instead and the spawn position is reset.
+		if validBedSpawn(w, spawn) {
+			pos = pos.Add(mgl64.Vec3{0, 0.5625})
+		} else {
+			w.ClearPlayerSpawn(p.UUID())
+			p.MessageTranslation(chat.Translation{Key: "tile.bed.notValid", Fallback: "Your home bed was missing or obstructed"})
+			pos = w.Spawn().Vec3Middle()
+		}
+	}
 
 	p.Handler().HandleRespawn(&pos, &w)
 
@@ -895,6 +1226,20 @@ func (p *Player) Respawn() {
 	p.SetVisible()
 }
 
+// validBedSpawn checks if the spawn position passed is a bed that a player can respawn on, meaning the bed still
+// exists and the two blocks above it do not obstruct the player.
+func validBedSpawn(w *world.World, spawn cube.Pos) bool {
+	if _, ok := w.Block(spawn).(block.Bed); !ok {
+		return false
+	}
+	for _, pos := range []cube.Pos{spawn.Side(cube.FaceUp), spawn.Side(cube.FaceUp).Side(cube.FaceUp)} {
+		if len(w.Block(pos).Model().BBox(pos, w)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // StartSprinting makes a player start sprinting, increasing the speed of the player by 30% and making
 // particles show up under the feet. The player will only start sprinting if its food level is high enough.
 // If the player is sneaking when calling StartSprinting, it is stopped from sneaking.
@@ -1018,13 +1363,71 @@ func (p *Player) StopGliding() {
 	p.updateState()
 }
 
-// StartFlying makes the player start flying if they aren't already. It requires the player to be in a gamemode which
-// allows flying.
+// Sleep makes the player sleep in the bed at the position passed. Sleep does nothing if there is no unoccupied
+// bed at the position or if the player is already sleeping. The player is woken up when it is hurt, or when
+// Wake is called.
+func (p *Player) Sleep(pos cube.Pos) {
+	w := p.World()
+	b, ok := w.Block(pos).(block.Bed)
+	if !ok || b.Occupied || p.sleeping.Load() {
+		return
+	}
+	ctx := event.C()
+	if p.Handler().HandleSleep(ctx, pos); ctx.Cancelled() {
+		return
+	}
+	p.StopSneaking()
+	p.StopSprinting()
+	p.StopGliding()
+	b.Occupy(pos, w, true)
+
+	p.Teleport(pos.Vec3Middle().Add(mgl64.Vec3{0, 0.5625}))
+	p.sleepPos.Store(pos)
+	p.sleeping.Store(true)
+	p.updateState()
+}
+
+// Sleeping returns the position of the bed that the player is sleeping in, and true if the player is currently
+// sleeping.
+func (p *Player) Sleeping() (cube.Pos, bool) {
+	if !p.sleeping.Load() {
+		return cube.Pos{}, false
+	}
+	return p.sleepPos.Load(), true
+}
+
+// Wake wakes the player up if it is currently sleeping, making the bed it was sleeping in available again.
+func (p *Player) Wake() {
+	if !p.sleeping.CAS(true, false) {
+		return
+	}
+	w, pos := p.World(), p.sleepPos.Load()
+	if b, ok := w.Block(pos).(block.Bed); ok {
+		b.Occupy(pos, w, false)
+	}
+	for _, v := range p.viewers() {
+		v.ViewEntityAction(p, entity.WakeUpAction{})
+	}
+	p.updateState()
+}
+
+// StartFlying makes the player start flying if they aren't already. It requires the player to be allowed to fly,
+// either because its game mode allows flying or because flight was allowed using SetFlightAllowed.
 func (p *Player) StartFlying() {
-	if !p.GameMode().AllowsFlying() || !p.flying.CAS(false, true) {
+	if !p.FlightAllowed() || p.Flying() {
 		return
 	}
-	p.session().SendGameMode(p.GameMode())
+	ctx := event.C()
+	if p.Handler().HandleToggleFlight(ctx, true); ctx.Cancelled() && p.GameMode().HasCollision() {
+		// Players in game modes without collision, such as spectator mode, must always be flying, so the event
+		// cannot be cancelled for them.
+		p.session().SendAbilities()
+		return
+	}
+	if !p.flying.CAS(false, true) {
+		return
+	}
+	p.session().SendAbilities()
 }
 
 // Flying checks if the player is currently flying.
@@ -1034,10 +1437,34 @@ func (p *Player) Flying() bool {
 
 // StopFlying makes the player stop flying if it currently is.
 func (p *Player) StopFlying() {
+	if !p.Flying() {
+		return
+	}
+	ctx := event.C()
+	if p.Handler().HandleToggleFlight(ctx, false); ctx.Cancelled() && p.FlightAllowed() {
+		p.session().SendAbilities()
+		return
+	}
 	if !p.flying.CAS(true, false) {
 		return
 	}
-	p.session().SendGameMode(p.GameMode())
+	p.session().SendAbilities()
+}
+
+// SetFlightAllowed changes if the player is allowed to fly regardless of its game mode, so that, for example,
+// players in survival mode may fly. If flight is no longer allowed, the player stops flying.
+func (p *Player) SetFlightAllowed(allowed bool) {
+	p.mayFly.Store(allowed)
+	if !p.FlightAllowed() {
+		p.flying.Store(false)
+	}
+	p.session().SendAbilities()
+}
+
+// FlightAllowed checks if the player is allowed to fly, either because its game mode allows flying or because
+// flight was allowed using SetFlightAllowed.
+func (p *Player) FlightAllowed() bool {
+	return p.GameMode().AllowsFlying() || p.mayFly.Load()
 }
 
 // Jump makes the player jump if they are on ground. It exhausts the player by 0.05 food points, an additional 0.15
@@ -1056,9 +1483,9 @@ func (p *Player) Jump() {
 		p.vel.Store(mgl64.Vec3{0, jumpVel})
 	}
 	if p.Sprinting() {
-		p.Exhaust(0.2)
+		p.Exhaust(0.2, JumpExhaustionSource{Sprinting: true})
 	} else {
-		p.Exhaust(0.05)
+		p.Exhaust(0.05, JumpExhaustionSource{})
 	}
 }
 
@@ -1090,6 +1517,28 @@ func (p *Player) Invisible() bool {
 	return p.invisible.Load()
 }
 
+// HideNameTagWhileInvisible hides the name tag of the player from other players while the player is
+// invisible. By default, the name tag of a player remains visible when it turns invisible.
+func (p *Player) HideNameTagWhileInvisible() {
+	if p.hideNameTag.CAS(false, true) && p.Invisible() {
+		p.updateState()
+	}
+}
+
+// ShowNameTagWhileInvisible shows the name tag of the player to other players while the player is
+// invisible, reverting a call to HideNameTagWhileInvisible.
+func (p *Player) ShowNameTagWhileInvisible() {
+	if p.hideNameTag.CAS(true, false) && p.Invisible() {
+		p.updateState()
+	}
+}
+
+// NameTagVisible checks if the name tag of the Player is currently shown to other players. The name tag is
+// hidden only if the Player is invisible and HideNameTagWhileInvisible was called.
+func (p *Player) NameTagVisible() bool {
+	return !p.Invisible() || !p.hideNameTag.Load()
+}
+
 // SetImmobile prevents the player from moving around, but still allows them to look around.
 func (p *Player) SetImmobile() {
 	if !p.immobile.CAS(false, true) {
@@ -1169,6 +1618,17 @@ func (p *Player) SetHeldItems(mainHand, offHand item.Stack) {
 	_ = p.offHand.SetItem(0, offHand)
 }
 
+// SwapHeldItems swaps the items held in the main hand and the off-hand of the player. The items are not swapped
+// if the item held in the main hand cannot be held in the off-hand.
+func (p *Player) SwapHeldItems() {
+	held, left := p.HeldItems()
+	if o, ok := held.Item().(item.OffHand); !held.Empty() && (!ok || !o.OffHand()) {
+		return
+	}
+	p.SetHeldItems(left, held)
+	p.updateState()
+}
+
 // EnderChestInventory returns the player's ender chest inventory. Its accessed by the player when opening
 // ender chests anywhere.
 func (p *Player) EnderChestInventory() *inventory.Inventory {
@@ -1184,8 +1644,8 @@ func (p *Player) SetGameMode(mode world.GameMode) {
 		v.ViewEntityGameMode(p)
 	}
 
-	if !mode.AllowsFlying() {
-		p.StopFlying()
+	if !p.FlightAllowed() && p.flying.CAS(true, false) {
+		p.session().SendAbilities()
 	}
 	if !mode.Visible() {
 		p.SetInvisible()
@@ -1257,7 +1717,7 @@ func (p *Player) UseItem() {
 		p.SetCooldown(it, cd.Cooldown())
 	}
 
-	if _, ok := it.(item.Releasable); ok {
+	if _, ok := it.(item.Releasable); ok && !loaded(it) {
 		if !p.canRelease() {
 			return
 		}
@@ -1275,8 +1735,7 @@ func (p *Player) UseItem() {
 		// We only swing the player's arm if the item held actually does something. If it doesn't, there is no
 		// reason to swing the arm.
 		p.SwingArm()
-		p.SetHeldItems(p.subtractItem(p.damageItem(i, useCtx.Damage), useCtx.CountSub), left)
-		p.addNewItem(useCtx)
+		p.handleUseContext(useCtx)
 	case item.Consumable:
 		if c, ok := usable.(interface{ CanConsume() bool }); ok && !c.CanConsume() {
 			p.ReleaseItem()
@@ -1313,7 +1772,7 @@ func (p *Player) UseItem() {
 		useCtx := p.useContext()
 		useCtx.NewItem = usable.Consume(w, p)
 		p.addNewItem(useCtx)
-		w.PlaySound(p.Position().Add(mgl64.Vec3{0, 1.5}), sound.Burp{})
+		p.playSound(p.Position().Add(mgl64.Vec3{0, 1.5}), sound.Burp{})
 	}
 }
 
@@ -1334,6 +1793,13 @@ func (p *Player) ReleaseItem() {
 	p.updateState()
 }
 
+// loaded checks if an item.Releasable is loaded, such as a loaded crossbow. Loaded items are used directly
+// instead of being charged.
+func loaded(it world.Item) bool {
+	l, ok := it.(interface{ Loaded() bool })
+	return ok && l.Loaded()
+}
+
 // canRelease returns whether the player can release the item currently held in the main hand.
 func (p *Player) canRelease() bool {
 	held, _ := p.HeldItems()
@@ -1344,10 +1810,16 @@ func (p *Player) canRelease() bool {
 	if p.GameMode().CreativeInventory() {
 		return true
 	}
+	_, left := p.HeldItems()
 	for _, req := range releasable.Requirements() {
+		otherName, _ := req.Item().EncodeItem()
+		if !left.Empty() {
+			if name, _ := left.Item().EncodeItem(); name == otherName {
+				continue
+			}
+		}
 		_, found := p.Inventory().FirstFunc(func(stack item.Stack) bool {
 			name, _ := stack.Item().EncodeItem()
-			otherName, _ := req.Item().EncodeItem()
 			return name == otherName
 		})
 		if !found {
@@ -1364,6 +1836,11 @@ func (p *Player) handleUseContext(ctx *item.UseContext) {
 	p.SetHeldItems(p.subtractItem(p.damageItem(i, ctx.Damage), ctx.CountSub), left)
 	p.addNewItem(ctx)
 	for _, it := range ctx.ConsumedItems {
+		if main, left := p.HeldItems(); !left.Empty() && left.Comparable(it) && left.Count() >= it.Count() {
+			// Items held in the off-hand, such as arrows, are consumed before the items in the inventory.
+			p.SetHeldItems(main, left.Grow(-it.Count()))
+			continue
+		}
 		_ = p.Inventory().RemoveItem(it)
 	}
 }
@@ -1408,6 +1885,12 @@ func (p *Player) UseItemOnBlock(pos cube.Pos, face cube.Face, clickPos mgl64.Vec
 			// The block was activated: Blocks such as doors must always have precedence over the item being
 			// used.
 			if useCtx := p.useContext(); act.Activate(pos, face, p.World(), p, useCtx) {
+				if w.Immutable() {
+					// The block could not be changed by the activation, but the client may have predicted it
+					// to, such as doors being opened, so we resend it. Items used are not consumed.
+					p.resendBlocks(pos, w, face)
+					return
+				}
 				p.SetHeldItems(p.subtractItem(p.damageItem(i, useCtx.Damage), useCtx.CountSub), left)
 				p.addNewItem(useCtx)
 				return
@@ -1417,6 +1900,11 @@ func (p *Player) UseItemOnBlock(pos cube.Pos, face cube.Face, clickPos mgl64.Vec
 	if i.Empty() {
 		return
 	}
+	if w.Immutable() {
+		// Items used on blocks would modify them, which isn't possible in immutable worlds.
+		p.resendBlocks(pos, w, face)
+		return
+	}
 	switch ib := i.Item().(type) {
 	case item.UsableOnBlock:
 		// The item does something when used on a block.
@@ -1480,10 +1968,11 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 		return false
 	}
 	var (
-		force, height  = 0.45, 0.3608
+		combat         = p.World().Combat()
+		force, height  = combat.KnockBack()
 		_, slowFalling = p.Effect(effect.SlowFalling{})
 		_, blind       = p.Effect(effect.Blindness{})
-		critical       = !p.Sprinting() && !p.Flying() && p.FallDistance() > 0 && !slowFalling && !blind
+		critical       = (!p.Sprinting() || combat.SprintCriticals) && !p.Flying() && p.FallDistance() > 0 && !slowFalling && !blind
 	)
 
 	ctx := event.C()
@@ -1518,7 +2007,7 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 	n, vulnerable := living.Hurt(dmg, entity.AttackDamageSource{Attacker: p})
 	i, left := p.HeldItems()
 
-	p.World().PlaySound(entity.EyePosition(e), sound.Attack{Damage: !mgl64.FloatEqual(n, 0)})
+	p.playSound(entity.EyePosition(e), sound.Attack{Damage: !mgl64.FloatEqual(n, 0)})
 	if !vulnerable {
 		return true
 	}
@@ -1528,7 +2017,7 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 		}
 	}
 
-	p.Exhaust(0.1)
+	p.Exhaust(0.1, AttackExhaustionSource{})
 
 	if k, ok := i.Enchantment(enchantment.KnockBack{}); ok {
 		inc := (enchantment.KnockBack{}).Force(k.Level())
@@ -1564,7 +2053,7 @@ func (p *Player) StartBreaking(pos cube.Pos, face cube.Face) {
 	if _, ok := w.Block(pos.Side(face)).(block.Fire); ok {
 		// TODO: Add a way to cancel fire extinguishing. This is currently not possible to handle.
 		w.SetBlock(pos.Side(face), nil, nil)
-		w.PlaySound(pos.Vec3(), sound.FireExtinguish{})
+		p.playSound(pos.Vec3(), sound.FireExtinguish{})
 		return
 	}
 
@@ -1592,6 +2081,7 @@ func (p *Player) StartBreaking(pos cube.Pos, face cube.Face) {
 		return
 	}
 	p.lastBreakDuration = p.breakTime(pos)
+	p.breakProgress, p.lastBreakUpdate = 0, time.Now()
 	for _, viewer := range p.viewers() {
 		viewer.ViewBlockAction(pos, block.StartCrackAction{BreakTime: p.lastBreakDuration})
 	}
@@ -1632,10 +2122,47 @@ func (p *Player) FinishBreaking() {
 		p.resendBlock(pos, p.World())
 		return
 	}
-	p.AbortBreaking()
 	p.BreakBlock(pos)
 }
 
+// breakTolerance is the minimum duration by which a player may finish breaking a block earlier than calculated
+// by breakTime. The round trip time of the connection of the player is added to it, to account for differences
+// between the client and server caused by latency.
+const breakTolerance = time.Second / 10
+
+// updateBreakProgress adds the progress made breaking the block at breakingPos since the last update to the
+// breakProgress of the player.
+func (p *Player) updateBreakProgress() {
+	now := time.Now()
+	if p.lastBreakDuration <= 0 {
+		p.breakProgress = 1
+	} else {
+		p.breakProgress += float64(now.Sub(p.lastBreakUpdate)) / float64(p.lastBreakDuration)
+	}
+	p.lastBreakUpdate = now
+}
+
+// breakFinished checks if the player has been breaking the block at breakingPos for long enough for it to be
+// broken, allowing for a difference of breakTolerance plus the round trip time of the connection.
+func (p *Player) breakFinished() bool {
+	p.updateBreakProgress()
+	tolerance := breakTolerance + p.Latency()*2
+	return p.breakProgress >= 1-float64(tolerance)/float64(p.lastBreakDuration)
+}
+
+// verifyBreak checks if the player may break the block at the position passed. Players in a game mode with a
+// creative inventory may always break blocks, while other players must have been breaking the block for long
+// enough. If the player was breaking the block at the position passed, it stops breaking it.
+func (p *Player) verifyBreak(pos cube.Pos) bool {
+	creative := p.GameMode().CreativeInventory()
+	if !p.breaking.Load() || p.breakingPos.Load() != pos {
+		return creative
+	}
+	finished := creative || p.breakFinished()
+	p.AbortBreaking()
+	return finished
+}
+
 // AbortBreaking makes the player stop breaking the block it is currently breaking, or returns immediately
 // if the player isn't breaking anything.
 // Unlike FinishBreaking, AbortBreaking does not stop the animation.
@@ -1668,10 +2195,13 @@ func (p *Player) ContinueBreaking(face cube.Face) {
 	if p.breakParticleCounter.Add(1)%5 == 0 {
 		// We send this sound only every so often. Vanilla doesn't send it every tick while breaking
 		// either. Every 5 ticks seems accurate.
-		w.PlaySound(pos.Vec3(), sound.BlockBreaking{Block: w.Block(pos)})
+		p.playSound(pos.Vec3(), sound.BlockBreaking{Block: w.Block(pos)})
 	}
 	breakTime := p.breakTime(pos)
 	if breakTime != p.lastBreakDuration {
+		// The break time changed, for example because the player started swimming or gained an effect, so
+		// the progress made until now is stored before the new break time is used.
+		p.updateBreakProgress()
 		for _, viewer := range p.viewers() {
 			viewer.ViewBlockAction(pos, block.ContinueCrackAction{BreakTime: breakTime})
 		}
@@ -1696,7 +2226,7 @@ func (p *Player) PlaceBlock(pos cube.Pos, b world.Block, ctx *item.UseContext) {
 // of the player. A bool is returned indicating if a block was placed successfully.
 func (p *Player) placeBlock(pos cube.Pos, b world.Block, ignoreBBox bool) bool {
 	w := p.World()
-	if !p.canReach(pos.Vec3Centre()) || !p.GameMode().AllowsEditing() {
+	if !p.canReach(pos.Vec3Centre()) || !p.GameMode().AllowsEditing() || pos.OutOfBounds(w.Range()) {
 		p.resendBlocks(pos, w, cube.Faces()...)
 		return false
 	}
@@ -1706,12 +2236,12 @@ func (p *Player) placeBlock(pos cube.Pos, b world.Block, ignoreBBox bool) bool {
 	}
 
 	ctx := event.C()
-	if p.Handler().HandleBlockPlace(ctx, pos, b); ctx.Cancelled() {
+	if p.Handler().HandleBlockPlace(ctx, pos, b); ctx.Cancelled() || w.Immutable() {
 		p.resendBlocks(pos, w, cube.Faces()...)
 		return false
 	}
 	w.SetBlock(pos, b, nil)
-	w.PlaySound(pos.Vec3(), sound.BlockPlace{Block: b})
+	p.playSound(pos.Vec3(), sound.BlockPlace{Block: b})
 	p.SwingArm()
 	return true
 }
@@ -1740,9 +2270,16 @@ func (p *Player) obstructedPos(pos cube.Pos, b world.Block) bool {
 }
 
 // BreakBlock makes the player break a block in the world at a position passed. If the player is unable to
-// reach the block passed, the method returns immediately.
+// reach the block passed, the method returns immediately. Unless the player is in a game mode with a creative
+// inventory, the block is only broken if the player started breaking it using StartBreaking and has been
+// breaking it for long enough.
 func (p *Player) BreakBlock(pos cube.Pos) {
 	w := p.World()
+	if !p.verifyBreak(pos) {
+		// The client finished breaking the block faster than it should be able to, so we don't break it.
+		p.resendBlocks(pos, w)
+		return
+	}
 	b := w.Block(pos)
 	if _, air := b.(block.Air); air {
 		// Don't do anything if the position broken is already air.
@@ -1761,11 +2298,20 @@ func (p *Player) BreakBlock(pos cube.Pos) {
 
 	xp := 0
 	if breakable, ok := b.(block.Breakable); ok && !p.GameMode().CreativeInventory() {
-		xp = breakable.BreakInfo().XPDrops.RandomValue()
+		t, ok := held.Item().(item.Tool)
+		if !ok {
+			t = item.ToolNone{}
+		}
+		// Ores broken with silk touch drop themselves rather than the items that would drop experience, and
+		// blocks broken with a tool they are not harvestable with drop nothing at all.
+		_, silkTouch := held.Enchantment(enchantment.SilkTouch{})
+		if info := breakable.BreakInfo(); !(silkTouch && info.SilkTouchCancelsXP) && info.Harvestable(t) {
+			xp = info.XPDrops.RandomValue()
+		}
 	}
 
 	ctx := event.C()
-	if p.Handler().HandleBlockBreak(ctx, pos, &drops, &xp); ctx.Cancelled() {
+	if p.Handler().HandleBlockBreak(ctx, pos, &drops, &xp); ctx.Cancelled() || w.Immutable() {
 		p.resendBlocks(pos, w)
 		return
 	}
@@ -1791,8 +2337,10 @@ func (p *Player) BreakBlock(pos cube.Pos) {
 		w.AddEntity(ent)
 	}
 
-	p.Exhaust(0.005)
-	if block.BreaksInstantly(b, held) {
+	p.Exhaust(0.005, BlockBreakExhaustionSource{})
+	if breakable, ok := b.(block.Breakable); ok && breakable.BreakInfo().Hardness == 0 {
+		// Blocks without hardness, such as grass, do not cost any durability to break. Other blocks, even if
+		// broken instantly because of Efficiency, do.
 		return
 	}
 	if durable, ok := held.Item().(item.Durable); ok {
@@ -1807,16 +2355,21 @@ func (p *Player) drops(held item.Stack, b world.Block) []item.Stack {
 		t = item.ToolNone{}
 	}
 	var drops []item.Stack
-	if container, ok := b.(block.Container); ok {
+	if box, ok := b.(block.ShulkerBox); ok {
+		// Shulker boxes keep their contents in the item dropped. In creative mode, they are only dropped if they
+		// hold any items.
+		if !p.GameMode().CreativeInventory() || !box.Inventory().Empty() {
+			drops = box.BreakInfo().Drops(t, held.Enchantments())
+		}
+	} else if container, ok := b.(block.Container); ok {
 		// If the block is a container, it should drop its inventory contents regardless whether the
 		// player is in creative mode or not.
-		drops = container.Inventory().Items()
+		drops = block.ClearContainer(container)
 		if breakable, ok := b.(block.Breakable); ok && !p.GameMode().CreativeInventory() {
 			if breakable.BreakInfo().Harvestable(t) {
 				drops = append(drops, breakable.BreakInfo().Drops(t, held.Enchantments())...)
 			}
 		}
-		container.Inventory().Clear()
 	} else if breakable, ok := b.(block.Breakable); ok && !p.GameMode().CreativeInventory() {
 		if breakable.BreakInfo().Harvestable(t) {
 			drops = breakable.BreakInfo().Drops(t, held.Enchantments())
@@ -1893,6 +2446,7 @@ func (p *Player) Teleport(pos mgl64.Vec3) {
 // teleport teleports the player to a target position in the world. It does not call the Handler of the
 // player.
 func (p *Player) teleport(pos mgl64.Vec3) {
+	pos = p.World().ClampPosition(pos)
 	for _, v := range p.viewers() {
 		v.ViewEntityTeleport(p, pos)
 	}
@@ -1922,12 +2476,29 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 		yaw, pitch            = p.Rotation().Elem()
 		res, resYaw, resPitch = pos.Add(deltaPos), yaw + deltaYaw, pitch + deltaPitch
 	)
+	corrected := false
+	if v := p.verticalFlightSpeed.Load(); v > 0 && p.Flying() && math.Abs(deltaPos[1]) > v {
+		// The player flew up or down faster than its vertical flight speed allows, so it is held back.
+		res[1], corrected = pos[1]+math.Copysign(v, deltaPos[1]), true
+	}
+	if clamped := w.ClampPosition(res); clamped != res {
+		// The player tried to move beyond the horizontal bounds of the world, so it is held back at the edge.
+		res, corrected = clamped, true
+	}
+	if corrected && !p.session().CorrectMovement(res, mgl64.Vec3{}, p.OnGround()) {
+		p.session().ViewEntityTeleport(p, res)
+	}
 	ctx := event.C()
 	if p.Handler().HandleMove(ctx, res, resYaw, resPitch); ctx.Cancelled() {
 		if p.session() != session.Nop && pos.ApproxEqual(p.Position()) {
 			// The position of the player was changed and the event cancelled. This means we still need to notify the
-			// player of this movement change.
-			p.teleport(pos)
+			// player of this movement change. With movement rewind, only the player itself saw the movement, so
+			// there is no need to notify the viewers of the player.
+			if !p.session().CorrectMovement(pos, mgl64.Vec3{}, p.OnGround()) {
+				p.teleport(pos)
+			}
+			p.vel.Store(mgl64.Vec3{})
+			p.ResetFallDistance()
 		}
 		return
 	}
@@ -1952,7 +2523,7 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 		}
 		if p.collidedHorizontally.Load() {
 			if force := horizontalVel.Len()*10.0 - 3.0; force > 0.0 && !p.AttackImmune() {
-				w.PlaySound(p.Position(), sound.Fall{Distance: force})
+				p.playSound(p.Position(), sound.Fall{Distance: force})
 				p.Hurt(force, entity.GlideDamageSource{})
 			}
 		}
@@ -1970,9 +2541,9 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 	p.updateFallState(deltaPos[1])
 
 	if p.Swimming() {
-		p.Exhaust(0.01 * horizontalVel.Len())
+		p.Exhaust(0.01*horizontalVel.Len(), SwimExhaustionSource{})
 	} else if p.Sprinting() {
-		p.Exhaust(0.1 * horizontalVel.Len())
+		p.Exhaust(0.1*horizontalVel.Len(), SprintExhaustionSource{})
 	}
 }
 
@@ -1993,11 +2564,14 @@ func (p *Player) Velocity() mgl64.Vec3 {
 	return p.vel.Load()
 }
 
-// SetVelocity updates the player's velocity. If there is an attached session, this will just send
-// the velocity to the player session for the player to update.
+// SetVelocity updates the player's velocity. If there is an attached session, the velocity is also sent to
+// the client, which moves the player accordingly. SetVelocity has no effect if the player is immobile or dead.
 func (p *Player) SetVelocity(velocity mgl64.Vec3) {
+	if p.Dead() || p.immobile.Load() {
+		return
+	}
+	p.vel.Store(velocity)
 	if p.session() == session.Nop {
-		p.vel.Store(velocity)
 		return
 	}
 	for _, v := range p.viewers() {
@@ -2079,6 +2653,25 @@ func (p *Player) SetExperienceLevel(level int) {
 	p.session().SendExperience(p.experience)
 }
 
+// SpendExperienceLevels makes the player spend the amount of experience levels passed on the world.LevelCostSource
+// passed, calling Handler.HandleExperienceSpend. The progress towards the next level is kept. False is returned if
+// the event was cancelled or if the player did not have enough levels, in which case no levels are spent.
+func (p *Player) SpendExperienceLevels(levels int, src world.LevelCostSource) bool {
+	ctx := event.C()
+	if p.Handler().HandleExperienceSpend(ctx, &levels, src); ctx.Cancelled() {
+		return false
+	}
+	if levels <= 0 {
+		return true
+	}
+	level := p.experience.Level()
+	if level < levels {
+		return false
+	}
+	p.SetExperienceLevel(level - levels)
+	return true
+}
+
 // ExperienceProgress returns the experience progress of the player.
 func (p *Player) ExperienceProgress() float64 {
 	return p.experience.Progress()
@@ -2153,18 +2746,21 @@ func (p *Player) mendItems(xp int) int {
 
 // Drop makes the player drop the item.Stack passed as an entity.Item, so that it may be picked up from the
 // ground.
-// The dropped item entity has a pickup delay of 2 seconds.
+// The dropped item entity has a pickup delay of 2 seconds and has the player as its thrower.
 // The number of items that was dropped in the end is returned. It is generally the count of the stack passed
-// or 0 if dropping the item.Stack was cancelled.
+// or 0 if dropping the item.Stack was cancelled or the item entity exceeded the entity caps of the world.
 func (p *Player) Drop(s item.Stack) int {
-	e := entity.NewItemPickupDelay(s, p.Position().Add(mgl64.Vec3{0, 1.4}), time.Second*2)
+	e := entity.NewItemConf(entity.ItemBehaviourConfig{PickupDelay: time.Second * 2, Thrower: p.uuid}, s, p.Position().Add(mgl64.Vec3{0, 1.4}))
 	e.SetVelocity(p.Rotation().Vec3().Mul(0.4))
 
 	ctx := event.C()
 	if p.Handler().HandleItemDrop(ctx, e); ctx.Cancelled() {
 		return 0
 	}
-	p.World().AddEntity(e)
+	if !p.World().AddEntity(e) {
+		// The item entity could not be spawned because of the entity caps of the world, so nothing was dropped.
+		return 0
+	}
 	return s.Count()
 }
 
@@ -2172,9 +2768,73 @@ func (p *Player) Drop(s item.Stack) int {
 // present at that location, OpenBlockContainer does nothing.
 // OpenBlockContainer will also do nothing if the player has no session connected to it.
 func (p *Player) OpenBlockContainer(pos cube.Pos) {
-	if p.session() != session.Nop {
-		p.session().OpenBlockContainer(pos)
+	if p.session() == session.Nop {
+		return
+	}
+	if opened, ok := p.session().OpenedBlockContainer(); ok && opened == pos {
+		return
+	}
+	ctx := event.C()
+	if p.Handler().HandleContainerOpen(ctx, pos, p.World().Block(pos)); ctx.Cancelled() {
+		return
+	}
+	p.session().OpenBlockContainer(pos)
+}
+
+// OpenedBlockContainer returns the position of the block container, such as a chest, that the player currently
+// has opened. If the player has no block container opened, false is returned.
+func (p *Player) OpenedBlockContainer() (cube.Pos, bool) {
+	return p.session().OpenedBlockContainer()
+}
+
+// CloseBlockContainer closes the block container that the player currently has opened. If the player has no
+// block container opened, CloseBlockContainer does nothing.
+func (p *Player) CloseBlockContainer() {
+	pos, ok := p.session().OpenedBlockContainer()
+	if !ok {
+		return
 	}
+	p.Handler().HandleContainerClose(pos, p.World().Block(pos))
+	p.session().CloseBlockContainer()
+}
+
+// OpenMerchant opens the trading UI of the inventory.Merchant passed for the player, allowing the player to
+// trade its offers. The trader passed is the entity shown in the trading UI, such as a villager or an NPC,
+// and must be visible to the player. Any block container opened by the player is closed.
+func (p *Player) OpenMerchant(m *inventory.Merchant, trader world.Entity) {
+	p.session().OpenMerchant(m, trader)
+}
+
+// OpenedMerchant returns the inventory.Merchant of which the player currently has the trading UI opened. If
+// the player has no trading UI opened, false is returned.
+func (p *Player) OpenedMerchant() (*inventory.Merchant, bool) {
+	return p.session().OpenedMerchant()
+}
+
+// CloseMerchant closes the trading UI that the player currently has opened. If the player has no trading UI
+// opened, CloseMerchant does nothing.
+func (p *Player) CloseMerchant() {
+	p.session().CloseMerchant()
+}
+
+// OpenCraftingMenu opens the inventory.CraftingMenu passed for the player. The player may place items in the
+// grid of the menu and take the result computed by the menu, which consumes the items in the grid. Items left
+// in the grid are returned to the player when the menu is closed. Any block container or trading UI opened by
+// the player is closed.
+func (p *Player) OpenCraftingMenu(m inventory.CraftingMenu) {
+	p.session().OpenCraftingMenu(m)
+}
+
+// OpenedCraftingMenu returns the inventory.CraftingMenu that the player currently has opened. If the player has
+// no crafting menu opened, false is returned.
+func (p *Player) OpenedCraftingMenu() (inventory.CraftingMenu, bool) {
+	return p.session().OpenedCraftingMenu()
+}
+
+// CloseCraftingMenu closes the crafting menu that the player currently has opened. If the player has no
+// crafting menu opened, CloseCraftingMenu does nothing.
+func (p *Player) CloseCraftingMenu() {
+	p.session().CloseCraftingMenu()
 }
 
 // HideEntity hides a world.Entity from the Player so that it can under no circumstance see it. Hidden entities can be
@@ -2193,6 +2853,34 @@ func (p *Player) ShowEntity(e world.Entity) {
 	}
 }
 
+// Vanish vanishes the Player: It is hidden from the world and the player list of all other players that do not
+// have the vanish bypass enabled using SetVanishBypass, no join or quit messages are broadcast for it and the
+// sounds it causes are only played to the Player itself. The Player stays vanished across chunk reloads and
+// world changes until Unvanish is called. Vanish has no effect if the Player does not have a session.
+func (p *Player) Vanish() {
+	p.session().Vanish()
+}
+
+// Unvanish makes the Player, previously vanished using Vanish, visible to all other players again.
+func (p *Player) Unvanish() {
+	p.session().Unvanish()
+}
+
+// Vanished checks if the Player is currently vanished using Vanish.
+func (p *Player) Vanished() bool {
+	return p.session().Vanished()
+}
+
+// SetVanishBypass changes if the Player is able to see players that are vanished.
+func (p *Player) SetVanishBypass(v bool) {
+	p.session().SetVanishBypass(v)
+}
+
+// VanishBypass checks if the Player is able to see players that are vanished.
+func (p *Player) VanishBypass() bool {
+	return p.session().VanishBypass()
+}
+
 // Latency returns a rolling average of latency between the sending and the receiving end of the connection of
 // the player.
 // The latency returned is updated continuously and is half the round trip time (RTT).
@@ -2204,6 +2892,23 @@ func (p *Player) Latency() time.Duration {
 	return p.session().Latency()
 }
 
+// JoinSilently makes the player join the server silently, like a vanished player: No join or quit messages
+// are broadcast for the player and it is not added to the player list of other players. JoinSilently only
+// has an effect if called from the server.HandleFunc passed to server.Server.Accept, before the player has
+// joined.
+func (p *Player) JoinSilently() {
+	if p.session() != session.Nop {
+		p.session().JoinSilently()
+	}
+}
+
+// NetworkStats returns statistics on the network traffic of the connection of the player, such as the amount
+// of packets and bytes sent and received and the compression ratio of the packets sent.
+// If the Player does not have a session associated with it, NetworkStats returns an empty session.Stats.
+func (p *Player) NetworkStats() session.Stats {
+	return p.session().Stats()
+}
+
 // Tick ticks the entity, performing actions such as checking if the player is still breaking a block.
 func (p *Player) Tick(w *world.World, current int64) {
 	if p.Dead() {
@@ -2237,6 +2942,7 @@ func (p *Player) Tick(w *world.World, current int64) {
 
 	p.tickFood(w)
 	p.tickAirSupply(w)
+	p.tickFreezing(current)
 	if p.immunityTicks.Load() > 0 {
 		p.immunityTicks.Dec()
 	}
@@ -2312,6 +3018,63 @@ func (p *Player) tickAirSupply(w *world.World) {
 	}
 }
 
+// maxFreezeTicks is the amount of ticks that a player must spend in powder snow to be fully frozen.
+const maxFreezeTicks = 140
+
+// tickFreezing ticks the freezing of the player, freezing it further if it was inside powder snow during the
+// tick and thawing it otherwise. Fully frozen players take damage every two seconds.
+func (p *Player) tickFreezing(current int64) {
+	ticks := p.freezeTicks.Load()
+	if p.freezing.Swap(false) {
+		ticks = min(ticks+1, maxFreezeTicks)
+	} else {
+		ticks = max(ticks-2, 0)
+	}
+	if p.freezeTicks.Swap(ticks) != ticks {
+		p.updateState()
+	}
+	if ticks == maxFreezeTicks && current%40 == 0 && !p.AttackImmune() {
+		p.Hurt(1, entity.FreezingDamageSource{})
+	}
+}
+
+// Freeze freezes the player further, as a result of it being inside powder snow for another tick. Players that
+// cannot take damage or wear leather armour do not freeze.
+func (p *Player) Freeze() {
+	if !p.GameMode().AllowsTakingDamage() {
+		return
+	}
+	for _, it := range p.Armour().Items() {
+		if leatherArmour(it) {
+			return
+		}
+	}
+	p.freezing.Store(true)
+}
+
+// leatherArmour checks if the item stack passed holds a piece of leather armour.
+func leatherArmour(s item.Stack) bool {
+	var tier item.ArmourTier
+	switch it := s.Item().(type) {
+	case item.Helmet:
+		tier = it.Tier
+	case item.Chestplate:
+		tier = it.Tier
+	case item.Leggings:
+		tier = it.Tier
+	case item.Boots:
+		tier = it.Tier
+	}
+	_, leather := tier.(item.ArmourTierLeather)
+	return leather
+}
+
+// FreezeProgress returns how far the player is frozen, ranging from 0 if it is not frozen at all to 1 if it is
+// fully frozen and takes freezing damage.
+func (p *Player) FreezeProgress() float64 {
+	return float64(p.freezeTicks.Load()) / maxFreezeTicks
+}
+
 // tickFood ticks food related functionality, such as the depletion of the food bar and regeneration if it
 // is full enough.
 func (p *Player) tickFood(w *world.World) {
@@ -2321,8 +3084,13 @@ func (p *Player) tickFood(w *world.World) {
 	}
 
 	if p.hunger.foodTick%10 == 0 && (p.hunger.canQuicklyRegenerate() || w.Difficulty().FoodRegenerates()) {
-		if w.Difficulty().FoodRegenerates() {
-			p.AddFood(1)
+		if w.Difficulty().FoodRegenerates() && p.hunger.Food() < 20 {
+			food, saturation := 1, 0.0
+			ctx := event.C()
+			if p.Handler().HandleFoodGain(ctx, &food, &saturation, PeacefulFoodSource{}); !ctx.Cancelled() {
+				p.hunger.saturate(food, saturation)
+				p.sendFood()
+			}
 		}
 		if p.hunger.foodTick%20 == 0 {
 			p.regenerate(false)
@@ -2348,7 +3116,7 @@ func (p *Player) regenerate(exhaust bool) {
 	}
 	p.Heal(1, entity.FoodHealingSource{})
 	if exhaust {
-		p.Exhaust(6)
+		p.Exhaust(6, RegenerationExhaustionSource{})
 	}
 }
 
@@ -2452,7 +3220,7 @@ func (p *Player) checkBlockCollisions(vel mgl64.Vec3, w *world.World) {
 		for x := minX; x <= maxX; x++ {
 			for z := minZ; z <= maxZ; z++ {
 				pos := cube.Pos{x, y, z}
-				boxes := w.Block(pos).Model().BBox(pos, w)
+				boxes := world.EntityBBox(w.Block(pos).Model(), pos, w, p)
 				for _, box := range boxes {
 					blocks = append(blocks, box.Translate(pos.Vec3()))
 				}
@@ -2526,7 +3294,7 @@ func (p *Player) checkOnGround(w *world.World) bool {
 		for z := min[2]; z <= max[2]; z++ {
 			for y := min[1]; y < max[1]; y++ {
 				pos := cube.Pos{x, y, z}
-				boxList := w.Block(pos).Model().BBox(pos, w)
+				boxList := world.EntityBBox(w.Block(pos).Model(), pos, w, p)
 				for _, bb := range boxList {
 					if bb.GrowVec3(mgl64.Vec3{0, 0.05}).Translate(pos.Vec3()).IntersectsWith(box) {
 						return true
@@ -2573,6 +3341,148 @@ func (p *Player) PlaySound(sound world.Sound) {
 	p.session().PlaySound(sound)
 }
 
+// playSound plays a world.Sound caused by the Player at a position in its world. If the Player is vanished, the
+// sound is only played to the Player itself.
+func (p *Player) playSound(pos mgl64.Vec3, s world.Sound) {
+	if p.Vanished() {
+		p.PlaySound(s)
+		return
+	}
+	p.World().PlaySound(pos, s)
+}
+
+// StopSound stops a sound with the name passed from playing to the Player, such as a sound.Custom played using
+// PlaySound.
+func (p *Player) StopSound(name string) {
+	if name == "" {
+		return
+	}
+	p.session().StopSound(name)
+}
+
+// StopAllSounds stops all sounds currently playing to the Player, including music played using PlayMusic.
+func (p *Player) StopAllSounds() {
+	p.StopMusic(0)
+	p.session().StopSound("")
+}
+
+// PlayMusic plays a track of sound.Music from a resource pack to the Player, such as boss music. Like the music
+// of the game, the track is not positioned in the world. Music that was previously played using PlayMusic is
+// stopped, fading out over the Fade of the new track. If the sound.Music has Loop set to true, the track is
+// repeated until StopMusic is called.
+func (p *Player) PlayMusic(m sound.Music) {
+	p.StopMusic(m.Fade)
+	if m.Name == "" {
+		return
+	}
+	p.musicMu.Lock()
+	p.music, p.musicStart = m, time.Now()
+	p.musicMu.Unlock()
+	p.session().PlayMusic(m)
+}
+
+// Music returns the sound.Music currently played to the Player using PlayMusic. False is returned if no music
+// is playing. A track that does not loop is assumed to be playing until its Length passes.
+func (p *Player) Music() (sound.Music, bool) {
+	p.musicMu.Lock()
+	defer p.musicMu.Unlock()
+	if p.music.Name == "" || (!p.music.Loop && p.music.Length > 0 && time.Since(p.musicStart) >= p.music.Length) {
+		return sound.Music{}, false
+	}
+	return p.music, true
+}
+
+// StopMusic stops the sound.Music currently played to the Player using PlayMusic. The track fades out over the
+// duration passed. If 0, it stops immediately.
+func (p *Player) StopMusic(fade time.Duration) {
+	p.musicMu.Lock()
+	m := p.music
+	p.music = sound.Music{}
+	p.musicMu.Unlock()
+	if m.Name != "" {
+		p.session().StopMusic(fade)
+	}
+}
+
+// PushFog pushes a fog with the identifier passed on top of the fog stack of the Player. Fog identifiers, such as
+// "minecraft:fog_hell", refer to fog settings that are part of the game or defined in a resource pack. Fog higher
+// in the stack is rendered over fog lower in the stack. If the fog is already in the stack, it is moved to the top.
+func (p *Player) PushFog(name string) {
+	p.fogMu.Lock()
+	defer p.fogMu.Unlock()
+	p.fog = append(slices.DeleteFunc(p.fog, func(f string) bool {
+		return f == name
+	}), name)
+	p.session().SendFog(slices.Clone(p.fog))
+}
+
+// PopFog removes the fog on top of the fog stack of the Player and returns its identifier. False is returned if the
+// fog stack was empty.
+func (p *Player) PopFog() (string, bool) {
+	p.fogMu.Lock()
+	defer p.fogMu.Unlock()
+	if len(p.fog) == 0 {
+		return "", false
+	}
+	name := p.fog[len(p.fog)-1]
+	p.fog = p.fog[:len(p.fog)-1]
+	p.session().SendFog(slices.Clone(p.fog))
+	return name, true
+}
+
+// RemoveFog removes the fog with the identifier passed from the fog stack of the Player, regardless of its position
+// in the stack.
+func (p *Player) RemoveFog(name string) {
+	p.fogMu.Lock()
+	defer p.fogMu.Unlock()
+	if !slices.Contains(p.fog, name) {
+		return
+	}
+	p.fog = slices.DeleteFunc(p.fog, func(f string) bool {
+		return f == name
+	})
+	p.session().SendFog(slices.Clone(p.fog))
+}
+
+// ResetFog clears the fog stack of the Player, so that only the fog of the biome the Player is in is rendered.
+func (p *Player) ResetFog() {
+	p.fogMu.Lock()
+	defer p.fogMu.Unlock()
+	p.fog = nil
+	p.session().SendFog(nil)
+}
+
+// Fog returns the fog stack of the Player, from the bottom to the top of the stack.
+func (p *Player) Fog() []string {
+	p.fogMu.Lock()
+	defer p.fogMu.Unlock()
+	return slices.Clone(p.fog)
+}
+
+// ShakeCamera shakes the camera of the Player with the intensity passed for the duration passed. The client does not
+// render intensities above 4. If rotational is true, the camera rotates while shaking rather than moving. Calling
+// ShakeCamera while the camera is already shaking adds to the existing shake.
+func (p *Player) ShakeCamera(intensity float64, duration time.Duration, rotational bool) {
+	p.session().ShakeCamera(intensity, duration, rotational)
+}
+
+// StopCameraShake stops all shaking of the camera of the Player started using ShakeCamera.
+func (p *Player) StopCameraShake() {
+	p.session().StopCameraShake()
+}
+
+// SetCamera detaches the camera of the Player from the Player and moves it to the position passed, looking in the
+// direction of the rotation passed. If ease is non-zero, the camera moves there smoothly over that duration. The
+// Player itself does not move. ClearCamera returns the camera to the Player.
+func (p *Player) SetCamera(pos mgl64.Vec3, rot cube.Rotation, ease time.Duration) {
+	p.session().SetCamera(pos, rot, ease)
+}
+
+// ClearCamera returns the camera of the Player to the Player after it was moved using SetCamera.
+func (p *Player) ClearCamera() {
+	p.session().ClearCamera()
+}
+
 // ShowParticle shows a particle that only this Player can see. Unlike World.AddParticle, it is not broadcast
 // to players around it.
 func (p *Player) ShowParticle(pos mgl64.Vec3, particle world.Particle) {
@@ -2602,13 +3512,16 @@ func (p *Player) EditSign(pos cube.Pos, frontText, backText string) error {
 
 	ctx := event.C()
 	if frontText != sign.Front.Text {
-		if p.Handler().HandleSignEdit(ctx, true, sign.Front.Text, frontText); ctx.Cancelled() {
+		if p.Handler().HandleSignEdit(ctx, pos, true, sign.Front.Text, &frontText); ctx.Cancelled() {
+			// The client already shows the edited text, so send the sign back to it.
+			p.session().ViewBlockUpdate(pos, sign, 0)
 			return nil
 		}
 		sign.Front.Text = frontText
 		sign.Front.Owner = p.XUID()
 	} else {
-		if p.Handler().HandleSignEdit(ctx, false, sign.Back.Text, backText); ctx.Cancelled() {
+		if p.Handler().HandleSignEdit(ctx, pos, false, sign.Back.Text, &backText); ctx.Cancelled() {
+			p.session().ViewBlockUpdate(pos, sign, 0)
 			return nil
 		}
 		sign.Back.Text = backText
@@ -2632,9 +3545,7 @@ func (p *Player) TurnLecternPage(pos cube.Pos, page int) error {
 		return nil
 	}
 
-	lectern.Page = page
-	w.SetBlock(pos, lectern, nil)
-	return nil
+	return lectern.TurnPage(pos, w, page)
 }
 
 // updateState updates the state of the player to all viewers of the player.
@@ -2664,6 +3575,17 @@ func (p *Player) SwingArm() {
 	}
 }
 
+// Emote makes the player perform the emote with the UUID passed. The emote is shown to all viewers of the player,
+// which makes it possible to let players without a client, such as NPCs, perform emotes.
+func (p *Player) Emote(emote uuid.UUID) {
+	if p.Dead() {
+		return
+	}
+	for _, v := range p.viewers() {
+		v.ViewEmote(p, emote)
+	}
+}
+
 // PunchAir makes the player punch the air and plays the sound for attacking with no damage.
 func (p *Player) PunchAir() {
 	if p.Dead() {
@@ -2674,7 +3596,7 @@ func (p *Player) PunchAir() {
 		return
 	}
 	p.SwingArm()
-	p.World().PlaySound(p.Position(), sound.Attack{})
+	p.playSound(p.Position(), sound.Attack{})
 }
 
 // damageItem damages the item stack passed with the damage passed and returns the new stack. If the item
@@ -2692,7 +3614,7 @@ func (p *Player) damageItem(s item.Stack, d int) item.Stack {
 		d = (enchantment.Unbreaking{}).Reduce(s.Item(), e.Level(), d)
 	}
 	if s = s.Damage(d); s.Empty() {
-		p.World().PlaySound(p.Position(), sound.ItemBreak{})
+		p.playSound(p.Position(), sound.ItemBreak{})
 	}
 	return s
 }
@@ -2722,7 +3644,7 @@ func (p *Player) addNewItem(ctx *item.UseContext) {
 		p.Drop(ctx.NewItem.Grow(ctx.NewItem.Count() - n))
 	}
 	if p.Dead() {
-		p.dropContents()
+		p.dropContents(nil)
 	}
 }
 
@@ -2747,6 +3669,7 @@ func (p *Player) canReach(pos mgl64.Vec3) bool {
 // Disconnect closes the player and removes it from the world.
 // Disconnect, unlike Close, allows a custom message to be passed to show to the player when it is
 // disconnected. The message is formatted following the rules of fmt.Sprintln without a newline at the end.
+// A session.Disconnection may be passed to disconnect the player with a specific reason.
 func (p *Player) Disconnect(msg ...any) {
 	p.once.Do(func() {
 		p.close(format(msg))
@@ -2771,6 +3694,13 @@ func (p *Player) close(msg string) {
 	if p.Dead() && p.session() != nil {
 		p.Respawn()
 	}
+	p.Wake()
+	if msg := p.session().QuitMessage(); msg != "" {
+		ctx := event.C()
+		if p.Handler().HandleQuitMessage(ctx, &msg); !ctx.Cancelled() {
+			_, _ = fmt.Fprintln(chat.Global, msg)
+		}
+	}
 	p.h.Swap(NopHandler{}).HandleQuit()
 
 	if s := p.s.Swap(nil); s != nil {
@@ -2790,11 +3720,6 @@ func (p *Player) load(data Data) {
 	p.pitch.Store(data.Pitch)
 
 	p.health.SetMaxHealth(data.MaxHealth)
-	p.health.AddHealth(data.Health - p.Health())
-	p.session().SendHealth(p.health)
-
-	p.absorptionHealth.Store(data.AbsorptionLevel)
-	p.session().SendAbsorption(p.absorptionHealth.Load())
 
 	p.hunger.SetFood(data.Hunger)
 	p.hunger.foodTick = data.FoodTick
@@ -2813,8 +3738,14 @@ func (p *Player) load(data Data) {
 	for _, potion := range data.Effects {
 		p.AddEffect(potion)
 	}
+	// The health and absorption are only restored after adding the effects: Effects such as health boost
+	// change the maximum health, while absorption would otherwise overwrite the absorption health left.
+	p.health.AddHealth(data.Health - p.Health())
+	p.session().SendHealth(p.health)
+	p.SetAbsorption(data.AbsorptionLevel)
 	p.fireTicks.Store(data.FireTicks)
 	p.fallDistance.Store(data.FallDistance)
+	p.seenCredits.Store(data.SeenCredits)
 
 	p.loadInventory(data.Inventory)
 	for slot, stack := range data.EnderChestInventory {
@@ -2848,7 +3779,7 @@ func (p *Player) Data() Data {
 		Yaw:             yaw,
 		Pitch:           pitch,
 		Health:          p.Health(),
-		MaxHealth:       p.MaxHealth(),
+		MaxHealth:       p.health.BaseMaxHealth(),
 		Hunger:          p.hunger.foodLevel,
 		Experience:      p.Experience(),
 		EnchantmentSeed: p.EnchantmentSeed(),
@@ -2872,6 +3803,7 @@ func (p *Player) Data() Data {
 		Effects:             p.Effects(),
 		FireTicks:           p.fireTicks.Load(),
 		FallDistance:        p.fallDistance.Load(),
+		SeenCredits:         p.seenCredits.Load(),
 		World:               p.World(),
 	}
 }
@@ -2914,6 +3846,9 @@ func (p *Player) useContext() *item.UseContext {
 			}
 		},
 		FirstFunc: func(comparable func(item.Stack) bool) (item.Stack, bool) {
+			if _, left := p.HeldItems(); !left.Empty() && comparable(left) {
+				return left, true
+			}
 			inv := p.Inventory()
 			s, ok := inv.FirstFunc(comparable)
 			if !ok {
@@ -34,6 +34,7 @@ func (p *Provider) fromJson(d jsonData, lookupWorld func(world.Dimension) *world
 		Effects:             dataToEffects(d.Effects),
 		FireTicks:           d.FireTicks,
 		FallDistance:        d.FallDistance,
+		SeenCredits:         d.SeenCredits,
 		Inventory:           dataToInv(d.Inventory),
 		EnderChestInventory: make([]item.Stack, 27),
 		World:               lookupWorld(dim),
@@ -67,6 +68,7 @@ func (p *Provider) toJson(d player.Data) jsonData {
 		Effects:             effectsToData(d.Effects),
 		FireTicks:           d.FireTicks,
 		FallDistance:        d.FallDistance,
+		SeenCredits:         d.SeenCredits,
 		Inventory:           invToData(d.Inventory),
 		EnderChestInventory: encodeItems(d.EnderChestInventory),
 		Dimension:           uint8(dim),
@@ -92,6 +94,7 @@ type jsonData struct {
 	Effects                          []jsonEffect
 	FireTicks                        int64
 	FallDistance                     float64
+	SeenCredits                      bool
 	Dimension                        uint8
 }
 
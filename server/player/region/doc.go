@@ -0,0 +1,11 @@
+// Package region implements trigger regions: Axis-aligned boxes in a world that detect players entering,
+// leaving and interacting with blocks inside of them. Regions may be used to implement portals, arenas, shop
+// zones and similar areas.
+//
+// Regions are registered to a Manager, which checks every world tick which players are inside each of its
+// regions. Only the chunks touched by a region are searched, so regions are cheap even in busy worlds.
+//
+//	m := region.NewManager(w)
+//	r := region.Config{Name: "shop", Box: cube.Box(0, 60, 0, 10, 70, 10), Handler: shopHandler{}}.New()
+//	m.Add(r)
+package region
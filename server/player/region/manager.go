@@ -0,0 +1,154 @@
+package region
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"sync"
+	"time"
+)
+
+// Manager manages the Regions of a world. Every world tick, it checks which players are inside each of its
+// Regions and calls the Handler of a Region when players enter or leave it.
+// Manager is safe for concurrent usage.
+type Manager struct {
+	w *world.World
+
+	mu      sync.Mutex
+	regions []*Region
+
+	once    sync.Once
+	closing chan struct{}
+}
+
+// NewManager creates a new Manager for the world passed and starts ticking it. Manager.Close must be called
+// to stop the Manager once it is no longer used, for example when the world is closed.
+func NewManager(w *world.World) *Manager {
+	m := &Manager{w: w, closing: make(chan struct{})}
+	go m.tickLoop()
+	return m
+}
+
+// World returns the world that the Regions of the Manager are in.
+func (m *Manager) World() *world.World {
+	return m.w
+}
+
+// Add adds a Region to the Manager. Players inside the Region will be detected from the next tick onwards.
+// Add does nothing if the Region was already added.
+func (m *Manager) Add(r *Region) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.regions {
+		if existing == r {
+			return
+		}
+	}
+	m.regions = append(m.regions, r)
+}
+
+// Remove removes a Region from the Manager. HandleLeave is called for all players inside the Region.
+func (m *Manager) Remove(r *Region) {
+	m.mu.Lock()
+	removed := false
+	for i, existing := range m.regions {
+		if existing == r {
+			m.regions = append(m.regions[:i], m.regions[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	m.mu.Unlock()
+	if removed {
+		r.update(nil)
+	}
+}
+
+// Regions returns all Regions added to the Manager.
+func (m *Manager) Regions() []*Region {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*Region(nil), m.regions...)
+}
+
+// Region looks up a Region added to the Manager by its name. False is returned if no Region with the name
+// exists.
+func (m *Manager) Region(name string) (*Region, bool) {
+	for _, r := range m.Regions() {
+		if r.Name() == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// RegionsAt returns all Regions of the Manager that contain the position passed.
+func (m *Manager) RegionsAt(pos mgl64.Vec3) []*Region {
+	var regions []*Region
+	for _, r := range m.Regions() {
+		if r.Contains(pos) {
+			regions = append(regions, r)
+		}
+	}
+	return regions
+}
+
+// Interact calls the HandleInteract method of the Handlers of all Regions containing the block position passed.
+// Interact is typically called from a player.Handler, such as in HandleItemUseOnBlock, passing the
+// event.Context of that event so that Regions may cancel the interaction:
+//
+//	func (h handler) HandleItemUseOnBlock(ctx *event.Context, pos cube.Pos, face cube.Face, clickPos mgl64.Vec3) {
+//		h.regions.Interact(ctx, h.p, pos)
+//	}
+func (m *Manager) Interact(ctx *event.Context, p *player.Player, pos cube.Pos) {
+	if p.World() != m.w {
+		return
+	}
+	for _, r := range m.RegionsAt(pos.Vec3Centre()) {
+		r.Handler().HandleInteract(ctx, r, p, pos)
+	}
+}
+
+// Close stops the Manager from ticking. HandleLeave is called for all players inside any of the Regions of the
+// Manager.
+func (m *Manager) Close() error {
+	m.once.Do(func() {
+		close(m.closing)
+	})
+	return nil
+}
+
+// tickLoop ticks the Manager every world tick until it is closed.
+func (m *Manager) tickLoop() {
+	t := time.NewTicker(time.Second / 20)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			m.tick()
+		case <-m.closing:
+			for _, r := range m.Regions() {
+				r.update(nil)
+			}
+			return
+		}
+	}
+}
+
+// tick updates the players inside all Regions of the Manager. Only the chunks touched by each Region are
+// searched for players.
+func (m *Manager) tick() {
+	for _, r := range m.Regions() {
+		entities := m.w.EntitiesWithin(r.box, func(e world.Entity) bool {
+			_, ok := e.(*player.Player)
+			return !ok
+		})
+		players := make([]*player.Player, 0, len(entities))
+		for _, e := range entities {
+			players = append(players, e.(*player.Player))
+		}
+		r.update(players)
+	}
+}
@@ -0,0 +1,139 @@
+package region
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/go-gl/mathgl/mgl64"
+	"sync"
+)
+
+// Handler handles events that are called by a Region. Handler methods are called by the Manager that the
+// Region is added to, on a goroutine of the Manager.
+type Handler interface {
+	// HandleEnter handles a player entering the Region.
+	HandleEnter(r *Region, p *player.Player)
+	// HandleLeave handles a player leaving the Region. HandleLeave is also called for players inside the
+	// Region when they change worlds or disconnect, or when the Region is removed from its Manager.
+	HandleLeave(r *Region, p *player.Player)
+	// HandleInteract handles a player interacting with the block at the position passed inside the Region.
+	// HandleInteract is only called when Manager.Interact is called. ctx.Cancel() may be called to cancel the
+	// interaction, if supported by the caller of Manager.Interact.
+	HandleInteract(ctx *event.Context, r *Region, p *player.Player, pos cube.Pos)
+}
+
+// Compile time check to make sure NopHandler implements Handler.
+var _ Handler = NopHandler{}
+
+// NopHandler implements the Handler interface but does not execute any code when an event is called. The
+// default Handler of a Region is set to NopHandler. Users may embed NopHandler to avoid having to implement
+// each method.
+type NopHandler struct{}
+
+func (NopHandler) HandleEnter(*Region, *player.Player)                              {}
+func (NopHandler) HandleLeave(*Region, *player.Player)                              {}
+func (NopHandler) HandleInteract(*event.Context, *Region, *player.Player, cube.Pos) {}
+
+// Config holds the configuration of a Region. A Region may be created by calling Config.New.
+type Config struct {
+	// Name is the name of the Region, such as 'spawn' or 'shop'.
+	Name string
+	// Box is the area covered by the Region. A player is inside the Region if its position is within Box.
+	Box cube.BBox
+	// Handler is the Handler of the Region. If nil, NopHandler is used.
+	Handler Handler
+}
+
+// New creates a Region using the fields of the Config. The Region must be added to a Manager using
+// Manager.Add for it to become active.
+func (conf Config) New() *Region {
+	r := &Region{name: conf.Name, box: conf.Box, inside: make(map[*player.Player]struct{})}
+	r.Handle(conf.Handler)
+	return r
+}
+
+// Region is an axis-aligned area in a world that detects players entering, leaving and interacting with it.
+// Region is safe for concurrent usage.
+type Region struct {
+	name string
+	box  cube.BBox
+
+	mu     sync.Mutex
+	h      Handler
+	inside map[*player.Player]struct{}
+}
+
+// Name returns the name of the Region.
+func (r *Region) Name() string {
+	return r.name
+}
+
+// Box returns the area covered by the Region.
+func (r *Region) Box() cube.BBox {
+	return r.box
+}
+
+// Contains checks if the position passed is within the Region.
+func (r *Region) Contains(pos mgl64.Vec3) bool {
+	return r.box.Vec3Within(pos)
+}
+
+// Handle assigns a Handler to the Region. If nil is passed, NopHandler is used.
+func (r *Region) Handle(h Handler) {
+	if h == nil {
+		h = NopHandler{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.h = h
+}
+
+// Handler returns the Handler currently assigned to the Region.
+func (r *Region) Handler() Handler {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.h
+}
+
+// Players returns all players currently inside the Region.
+func (r *Region) Players() []*player.Player {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	players := make([]*player.Player, 0, len(r.inside))
+	for p := range r.inside {
+		players = append(players, p)
+	}
+	return players
+}
+
+// update updates the players inside the Region to the players passed, calling HandleEnter and HandleLeave for
+// players that entered or left the Region respectively.
+func (r *Region) update(players []*player.Player) {
+	current := make(map[*player.Player]struct{}, len(players))
+	for _, p := range players {
+		current[p] = struct{}{}
+	}
+
+	r.mu.Lock()
+	var entered, left []*player.Player
+	for p := range current {
+		if _, ok := r.inside[p]; !ok {
+			entered = append(entered, p)
+		}
+	}
+	for p := range r.inside {
+		if _, ok := current[p]; !ok {
+			left = append(left, p)
+		}
+	}
+	r.inside = current
+	h := r.h
+	r.mu.Unlock()
+
+	for _, p := range left {
+		h.HandleLeave(r, p)
+	}
+	for _, p := range entered {
+		h.HandleEnter(r, p)
+	}
+}
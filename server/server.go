@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/internal/blockinternal"
 	"github.com/df-mc/dragonfly/server/internal/iteminternal"
 	"github.com/df-mc/dragonfly/server/internal/sliceutil"
 	_ "github.com/df-mc/dragonfly/server/item" // Imported for maintaining correct initialisation order.
 	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/player/chat"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/session"
 	"github.com/df-mc/dragonfly/server/world"
@@ -34,6 +36,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 )
 
 // Server implements a Dragonfly server. It runs the main server loop and
@@ -49,6 +52,10 @@ type Server struct {
 	customBlocks []protocol.BlockEntry
 	customItems  []protocol.ItemComponentEntry
 
+	ops         *operators
+	cmds        map[string]cmd.Command
+	maintenance *maintenance
+
 	listeners []Listener
 	incoming  chan *session.Session
 
@@ -56,6 +63,10 @@ type Server struct {
 	// p holds a map of all players currently connected to the server. When they
 	// leave, they are removed from the map.
 	p map[uuid.UUID]*player.Player
+	// detached holds the players in p that lost their connection and may be
+	// resumed by a new connection, together with the timer that closes the
+	// player once the ResumeTimeout passes.
+	detached map[uuid.UUID]*time.Timer
 	// pwg is a sync.WaitGroup used to wait for all players to be disconnected
 	// before server shutdown, so that their data is saved properly.
 	pwg sync.WaitGroup
@@ -111,6 +122,12 @@ func (srv *Server) Accept(f HandleFunc) bool {
 	srv.pmu.Unlock()
 
 	s.Start()
+	if msg := s.JoinMessage(); msg != "" {
+		ctx := event.C()
+		if p.Handler().HandleJoinMessage(ctx, &msg); !ctx.Cancelled() {
+			_, _ = fmt.Fprintln(chat.Global, msg)
+		}
+	}
 	return true
 }
 
@@ -211,7 +228,10 @@ func (srv *Server) close() {
 
 	srv.conf.Log.Debugf("Disconnecting players...")
 	for _, p := range srv.Players() {
-		p.Disconnect(text.Colourf("<yellow>%v</yellow>", srv.conf.ShutdownMessage))
+		p.Disconnect(session.Disconnection{
+			Reason:  session.DisconnectReasonShutdown,
+			Message: chat.Translation{Fallback: "%v", Params: []string{text.Colourf("<yellow>%v</yellow>", srv.conf.ShutdownMessage)}},
+		})
 	}
 	srv.pwg.Wait()
 
@@ -260,7 +280,7 @@ func (srv *Server) listen(l Listener) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if msg, ok := srv.conf.Allower.Allow(c.RemoteAddr(), c.IdentityData(), c.ClientData()); !ok {
+			if msg, ok := srv.admit(c); !ok {
 				_ = c.WritePacket(&packet.Disconnect{HideDisconnectionScreen: msg == "", Message: msg})
 				_ = c.Close()
 				return
@@ -270,6 +290,37 @@ func (srv *Server) listen(l Listener) {
 	}
 }
 
+// admit checks if the connection passed may join the server. If not, false is
+// returned together with the disconnect message the connection should be
+// refused with. An empty message means no disconnection screen is shown.
+// Connections are refused if they are not authenticated while authentication
+// is enabled, if the server is full, if the Allower of the server does not
+// allow them or if the server is in maintenance mode. Players resuming a
+// detached player are not refused because the server is full, as they are
+// still counted as online.
+func (srv *Server) admit(c session.Conn) (string, bool) {
+	id := c.IdentityData()
+	if !srv.conf.AuthDisabled && id.XUID == "" {
+		// Listeners other than the standard one, such as those of proxies,
+		// may not authenticate connections themselves.
+		return session.Disconnection{Reason: session.DisconnectReasonNotAuthenticated}.String(), false
+	}
+	resuming := false
+	if uid, err := uuid.Parse(id.Identity); err == nil {
+		_, resuming = srv.detachedPlayer(uid)
+	}
+	if !resuming && srv.conf.MaxPlayers != 0 && len(srv.Players()) >= srv.conf.MaxPlayers {
+		return session.Disconnection{Reason: session.DisconnectReasonServerFull}.String(), false
+	}
+	if msg, ok := srv.conf.Allower.Allow(c.RemoteAddr(), id, c.ClientData()); !ok {
+		return msg, false
+	}
+	if srv.Maintenance() && !srv.MaintenanceExempt(id.DisplayName, id.XUID) {
+		return srv.maintenanceDisconnection().String(), false
+	}
+	return "", true
+}
+
 // startListening starts making the EncodeBlock listener listen, accepting new
 // connections from players.
 func (srv *Server) startListening() {
@@ -331,7 +382,15 @@ func (srv *Server) finaliseConn(ctx context.Context, conn session.Conn, l Listen
 	id := uuid.MustParse(conn.IdentityData().Identity)
 	data := srv.defaultGameData()
 
-	var playerData *player.Data
+	if p, ok := srv.detachedPlayer(id); ok {
+		srv.resumeConn(ctx, conn, l, p)
+		return
+	}
+
+	var (
+		playerData *player.Data
+		spawn      mgl64.Vec3
+	)
 	if d, err := srv.conf.PlayerProvider.Load(id, srv.dimension); err == nil {
 		if d.World == nil {
 			d.World = srv.world
@@ -342,19 +401,109 @@ func (srv *Server) finaliseConn(ctx context.Context, conn session.Conn, l Listen
 		data.Yaw, data.Pitch = float32(d.Yaw), float32(d.Pitch)
 
 		playerData = &d
+	} else {
+		// The player joins for the first time, so we select a spawn position
+		// within the spawn radius of the world.
+		spawn = srv.world.PlayerSpawn(id).Vec3Middle()
+		data.PlayerPosition = vec64To32(spawn).Add(mgl32.Vec3{0, 1.62})
 	}
 
+	w := srv.world
+	if playerData != nil {
+		w = playerData.World
+	}
+	if pk, ok := session.DimensionData(w); ok {
+		_ = conn.WritePacket(pk)
+	}
 	if err := conn.StartGameContext(ctx, data); err != nil {
-		_ = l.Disconnect(conn, "Connection timeout.")
+		_ = l.Disconnect(conn, session.Disconnection{Reason: session.DisconnectReasonTimeout}.String())
 
 		srv.conf.Log.Debugf("connection %v failed spawning: %v\n", conn.RemoteAddr(), err)
 		return
 	}
 	_ = conn.WritePacket(&packet.ItemComponent{Items: srv.customItems})
 	if p, ok := srv.Player(id); ok {
-		p.Disconnect("Logged in from another location.")
+		p.Disconnect(session.Disconnection{Reason: session.DisconnectReasonLoggedInElsewhere})
+	}
+	srv.incoming <- srv.createPlayer(id, conn, spawn, playerData)
+}
+
+// resumeConn finalises the session.Conn passed by giving it control of the
+// detached player passed. If the player was closed before the connection
+// finished spawning, the connection is disconnected.
+func (srv *Server) resumeConn(ctx context.Context, conn session.Conn, l Listener, p *player.Player) {
+	data := srv.defaultGameData()
+	data.PlayerPosition = vec64To32(p.Position()).Add(mgl32.Vec3{0, 1.62})
+	dim, _ := world.DimensionID(p.World().Dimension())
+	data.Dimension = int32(dim)
+	yaw, pitch := p.Rotation().Elem()
+	data.Yaw, data.Pitch = float32(yaw), float32(pitch)
+
+	if pk, ok := session.DimensionData(p.World()); ok {
+		_ = conn.WritePacket(pk)
+	}
+	if err := conn.StartGameContext(ctx, data); err != nil {
+		_ = l.Disconnect(conn, session.Disconnection{Reason: session.DisconnectReasonTimeout}.String())
+
+		srv.conf.Log.Debugf("connection %v failed spawning: %v\n", conn.RemoteAddr(), err)
+		return
+	}
+	_ = conn.WritePacket(&packet.ItemComponent{Items: srv.customItems})
+
+	srv.pmu.Lock()
+	t, ok := srv.detached[p.UUID()]
+	if ok && !t.Stop() {
+		// The ResumeTimeout passed while the connection was spawning, so the
+		// player is already being closed.
+		ok = false
+	}
+	delete(srv.detached, p.UUID())
+	srv.pmu.Unlock()
+	if !ok {
+		_ = l.Disconnect(conn, session.Disconnection{
+			Reason:  session.DisconnectReasonTimeout,
+			Message: chat.Translation{Fallback: "Your previous session has expired, please reconnect."},
+		}.String())
+		return
+	}
+
+	s := srv.sessionConfig().New(conn)
+	p.Resume(s)
+	s.Spawn(p, p.Position(), p.World(), p.GameMode(), srv.handleSessionClose)
+	s.Start()
+	srv.conf.Log.Debugf("Resumed session of %v (%v).", p.Name(), conn.RemoteAddr())
+}
+
+// detachedPlayer returns the player with the UUID passed if it lost its
+// connection and may still be resumed.
+func (srv *Server) detachedPlayer(id uuid.UUID) (*player.Player, bool) {
+	srv.pmu.RLock()
+	defer srv.pmu.RUnlock()
+	if _, ok := srv.detached[id]; !ok {
+		return nil, false
+	}
+	p, ok := srv.p[id]
+	return p, ok
+}
+
+// detach handles the connection of a session being lost. The player of the
+// session is kept in its world for the ResumeTimeout, after which it is
+// closed if no new connection resumed it.
+func (srv *Server) detach(c session.Controllable) bool {
+	srv.pmu.Lock()
+	defer srv.pmu.Unlock()
+	p, ok := srv.p[c.UUID()]
+	if !ok {
+		return false
 	}
-	srv.incoming <- srv.createPlayer(id, conn, playerData)
+	srv.detached[p.UUID()] = time.AfterFunc(srv.conf.ResumeTimeout, func() {
+		srv.pmu.Lock()
+		delete(srv.detached, p.UUID())
+		srv.pmu.Unlock()
+		_ = p.Close()
+	})
+	srv.conf.Log.Debugf("Lost connection of %v, keeping player for %v.", p.Name(), srv.conf.ResumeTimeout)
+	return true
 }
 
 // defaultGameData returns a minecraft.GameData as sent for a new player. It
@@ -381,13 +530,24 @@ func (srv *Server) defaultGameData() minecraft.GameData {
 		GameRules:    []protocol.GameRule{{Name: "naturalregeneration", Value: false}},
 
 		ServerAuthoritativeInventory: true,
-		PlayerMovementSettings: protocol.PlayerMovementSettings{
-			MovementType:                     protocol.PlayerMovementModeServer,
-			ServerAuthoritativeBlockBreaking: true,
-		},
+		PlayerMovementSettings:       srv.movementSettings(),
 	}
 }
 
+// movementSettings returns the protocol.PlayerMovementSettings sent to players
+// in the StartGame packet.
+func (srv *Server) movementSettings() protocol.PlayerMovementSettings {
+	settings := protocol.PlayerMovementSettings{
+		MovementType:                     protocol.PlayerMovementModeServer,
+		ServerAuthoritativeBlockBreaking: true,
+	}
+	if srv.conf.MovementRewindHistory > 0 {
+		settings.MovementType = protocol.PlayerMovementModeServerWithRewind
+		settings.RewindHistorySize = int32(srv.conf.MovementRewindHistory)
+	}
+	return settings
+}
+
 // dimension returns a world by a dimension passed.
 func (srv *Server) dimension(dimension world.Dimension) *world.World {
 	switch dimension {
@@ -422,6 +582,10 @@ func (srv *Server) handleSessionClose(c session.Controllable) {
 	srv.pmu.Lock()
 	p, ok := srv.p[c.UUID()]
 	delete(srv.p, c.UUID())
+	if t, detached := srv.detached[c.UUID()]; detached {
+		t.Stop()
+		delete(srv.detached, c.UUID())
+	}
 	srv.pmu.Unlock()
 	if !ok {
 		// When a player disconnects immediately after a session is started, it might not be added to the players map
@@ -436,13 +600,14 @@ func (srv *Server) handleSessionClose(c session.Controllable) {
 }
 
 // createPlayer creates a new player instance using the UUID and connection
-// passed.
-func (srv *Server) createPlayer(id uuid.UUID, conn session.Conn, data *player.Data) *session.Session {
-	w, gm, pos := srv.world, srv.world.DefaultGameMode(), srv.world.Spawn().Vec3Middle()
+// passed. The player is spawned at the spawn position passed if it does not
+// have any data stored yet.
+func (srv *Server) createPlayer(id uuid.UUID, conn session.Conn, spawn mgl64.Vec3, data *player.Data) *session.Session {
+	w, gm, pos := srv.world, srv.world.DefaultGameMode(), spawn
 	if data != nil {
 		w, gm, pos = data.World, data.GameMode, data.Position
 	}
-	s := session.New(conn, srv.conf.MaxChunkRadius, srv.conf.Log, srv.conf.JoinMessage, srv.conf.QuitMessage)
+	s := srv.sessionConfig().New(conn)
 	p := player.NewWithSession(conn.IdentityData().DisplayName, conn.IdentityData().XUID, id, srv.parseSkin(conn.ClientData()), s, pos, data)
 
 	s.Spawn(p, pos, w, gm, srv.handleSessionClose)
@@ -450,6 +615,23 @@ func (srv *Server) createPlayer(id uuid.UUID, conn session.Conn, data *player.Da
 	return s
 }
 
+// sessionConfig returns the session.Config used to create the sessions of
+// players joining the server.
+func (srv *Server) sessionConfig() session.Config {
+	conf := session.Config{
+		Log:            srv.conf.Log,
+		MaxChunkRadius: srv.conf.MaxChunkRadius,
+		JoinMessage:    srv.conf.JoinMessage,
+		QuitMessage:    srv.conf.QuitMessage,
+		MovementRewind: srv.conf.MovementRewindHistory > 0,
+		Commands:       srv.cmds,
+	}
+	if srv.conf.ResumeTimeout > 0 {
+		conf.Detach = srv.detach
+	}
+	return conf
+}
+
 // createWorld loads a world of the server with a specific dimension, ending
 // the program if the world could not be loaded. The layers passed are used to
 // create a generator.Flat that is used as generator for the world.
@@ -466,13 +648,20 @@ func (srv *Server) createWorld(dim world.Dimension, nether, end **world.World) *
 	logger.Debugf("Loading world...")
 
 	conf := world.Config{
-		Log:             logger,
-		Dim:             dim,
-		Provider:        srv.conf.WorldProvider,
-		Generator:       srv.conf.Generator(dim),
-		RandomTickSpeed: srv.conf.RandomTickSpeed,
-		ReadOnly:        srv.conf.ReadOnlyWorld,
-		Entities:        srv.conf.Entities,
+		Log:              logger,
+		Dim:              dim,
+		Provider:         srv.conf.WorldProvider,
+		Generator:        srv.conf.Generator(dim),
+		RandomTickSpeed:  srv.conf.RandomTickSpeed,
+		EntityTickBudget: srv.conf.EntityTickBudget,
+		Watchdog:         srv.conf.Watchdog,
+		Despawn:          srv.conf.Despawn,
+		EntityCaps:       srv.conf.EntityCaps,
+		Griefing:         srv.conf.Griefing,
+		HorizontalBound:  srv.conf.HorizontalBound,
+		Range:            srv.conf.WorldRange(dim),
+		ReadOnly:         srv.conf.ReadOnlyWorld,
+		Entities:         srv.conf.Entities,
 		PortalDestination: func(dim world.Dimension) *world.World {
 			if dim == world.Nether {
 				return *nether
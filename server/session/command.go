@@ -5,6 +5,7 @@ import (
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"maps"
 	"math"
 )
 
@@ -36,10 +37,27 @@ func (s *Session) SendCommandOutput(output *cmd.Output) {
 	})
 }
 
+// Command looks up a command available to the Session by an alias. Commands passed to the Session using
+// Config.Commands take precedence over commands registered using cmd.Register.
+func (s *Session) Command(alias string) (cmd.Command, bool) {
+	if c, ok := s.commands[alias]; ok {
+		return c, true
+	}
+	return cmd.ByAlias(alias)
+}
+
+// availableCommands returns all commands available to the Session, indexed by the alias they are available
+// with.
+func (s *Session) availableCommands() map[string]cmd.Command {
+	commands := cmd.Commands()
+	maps.Copy(commands, s.commands)
+	return commands
+}
+
 // sendAvailableCommands sends all available commands of the server. Once sent, they will be visible in the
 // /help list and will be auto-completed.
 func (s *Session) sendAvailableCommands() map[string]map[int]cmd.Runnable {
-	commands := cmd.Commands()
+	commands := s.availableCommands()
 	m := make(map[string]map[int]cmd.Runnable, len(commands))
 
 	pk := &packet.AvailableCommands{}
@@ -184,6 +202,13 @@ func valueToParamType(i cmd.ParamInfo, source cmd.Source) (t uint32, enum comman
 			Options: []string{i.Name},
 		}
 	}
+	if c, ok := i.Value.(cmd.Completer); ok {
+		return 0, commandEnum{
+			Type:    c.Type(),
+			Options: c.Completions(source),
+			Dynamic: true,
+		}
+	}
 	if enum, ok := i.Value.(cmd.Enum); ok {
 		return 0, commandEnum{
 			Type:    enum.Type(),
@@ -198,7 +223,7 @@ func valueToParamType(i cmd.ParamInfo, source cmd.Source) (t uint32, enum comman
 // match with the commands that the Session is currently allowed to execute.
 // True is returned if the commands were resent.
 func (s *Session) resendCommands(before map[string]map[int]cmd.Runnable) (map[string]map[int]cmd.Runnable, bool) {
-	commands := cmd.Commands()
+	commands := s.availableCommands()
 	m := make(map[string]map[int]cmd.Runnable, len(commands))
 
 	for alias, c := range commands {
@@ -230,15 +255,19 @@ func (s *Session) resendCommands(before map[string]map[int]cmd.Runnable) (map[st
 	return m, false
 }
 
-// enums returns a map of all enums exposed to the Session and records the values those enums currently hold.
-func (s *Session) enums() (map[string]cmd.Enum, map[string][]string) {
-	enums, enumValues := make(map[string]cmd.Enum), make(map[string][]string)
-	for alias, c := range cmd.Commands() {
+// enums returns a map of functions returning the options of all enums exposed to the Session, including those
+// of parameters implementing cmd.Completer, and records the values those enums currently hold.
+func (s *Session) enums() (map[string]func(cmd.Source) []string, map[string][]string) {
+	enums, enumValues := make(map[string]func(cmd.Source) []string), make(map[string][]string)
+	for alias, c := range s.availableCommands() {
 		if c.Name() == alias {
 			for _, params := range c.Params(s.c) {
 				for _, paramInfo := range params {
-					if enum, ok := paramInfo.Value.(cmd.Enum); ok {
-						enums[enum.Type()] = enum
+					if completer, ok := paramInfo.Value.(cmd.Completer); ok {
+						enums[completer.Type()] = completer.Completions
+						enumValues[completer.Type()] = completer.Completions(s.c)
+					} else if enum, ok := paramInfo.Value.(cmd.Enum); ok {
+						enums[enum.Type()] = enum.Options
 						enumValues[enum.Type()] = enum.Options(s.c)
 					}
 				}
@@ -250,10 +279,10 @@ func (s *Session) enums() (map[string]cmd.Enum, map[string][]string) {
 
 // resendEnums checks the options of the enums passed against the values that were previously recorded. If they do not
 // match, the enum is resent to the client and the values are updated in the before map.
-func (s *Session) resendEnums(enums map[string]cmd.Enum, before map[string][]string) {
-	for name, enum := range enums {
+func (s *Session) resendEnums(enums map[string]func(cmd.Source) []string, before map[string][]string) {
+	for name, options := range enums {
 		valuesBefore := before[name]
-		values := enum.Options(s.c)
+		values := options(s.c)
 		before[name] = values
 
 		if len(valuesBefore) != len(values) {
@@ -7,6 +7,7 @@ import (
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/player/device"
 	"github.com/df-mc/dragonfly/server/player/form"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/world"
@@ -29,6 +30,7 @@ type Controllable interface {
 	Locale() language.Tag
 
 	SetHeldItems(right, left item.Stack)
+	SwapHeldItems()
 
 	Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64)
 	Speed() float64
@@ -52,6 +54,7 @@ type Controllable interface {
 
 	ExperienceLevel() int
 	SetExperienceLevel(level int)
+	SpendExperienceLevels(levels int, src world.LevelCostSource) bool
 
 	EnchantmentSeed() int64
 	ResetEnchantmentSeed()
@@ -59,6 +62,8 @@ type Controllable interface {
 	Respawn()
 	Dead() bool
 
+	FinishCredits()
+
 	StartSneaking()
 	Sneaking() bool
 	StopSneaking()
@@ -71,17 +76,22 @@ type Controllable interface {
 	StartFlying()
 	Flying() bool
 	StopFlying()
+	FlightAllowed() bool
+	HorizontalFlightSpeed() float64
 	StartGliding()
 	Gliding() bool
 	StopGliding()
 	Jump()
+	Wake()
 
 	StartBreaking(pos cube.Pos, face cube.Face)
 	ContinueBreaking(face cube.Face)
 	FinishBreaking()
 	AbortBreaking()
 
-	Exhaust(points float64)
+	Exhaust(points float64, src world.ExhaustionSource)
+
+	CloseBlockContainer()
 
 	OpenSign(pos cube.Pos, frontSide bool)
 	EditSign(pos cube.Pos, frontText, backText string) error
@@ -99,4 +109,14 @@ type Controllable interface {
 	// entity looks in the world.
 	Skin() skin.Skin
 	SetSkin(skin.Skin)
+
+	// InputMode returns the device.InputMode that the controllable currently uses to play.
+	InputMode() device.InputMode
+	// UpdateInputMode updates the device.InputMode of the controllable after the client switched to a different
+	// input mode.
+	UpdateInputMode(mode device.InputMode)
+	// ClientSettings returns the device.Settings of the client of the controllable.
+	ClientSettings() device.Settings
+	// UpdateClientSettings updates the device.Settings of the controllable after the client changed its settings.
+	UpdateClientSettings(settings device.Settings)
 }
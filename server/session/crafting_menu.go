@@ -0,0 +1,195 @@
+package session
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"slices"
+)
+
+// craftingMenu is an inventory.CraftingMenu opened by a Session. It is displayed to the client as the UI of a
+// crafting table, using the crafting grid in the UI inventory. Because the client only shows the result of
+// recipes it knows, the result of the grid is sent to the client as a recipe matching exactly the items in the
+// grid every time the result changes.
+type craftingMenu struct {
+	inventory.CraftingMenu
+	// recipeID is the network ID of the recipe last sent for the result of the grid. It is 0 if the grid has
+	// no result.
+	recipeID uint32
+	// output and input are the output and the input of the recipe last sent.
+	output item.Stack
+	input  []item.Stack
+	// sentRecipes is true if any recipes were sent for the menu, meaning the recipes of the client must be
+	// reset once the menu is closed.
+	sentRecipes bool
+}
+
+// gridSlot returns the slot of the UI inventory that holds the item in column x and row y of the grid.
+func (m *craftingMenu) gridSlot(x, y int) int {
+	return craftingGridLargeOffset + y*3 + x
+}
+
+// gridSlots returns the slots of the UI inventory that make up the grid, ordered from left to right and from top
+// to bottom.
+func (m *craftingMenu) gridSlots() []int {
+	width, height := m.Size()
+	slots := make([]int, 0, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			slots = append(slots, m.gridSlot(x, y))
+		}
+	}
+	return slots
+}
+
+// grid returns the inventory.CraftingGrid holding the items currently placed in the grid of the UI inventory
+// passed.
+func (m *craftingMenu) grid(ui *inventory.Inventory) inventory.CraftingGrid {
+	width, height := m.Size()
+	slots := m.gridSlots()
+	items := make([]item.Stack, len(slots))
+	for i, slot := range slots {
+		items[i], _ = ui.Item(slot)
+	}
+	return inventory.NewCraftingGrid(width, height, items)
+}
+
+// usable checks if the slot of the crafting grid passed is part of the grid of the menu. Menus with a grid
+// smaller than 3x3 only use the top left part of the crafting grid.
+func (m *craftingMenu) usable(slot int) bool {
+	for _, s := range m.gridSlots() {
+		if s == slot {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenCraftingMenu opens the inventory.CraftingMenu passed for the Controllable of the Session. Any block
+// container or trading UI currently opened is closed.
+func (s *Session) OpenCraftingMenu(m inventory.CraftingMenu) {
+	if s == Nop {
+		return
+	}
+	s.c.CloseBlockContainer()
+	s.closeMerchant()
+	s.closeCraftingMenu()
+
+	nextID := s.nextWindowID()
+	s.craftingMenu.Store(&craftingMenu{CraftingMenu: m})
+	s.openedContainerID.Store(uint32(protocol.ContainerTypeWorkbench))
+
+	pos := cube.PosFromVec3(s.c.Position())
+	s.writePacket(&packet.ContainerOpen{
+		WindowID:                nextID,
+		ContainerType:           protocol.ContainerTypeWorkbench,
+		ContainerPosition:       protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])},
+		ContainerEntityUniqueID: -1,
+	})
+}
+
+// OpenedCraftingMenu returns the inventory.CraftingMenu currently opened by the Controllable of the Session.
+// False is returned if no crafting menu is opened.
+func (s *Session) OpenedCraftingMenu() (inventory.CraftingMenu, bool) {
+	if s == Nop {
+		return inventory.CraftingMenu{}, false
+	}
+	if m := s.craftingMenu.Load(); m != nil {
+		return m.CraftingMenu, true
+	}
+	return inventory.CraftingMenu{}, false
+}
+
+// CloseCraftingMenu closes the crafting menu currently opened by the Controllable of the Session, if any.
+func (s *Session) CloseCraftingMenu() {
+	if s == Nop {
+		return
+	}
+	s.closeCraftingMenu()
+}
+
+// closeCraftingMenu closes the crafting menu currently opened. The items left in its grid are added back to
+// the inventory of the Controllable, or dropped if they do not fit. If no crafting menu is open,
+// closeCraftingMenu does nothing.
+func (s *Session) closeCraftingMenu() {
+	m := s.craftingMenu.Swap(nil)
+	if m == nil {
+		return
+	}
+	s.openedContainerID.Store(0)
+	s.EmptyUIInventory()
+	s.writePacket(&packet.ContainerClose{WindowID: byte(s.openedWindowID.Load())})
+	if m.sentRecipes {
+		// Remove the recipes sent for the results of the menu from the client again, so that their network IDs
+		// may be used again by the next menu.
+		s.sendRecipes()
+		s.menuRecipes.Store(0)
+	}
+}
+
+// craftMenu consumes the items in the grid of the crafting menu passed when the client crafts the recipe with
+// the network ID passed. The result is computed again, so that it is validated against the current contents of
+// the grid, and all items consumed are removed from the grid at once.
+func (h *ItemStackRequestHandler) craftMenu(id uint32, m *craftingMenu, s *Session) error {
+	if id != m.recipeID {
+		return fmt.Errorf("recipe with network id %v is not the result of the crafting menu", id)
+	}
+	output, consumed := m.Craft(s.c, m.grid(s.ui))
+	if output.Empty() || !output.Equal(m.output) {
+		return fmt.Errorf("crafting menu result %v does not match the result of the grid %v", m.output, output)
+	}
+	for i, slot := range m.gridSlots() {
+		if consumed[i] == 0 {
+			continue
+		}
+		info := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerCraftingInput, Slot: byte(slot)}
+		it, _ := h.itemInSlot(info, s)
+		h.setItemInSlot(info, it.Grow(-consumed[i]), s)
+	}
+	return h.createResults(s, output)
+}
+
+// updateCraftingMenu computes the result of the grid of the crafting menu opened by the Session, if any, after a
+// request was handled. If the result changed, a recipe matching the items in the grid with the new result is
+// sent to the client, so that the result is shown in the crafting table UI.
+func (h *ItemStackRequestHandler) updateCraftingMenu(s *Session) {
+	m := s.craftingMenu.Load()
+	if m == nil {
+		return
+	}
+	grid := m.grid(s.ui)
+	output, consumed := m.Craft(s.c, grid)
+	input := make([]item.Stack, len(grid.Items()))
+	if !output.Empty() {
+		for i, it := range grid.Items() {
+			if !it.Empty() {
+				// The client consumes the count of every ingredient of the recipe, so the count is set to the
+				// amount of items the result consumes from the slot.
+				input[i] = it.Grow(max(consumed[i], 1) - it.Count())
+			}
+		}
+	}
+	if output.Equal(m.output) && slices.EqualFunc(input, m.input, item.Stack.Equal) {
+		return
+	}
+	m.output, m.input, m.recipeID = output, input, 0
+	if output.Empty() {
+		return
+	}
+	width, height := m.Size()
+	m.recipeID = uint32(len(s.recipes)) + 1 + s.menuRecipes.Inc()
+	m.sentRecipes = true
+	s.writePacket(&packet.CraftingData{Recipes: []protocol.Recipe{&protocol.ShapedRecipe{
+		RecipeID:        uuid.New().String(),
+		Width:           int32(width),
+		Height:          int32(height),
+		Input:           stacksToIngredientItems(input),
+		Output:          stacksToRecipeStacks([]item.Stack{output}),
+		Block:           "crafting_table",
+		RecipeNetworkID: m.recipeID,
+	}}})
+}
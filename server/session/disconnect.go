@@ -0,0 +1,89 @@
+package session
+
+import (
+	"github.com/df-mc/dragonfly/server/player/chat"
+)
+
+// DisconnectReason is the reason a client is disconnected for. Every DisconnectReason has a default message
+// that is shown on the disconnection screen of the client.
+type DisconnectReason uint8
+
+const (
+	// DisconnectReasonUnknown is used if no specific reason applies.
+	DisconnectReasonUnknown DisconnectReason = iota
+	// DisconnectReasonKicked is used if a client is kicked, for example by an operator.
+	DisconnectReasonKicked
+	// DisconnectReasonBanned is used if a client is banned from the server.
+	DisconnectReasonBanned
+	// DisconnectReasonNotWhitelisted is used if a client is refused because it is not on the whitelist.
+	DisconnectReasonNotWhitelisted
+	// DisconnectReasonServerFull is used if a client is refused because the server is full.
+	DisconnectReasonServerFull
+	// DisconnectReasonNotAuthenticated is used if a client failed to authenticate with XBOX Live.
+	DisconnectReasonNotAuthenticated
+	// DisconnectReasonLoggedInElsewhere is used if the same account joined the server from a different client.
+	DisconnectReasonLoggedInElsewhere
+	// DisconnectReasonTimeout is used if the connection of a client timed out or its session expired.
+	DisconnectReasonTimeout
+	// DisconnectReasonShutdown is used if the server is shutting down.
+	DisconnectReasonShutdown
+	// DisconnectReasonMaintenance is used if a client is refused or kicked because the server is in maintenance
+	// mode.
+	DisconnectReasonMaintenance
+)
+
+// Message returns the default message of the DisconnectReason. If it has a translation key, the client shows
+// the message in its own language.
+func (r DisconnectReason) Message() chat.Translation {
+	switch r {
+	case DisconnectReasonKicked:
+		return chat.Translation{Fallback: "Kicked from the server."}
+	case DisconnectReasonBanned:
+		return chat.Translation{Fallback: "You are banned from this server."}
+	case DisconnectReasonNotWhitelisted:
+		return chat.Translation{Key: "disconnectionScreen.notAllowed", Fallback: "You are not invited to play on this server."}
+	case DisconnectReasonServerFull:
+		return chat.Translation{Key: "disconnectionScreen.serverFull", Fallback: "Wow this server is popular! Check back later to see if space opens up."}
+	case DisconnectReasonNotAuthenticated:
+		return chat.Translation{Key: "disconnectionScreen.notAuthenticated", Fallback: "You need to authenticate to Microsoft services."}
+	case DisconnectReasonLoggedInElsewhere:
+		return chat.Translation{Key: "disconnectionScreen.loggedinOtherLocation", Fallback: "Logged in from other location."}
+	case DisconnectReasonTimeout:
+		return chat.Translation{Fallback: "Connection timeout."}
+	case DisconnectReasonShutdown:
+		return chat.Translation{Fallback: "Server closed."}
+	case DisconnectReasonMaintenance:
+		return chat.Translation{Fallback: "The server is under maintenance. Please try again later."}
+	}
+	return chat.Translation{Fallback: "Disconnected from server."}
+}
+
+// Disconnection describes why a client is disconnected. It implements fmt.Stringer, so that it may be passed
+// to player.Player.Disconnect, or returned by an Allower of a server by calling String, to show a consistent
+// disconnection screen to the client.
+type Disconnection struct {
+	// Reason is the reason the client is disconnected for.
+	Reason DisconnectReason
+	// Message is the message shown to the client. If left empty, the default message of the Reason is used.
+	Message chat.Translation
+	// Details are optional details shown below the message, such as the reason a player was kicked or banned
+	// for, or how long a ban lasts.
+	Details string
+}
+
+// String returns the message shown on the disconnection screen of the client. If the message has a
+// translation key, has no parameters and no Details are set, only the key is returned, so that the client
+// translates it into its own language.
+func (d Disconnection) String() string {
+	msg := d.Message
+	if msg.Key == "" && msg.Fallback == "" {
+		msg = d.Reason.Message()
+	}
+	if msg.Key != "" && len(msg.Params) == 0 && d.Details == "" {
+		return msg.Key
+	}
+	if d.Details == "" {
+		return msg.String()
+	}
+	return msg.String() + "\n" + d.Details
+}
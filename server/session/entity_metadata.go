@@ -1,6 +1,7 @@
 package session
 
 import (
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
@@ -53,6 +54,13 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 	if gl, ok := e.(glider); ok && gl.Gliding() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagGliding)
 	}
+	if sl, ok := e.(sleeper); ok {
+		if pos, sleeping := sl.Sleeping(); sleeping {
+			m[protocol.EntityDataKeyBedPosition] = protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSleeping)
+			m.SetFlag(protocol.EntityDataKeyPlayerFlags, playerFlagSleeping)
+		}
+	}
 	if b, ok := e.(breather); ok {
 		m[protocol.EntityDataKeyAirSupply] = int16(b.AirSupply().Milliseconds() / 50)
 		m[protocol.EntityDataKeyAirSupplyMax] = int16(b.MaxAirSupply().Milliseconds() / 50)
@@ -60,6 +68,9 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagBreathing)
 		}
 	}
+	if f, ok := e.(freezing); ok {
+		m[protocol.EntityDataKeyFreezingEffectStrength] = float32(f.FreezeProgress())
+	}
 	if i, ok := e.(invisible); ok && i.Invisible() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagInvisible)
 	}
@@ -69,6 +80,9 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 	if o, ok := e.(onFire); ok && o.OnFireDuration() > 0 {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagOnFire)
 	}
+	if b, ok := e.(blocker); ok && b.Blocking() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagBlocking)
+	}
 	if u, ok := e.(using); ok && u.UsingItem() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagUsingItem)
 	}
@@ -91,7 +105,7 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 		if o, ok := e.(owned); ok && f.Attached() {
 			m[protocol.EntityDataKeyCustomDisplay] = int64(s.entityRuntimeID(o.Owner()))
 		}
-	} else if o, ok := e.(owned); ok {
+	} else if o, ok := e.(owned); ok && o.Owner() != nil {
 		m[protocol.EntityDataKeyOwner] = int64(s.entityRuntimeID(o.Owner()))
 	}
 	if sc, ok := e.(scaled); ok {
@@ -101,7 +115,9 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 		m[protocol.EntityDataKeyFuseTime] = int32(t.Fuse().Milliseconds() / 50)
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagIgnited)
 	}
-	if n, ok := e.(named); ok {
+	if v, ok := e.(nameTagVisible); ok && !v.NameTagVisible() {
+		m[protocol.EntityDataKeyName] = ""
+	} else if n, ok := e.(named); ok {
 		m[protocol.EntityDataKeyName] = n.NameTag()
 		m[protocol.EntityDataKeyAlwaysShowNameTag] = uint8(1)
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagAlwaysShowName)
@@ -182,12 +198,23 @@ type glider interface {
 	Gliding() bool
 }
 
+// playerFlagSleeping is the index of the flag in the player flags of an entity that is set while it is sleeping.
+const playerFlagSleeping = 1
+
+type sleeper interface {
+	Sleeping() (cube.Pos, bool)
+}
+
 type breather interface {
 	Breathing() bool
 	AirSupply() time.Duration
 	MaxAirSupply() time.Duration
 }
 
+type freezing interface {
+	FreezeProgress() float64
+}
+
 type immobile interface {
 	Immobile() bool
 }
@@ -208,6 +235,10 @@ type named interface {
 	NameTag() string
 }
 
+type nameTagVisible interface {
+	NameTagVisible() bool
+}
+
 type scoreTag interface {
 	ScoreTag() string
 }
@@ -237,6 +268,10 @@ type using interface {
 	UsingItem() bool
 }
 
+type blocker interface {
+	Blocking() bool
+}
+
 type arrow interface {
 	Critical() bool
 }
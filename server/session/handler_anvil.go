@@ -30,7 +30,7 @@ func (h *ItemStackRequestHandler) handleCraftRecipeOptional(a *protocol.CraftRec
 	if !ok {
 		return fmt.Errorf("no anvil container opened")
 	}
-	if len(filterStrings) < int(a.FilterStringIndex) {
+	if len(filterStrings) > 0 && int(a.FilterStringIndex) >= len(filterStrings) {
 		return fmt.Errorf("filter string index %v is out of bounds", a.FilterStringIndex)
 	}
 
@@ -69,7 +69,10 @@ func (h *ItemStackRequestHandler) handleCraftRecipeOptional(a *protocol.CraftRec
 			// Ensure that the input item is repairable, or the material item is an enchanted book. If not, this is an
 			// invalid scenario, and we should return an error.
 			enchantedBook := book && len(material.Enchantments()) > 0
-			if !enchantedBook && (input.Item() != material.Item() || !durable) {
+			// Items are compared by their names, as comparing items that hold, for example, slices would panic.
+			inputName, _ := input.Item().EncodeItem()
+			materialName, _ := material.Item().EncodeItem()
+			if !enchantedBook && (inputName != materialName || !durable) {
 				return fmt.Errorf("input item is not repairable/same type or material item is not an enchanted book")
 			}
 
@@ -91,11 +94,14 @@ func (h *ItemStackRequestHandler) handleCraftRecipeOptional(a *protocol.CraftRec
 		}
 	}
 
-	// If we have a filter string, then the client is intending to rename the item.
+	// If we have a filter string, then the client is intending to rename the item. Renaming to the current name
+	// of the item is free, and renaming to an empty name removes the custom name of the item.
 	if len(filterStrings) > 0 {
-		renameCost = 1
-		actionCost += renameCost
-		result = result.WithCustomName(filterStrings[int(a.FilterStringIndex)])
+		if renamed := result.WithCustomName(filterStrings[int(a.FilterStringIndex)]); renamed.CustomName() != input.CustomName() {
+			renameCost = 1
+			actionCost += renameCost
+			result = renamed
+		}
 	}
 
 	// Calculate the total cost. (action cost + anvil cost)
@@ -111,16 +117,13 @@ func (h *ItemStackRequestHandler) handleCraftRecipeOptional(a *protocol.CraftRec
 
 	// We can bypass the "impossible cost" limit if we're in creative mode.
 	c := s.c.GameMode().CreativeInventory()
-	if cost >= 40 && !c {
+	if cost >= block.MaxAnvilCost && !c {
 		return fmt.Errorf("impossible cost")
 	}
 
 	// Ensure we have enough levels (or if we're in creative mode, ignore the cost) to perform the action.
-	level := s.c.ExperienceLevel()
-	if level < cost && !c {
+	if !c && !s.c.SpendExperienceLevels(cost, block.AnvilLevelCostSource{Input: input, Material: material, Result: result}) {
 		return fmt.Errorf("not enough experience")
-	} else if !c {
-		s.c.SetExperienceLevel(level - cost)
 	}
 
 	// If we had a result item, we need to calculate the new anvil cost and update it on the item.
@@ -130,7 +133,7 @@ func (h *ItemStackRequestHandler) handleCraftRecipeOptional(a *protocol.CraftRec
 			updatedAnvilCost = material.AnvilCost()
 		}
 		if renameCost != actionCost || renameCost == 0 {
-			updatedAnvilCost = updatedAnvilCost*2 + 1
+			updatedAnvilCost = block.NextAnvilCost(updatedAnvilCost)
 		}
 		result = result.WithAnvilCost(updatedAnvilCost)
 	}
@@ -38,6 +38,14 @@ func (b BlockActorDataHandler) handleSign(pk *packet.BlockActorData, pos cube.Po
 		s.log.Debugf("sign block actor data for position without sign %v", pos)
 		return nil
 	}
+	if !s.signOpened.Load() || s.openedSign.Load() != pos {
+		// The client may only edit the sign that was last opened for it. Clients may send edits of signs that
+		// were opened before without being malicious, so the packet is dropped without disconnecting.
+		s.log.Debugf("sign block actor data for sign at %v that was not opened", pos)
+		return nil
+	}
+	// An opened sign may only be edited once.
+	s.signOpened.Store(false)
 
 	frontText, err := b.textFromNBTData(pk.NBTData, true)
 	if err != nil {
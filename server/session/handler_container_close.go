@@ -13,13 +13,22 @@ func (h *ContainerCloseHandler) Handle(p packet.Packet, s *Session) error {
 	pk := p.(*packet.ContainerClose)
 
 	s.EmptyUIInventory()
+	s.signOpened.Store(false)
 	switch pk.WindowID {
 	case 0:
 		// Closing of the normal inventory.
 		s.writePacket(&packet.ContainerClose{WindowID: 0})
 		s.invOpened = false
 	case byte(s.openedWindowID.Load()):
-		s.closeCurrentContainer()
+		if _, ok := s.OpenedMerchant(); ok {
+			s.closeMerchant()
+			break
+		}
+		if _, ok := s.OpenedCraftingMenu(); ok {
+			s.closeCraftingMenu()
+			break
+		}
+		s.c.CloseBlockContainer()
 	case 0xff:
 		// TODO: Handle closing the crafting grid.
 	default:
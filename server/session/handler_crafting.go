@@ -14,6 +14,9 @@ import (
 
 // handleCraft handles the CraftRecipe request action.
 func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackRequestAction, s *Session) error {
+	if m := s.craftingMenu.Load(); m != nil {
+		return h.craftMenu(a.RecipeNetworkID, m, s)
+	}
 	craft, ok := s.recipes[a.RecipeNetworkID]
 	if !ok {
 		return fmt.Errorf("recipe with network id %v does not exist", a.RecipeNetworkID)
@@ -27,6 +30,13 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 		return fmt.Errorf("recipe with network id %v is not a crafting table recipe", a.RecipeNetworkID)
 	}
 
+	if shaped {
+		if err := h.consumeShaped(a.RecipeNetworkID, craft.(recipe.Shaped), s); err != nil {
+			return err
+		}
+		return h.createResults(s, craft.Output()...)
+	}
+
 	size := s.craftingSize()
 	offset := s.craftingOffset()
 	consumed := make([]bool, size)
@@ -58,11 +68,84 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 			return fmt.Errorf("recipe %v: could not consume expected item: %v", a.RecipeNetworkID, expected)
 		}
 	}
+	for slot := offset; slot < offset+size; slot++ {
+		if has, _ := s.ui.Item(int(slot)); !consumed[slot-offset] && !has.Empty() {
+			return fmt.Errorf("recipe %v: crafting grid holds item that is not part of the recipe: %v", a.RecipeNetworkID, has)
+		}
+	}
 	return h.createResults(s, craft.Output()...)
 }
 
+// consumeShaped consumes the input of a shaped recipe from the crafting grid. The input must be laid out in the
+// crafting grid in the shape of the recipe, but may be placed anywhere in the grid and may be mirrored
+// horizontally. All slots of the grid outside the shape must be empty.
+func (h *ItemStackRequestHandler) consumeShaped(id uint32, craft recipe.Shaped, s *Session) error {
+	size, offset := int(s.craftingSize()), int(s.craftingOffset())
+	gridWidth := int(math.Sqrt(float64(size)))
+	width, height := craft.Shape().Width(), craft.Shape().Height()
+	input := craft.Input()
+
+	grid := make([]item.Stack, size)
+	for i := range grid {
+		grid[i], _ = s.ui.Item(offset + i)
+	}
+	// expectedAt returns the item expected in the grid at the x and y passed if the shape is placed at the
+	// offset passed.
+	expectedAt := func(x, y, offsetX, offsetY int, mirrored bool) item.Stack {
+		sx, sy := x-offsetX, y-offsetY
+		if sx < 0 || sy < 0 || sx >= width || sy >= height {
+			return item.Stack{}
+		}
+		if mirrored {
+			sx = width - 1 - sx
+		}
+		if i := sy*width + sx; i < len(input) {
+			return input[i]
+		}
+		return item.Stack{}
+	}
+	matches := func(offsetX, offsetY int, mirrored bool) bool {
+		for i, has := range grid {
+			expected := expectedAt(i%gridWidth, i/gridWidth, offsetX, offsetY, mirrored)
+			if has.Empty() != expected.Empty() {
+				return false
+			}
+			if !expected.Empty() && (has.Count() < expected.Count() || !matchingStacks(has, expected)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for offsetY := 0; offsetY+height <= gridWidth; offsetY++ {
+		for offsetX := 0; offsetX+width <= gridWidth; offsetX++ {
+			for _, mirrored := range []bool{false, true} {
+				if !matches(offsetX, offsetY, mirrored) {
+					continue
+				}
+				for i, has := range grid {
+					expected := expectedAt(i%gridWidth, i/gridWidth, offsetX, offsetY, mirrored)
+					if expected.Empty() {
+						continue
+					}
+					h.setItemInSlot(protocol.StackRequestSlotInfo{
+						ContainerID: protocol.ContainerCraftingInput,
+						Slot:        byte(offset + i),
+					}, has.Grow(-expected.Count()), s)
+				}
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("recipe %v: items in crafting grid do not match the shape of the recipe", id)
+}
+
 // handleAutoCraft handles the AutoCraftRecipe request action.
 func (h *ItemStackRequestHandler) handleAutoCraft(a *protocol.AutoCraftRecipeStackRequestAction, s *Session) error {
+	if m := s.craftingMenu.Load(); m != nil {
+		// The result of a crafting menu is only crafted once at a time, as it may change after every craft.
+		return h.craftMenu(a.RecipeNetworkID, m, s)
+	}
 	craft, ok := s.recipes[a.RecipeNetworkID]
 	if !ok {
 		return fmt.Errorf("recipe with network id %v does not exist", a.RecipeNetworkID)
@@ -48,16 +48,16 @@ func (h *ItemStackRequestHandler) handleEnchant(a *protocol.CraftRecipeStackRequ
 	cost := int(a.RecipeNetworkID + 1)
 	requirement := allCosts[a.RecipeNetworkID]
 	enchants := allEnchants[a.RecipeNetworkID]
+	if len(enchants) == 0 {
+		return fmt.Errorf("enchantment option %d is not available", a.RecipeNetworkID)
+	}
 
 	// If we don't have infinite resources, we need to deduct Lapis Lazuli and experience.
 	if !s.c.GameMode().CreativeInventory() {
-		// First ensure that the experience level is both underneath the requirement and the cost.
+		// First ensure that the experience level is not underneath the requirement.
 		if s.c.ExperienceLevel() < requirement {
 			return fmt.Errorf("not enough levels to meet requirement")
 		}
-		if s.c.ExperienceLevel() < cost {
-			return fmt.Errorf("not enough levels to meet cost")
-		}
 
 		// Then ensure that the player has input Lapis Lazuli, and enough of it to meet the cost.
 		lapis, err := s.ui.Item(enchantingLapisSlot)
@@ -72,7 +72,9 @@ func (h *ItemStackRequestHandler) handleEnchant(a *protocol.CraftRecipeStackRequ
 		}
 
 		// Deduct the experience and Lapis Lazuli.
-		s.c.SetExperienceLevel(s.c.ExperienceLevel() - cost)
+		if !s.c.SpendExperienceLevels(cost, block.EnchantingLevelCostSource{Input: input, Enchantments: enchants}) {
+			return fmt.Errorf("not enough levels to meet cost")
+		}
 		h.setItemInSlot(protocol.StackRequestSlotInfo{
 			ContainerID: protocol.ContainerEnchantingMaterial,
 			Slot:        enchantingLapisSlot,
@@ -105,6 +107,10 @@ func (s *Session) sendEnchantmentOptions(w *world.World, pos cube.Pos, stack ite
 	// Build the protocol variant of the enchantment options.
 	options := make([]protocol.EnchantmentOption, 0, 3)
 	for i := 0; i < 3; i++ {
+		if len(selectedEnchants[i]) == 0 {
+			// No enchantments could be selected for this option, so it is not shown to the client.
+			continue
+		}
 		// First build the enchantment instances for each selected enchantment.
 		enchants := make([]protocol.EnchantmentInstance, 0, len(selectedEnchants[i]))
 		for _, enchant := range selectedEnchants[i] {
@@ -149,27 +155,21 @@ func (s *Session) determineAvailableEnchantments(w *world.World, pos cube.Pos, s
 	// Search for bookshelves around the enchanting table. Bookshelves help boost the value of the enchantments that
 	// are selected, resulting in enchantments that are rarer but also more expensive.
 	random := rand.New(rand.NewSource(s.c.EnchantmentSeed()))
-	bookshelves := searchBookshelves(w, pos)
+	bookshelves := block.EnchantingTable{}.Bookshelves(pos, w)
 	value := enchantable.EnchantmentValue()
 
-	// Calculate the base cost, used to calculate the upper, middle, and lower level costs.
-	baseCost := random.Intn(8) + 1 + (bookshelves >> 1) + random.Intn(bookshelves+1)
-
 	// Calculate the upper, middle, and lower level costs.
-	upperLevelCost := max(baseCost/3, 1)
-	middleLevelCost := baseCost*2/3 + 1
-	lowerLevelCost := max(baseCost, bookshelves*2)
-
-	// Create a list of available enchantments for each slot.
-	return []int{
-			upperLevelCost,
-			middleLevelCost,
-			lowerLevelCost,
-		}, [][]item.Enchantment{
-			createEnchantments(random, stack, value, upperLevelCost),
-			createEnchantments(random, stack, value, middleLevelCost),
-			createEnchantments(random, stack, value, lowerLevelCost),
+	costs := block.EnchantingCosts(random, bookshelves)
+
+	// Create a list of available enchantments for each slot. Slots with a level cost lower than their
+	// lapis cost are not available, just like in vanilla.
+	enchants := make([][]item.Enchantment, 3)
+	for i, cost := range costs {
+		if enchants[i] = createEnchantments(random, stack, value, cost); cost < i+1 {
+			enchants[i] = nil
 		}
+	}
+	return costs[:], enchants
 }
 
 // treasureEnchantment represents an enchantment that may be a treasure enchantment.
@@ -260,45 +260,6 @@ func createEnchantments(random *rand.Rand, stack item.Stack, value, level int) [
 	return selectedEnchants
 }
 
-// searchBookshelves searches for nearby bookshelves around the position passed, and returns the amount found.
-func searchBookshelves(w *world.World, pos cube.Pos) (shelves int) {
-	for x := -1; x <= 1; x++ {
-		for z := -1; z <= 1; z++ {
-			for y := 0; y <= 1; y++ {
-				if x == 0 && z == 0 {
-					// Ignore the center block.
-					continue
-				}
-				if _, ok := w.Block(pos.Add(cube.Pos{x, y, z})).(block.Air); !ok {
-					// There must be a one block space between the bookshelf and the player.
-					continue
-				}
-
-				// Check for a bookshelf two blocks away.
-				if _, ok := w.Block(pos.Add(cube.Pos{x * 2, y, z * 2})).(block.Bookshelf); ok {
-					shelves++
-				}
-				if x != 0 && z != 0 {
-					// Check for a bookshelf two blocks away on the X axis.
-					if _, ok := w.Block(pos.Add(cube.Pos{x * 2, y, z})).(block.Bookshelf); ok {
-						shelves++
-					}
-					// Check for a bookshelf two blocks away on the Z axis.
-					if _, ok := w.Block(pos.Add(cube.Pos{x, y, z * 2})).(block.Bookshelf); ok {
-						shelves++
-					}
-				}
-
-				if shelves >= 15 {
-					// We've found enough bookshelves.
-					return 15
-				}
-			}
-		}
-	}
-	return shelves
-}
-
 // weightedRandomEnchantment returns a random enchantment from the given list of enchantments using the rarity weight of
 // each enchantment.
 func weightedRandomEnchantment(rs *rand.Rand, enchants []item.Enchantment) item.Enchantment {
@@ -47,6 +47,13 @@ func (h *ItemStackRequestHandler) handleGrindstoneCraft(s *Session) error {
 
 	resultStack := nonZeroItem(firstInput, secondInput)
 	if !firstInput.Empty() && !secondInput.Empty() {
+		// Two items may only be combined if they are of the same type and may be repaired.
+		if _, durable := firstInput.Item().(item.Durable); !durable {
+			return fmt.Errorf("input items are not durable")
+		}
+		if !sameItemType(firstInput, secondInput) {
+			return fmt.Errorf("input items are not of the same type")
+		}
 		// We add the enchantments to the result stack in order to calculate the gained experience. These enchantments
 		// are stripped when creating the result.
 		resultStack = firstInput.WithEnchantments(secondInput.Enchantments()...)
@@ -58,6 +65,15 @@ func (h *ItemStackRequestHandler) handleGrindstoneCraft(s *Session) error {
 		resultStack = resultStack.WithDurability(firstDurability + secondDurability + maxDurability*5/100)
 	}
 
+	result := stripPossibleEnchantments(resultStack)
+	if (firstInput.Empty() || secondInput.Empty()) && len(result.Enchantments()) == len(resultStack.Enchantments()) {
+		return fmt.Errorf("input item has no enchantments to remove")
+	}
+	if _, book := result.Item().(item.EnchantedBook); book && len(result.Enchantments()) == 0 {
+		// An enchanted book without any enchantments left becomes a normal book.
+		result = duplicateStack(result, item.Book{})
+	}
+
 	w := s.c.World()
 	for _, o := range entity.NewExperienceOrbs(entity.EyePosition(s.c), experienceFromEnchantments(resultStack)) {
 		o.SetVelocity(mgl64.Vec3{(rand.Float64()*0.2 - 0.1) * 2, rand.Float64() * 0.4, (rand.Float64()*0.2 - 0.1) * 2})
@@ -72,7 +88,15 @@ func (h *ItemStackRequestHandler) handleGrindstoneCraft(s *Session) error {
 		ContainerID: protocol.ContainerGrindstoneAdditional,
 		Slot:        grindstoneSecondInputSlot,
 	}, item.Stack{}, s)
-	return h.createResults(s, stripPossibleEnchantments(resultStack))
+	return h.createResults(s, result)
+}
+
+// sameItemType checks if the items of the two stacks passed are of the same type, regardless of their
+// durability or enchantments.
+func sameItemType(first, second item.Stack) bool {
+	firstName, firstMeta := first.Item().EncodeItem()
+	secondName, secondMeta := second.Item().EncodeItem()
+	return firstName == secondName && firstMeta == secondMeta
 }
 
 // curseEnchantment represents an enchantment that may be a curse enchantment.
@@ -65,6 +65,9 @@ func (h *ItemStackRequestHandler) Handle(p packet.Packet, s *Session) error {
 func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s *Session) (err error) {
 	h.currentRequest = req.RequestID
 	defer func() {
+		// The result of a crafting menu may change with any request, including requests that are rejected and
+		// reverted.
+		defer h.updateCraftingMenu(s)
 		if err != nil {
 			h.reject(req.RequestID, s)
 			return
@@ -88,6 +91,10 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 		case *protocol.BeaconPaymentStackRequestAction:
 			err = h.handleBeaconPayment(a, s)
 		case *protocol.CraftRecipeStackRequestAction:
+			if m := s.openedMerchant.Load(); m != nil {
+				err = h.handleTrade(a, s, m)
+				break
+			}
 			if s.containerOpened.Load() {
 				var special bool
 				switch s.c.World().Block(s.openedPos.Load()).(type) {
@@ -148,6 +155,9 @@ func (h *ItemStackRequestHandler) handleTransfer(from, to protocol.StackRequestS
 	}
 	i, _ := h.itemInSlot(from, s)
 	dest, _ := h.itemInSlot(to, s)
+	if nestedShulkerBox(to, i) {
+		return fmt.Errorf("client tried placing shulker box %v in a shulker box", i)
+	}
 	if !i.Comparable(dest) {
 		return fmt.Errorf("client tried transferring %v to %v, but the stacks are incomparable", i, dest)
 	}
@@ -184,6 +194,9 @@ func (h *ItemStackRequestHandler) handleSwap(a *protocol.SwapStackRequestAction,
 	}
 	i, _ := h.itemInSlot(a.Source, s)
 	dest, _ := h.itemInSlot(a.Destination, s)
+	if nestedShulkerBox(a.Destination, i) || nestedShulkerBox(a.Source, dest) {
+		return fmt.Errorf("client tried placing a shulker box in a shulker box")
+	}
 
 	invA, _ := s.invByID(int32(a.Source.ContainerID))
 	invB, _ := s.invByID(int32(a.Destination.ContainerID))
@@ -197,6 +210,13 @@ func (h *ItemStackRequestHandler) handleSwap(a *protocol.SwapStackRequestAction,
 		return err
 	}
 
+	if h.swapsHeldItems(a, s) {
+		// Swapping the item held in the main hand with the off-hand is left to the Controllable, so that items
+		// that cannot be held in the off-hand are not swapped.
+		s.c.SwapHeldItems()
+		dest, _ = h.itemInSlot(a.Source, s)
+		i, _ = h.itemInSlot(a.Destination, s)
+	}
 	h.setItemInSlot(a.Source, dest, s)
 	h.setItemInSlot(a.Destination, i, s)
 	h.collectRewards(s, invA, int(a.Source.Slot))
@@ -204,6 +224,22 @@ func (h *ItemStackRequestHandler) handleSwap(a *protocol.SwapStackRequestAction,
 	return nil
 }
 
+// swapsHeldItems checks if the SwapStackRequestAction passed swaps the item held in the main hand with the item
+// in the off-hand.
+func (h *ItemStackRequestHandler) swapsHeldItems(a *protocol.SwapStackRequestAction, s *Session) bool {
+	held := func(slot protocol.StackRequestSlotInfo) bool {
+		switch slot.ContainerID {
+		case protocol.ContainerHotBar, protocol.ContainerInventory, protocol.ContainerCombinedHotBarAndInventory:
+			return uint32(slot.Slot) == s.heldSlot.Load()
+		}
+		return false
+	}
+	offHand := func(slot protocol.StackRequestSlotInfo) bool {
+		return slot.ContainerID == protocol.ContainerOffhand
+	}
+	return (held(a.Source) && offHand(a.Destination)) || (offHand(a.Source) && held(a.Destination))
+}
+
 // collectRewards checks if the source inventory has rewards for the player, for example, experience rewards when
 // smelting. If it does, it will drop the rewards at the player's location.
 func (h *ItemStackRequestHandler) collectRewards(s *Session, inv *inventory.Inventory, slot int) {
@@ -331,6 +367,9 @@ func (h *ItemStackRequestHandler) verifySlot(slot protocol.StackRequestSlotInfo,
 		return fmt.Errorf("too many unacknowledged request slot changes")
 	}
 	inv, _ := s.invByID(int32(slot.ContainerID))
+	if m := s.craftingMenu.Load(); m != nil && slot.ContainerID == protocol.ContainerCraftingInput && !m.usable(int(slot.Slot)) {
+		return fmt.Errorf("slot %v is not part of the crafting menu", slot.Slot)
+	}
 
 	i, err := h.itemInSlot(slot, s)
 	if err != nil {
@@ -512,3 +551,13 @@ func call(ctx *event.Context, slot int, it item.Stack, f func(ctx *event.Context
 	}
 	return nil
 }
+
+// nestedShulkerBox checks if the item passed is a shulker box that would be placed in the slot passed of an
+// opened shulker box. Shulker boxes cannot be placed inside other shulker boxes.
+func nestedShulkerBox(slot protocol.StackRequestSlotInfo, it item.Stack) bool {
+	if slot.ContainerID != protocol.ContainerShulkerBox {
+		return false
+	}
+	_, ok := it.Item().(block.ShulkerBox)
+	return ok
+}
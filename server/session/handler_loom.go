@@ -14,6 +14,8 @@ const (
 	loomDyeSlot = 0x0a
 	// loomPatternSlot is the slot index of the pattern item in the loom table.
 	loomPatternSlot = 0x0b
+	// maxBannerPatterns is the maximum amount of patterns that may be applied to a banner using a loom.
+	maxBannerPatterns = 6
 )
 
 // handleLoomCraft handles a CraftLoomRecipe stack request action made using a loom table.
@@ -41,6 +43,9 @@ func (h *ItemStackRequestHandler) handleLoomCraft(a *protocol.CraftLoomRecipeSta
 	if b.Illager {
 		return fmt.Errorf("input item is an illager banner")
 	}
+	if len(b.Patterns) >= maxBannerPatterns {
+		return fmt.Errorf("input banner already has %v patterns", maxBannerPatterns)
+	}
 
 	// Do the same with the input dye.
 	dye, _ := h.itemInSlot(protocol.StackRequestSlotInfo{
@@ -57,7 +62,10 @@ func (h *ItemStackRequestHandler) handleLoomCraft(a *protocol.CraftLoomRecipeSta
 
 	// The action contains the pattern that the client wanted to apply, so parse the ID and check if it is a valid
 	// pattern.
-	expectedPattern := block.BannerPatternByID(a.Pattern)
+	expectedPattern, ok := block.BannerPatternByID(a.Pattern)
+	if !ok {
+		return fmt.Errorf("unknown banner pattern %v", a.Pattern)
+	}
 
 	// Some banner patterns have equivalent banner pattern items that are required to craft the pattern. If the expected
 	// pattern has a pattern item, check if the player input the correct pattern item.
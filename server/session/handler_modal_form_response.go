@@ -1,44 +1,143 @@
 package session
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/player/form"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 	"sync"
+	"time"
 )
 
 // ModalFormResponseHandler handles the ModalFormResponse packet.
 type ModalFormResponseHandler struct {
-	mu        sync.Mutex
-	forms     map[uint32]form.Form
+	mu sync.Mutex
+	// current is the form currently shown to the client. Only one form is sent to the client at a time, so
+	// that responses can never be matched to the wrong form.
+	current pendingForm
+	// queue holds forms that were sent while another form was still opened. They are sent to the client, in
+	// order, once the current form is submitted, closed or times out.
+	queue     []form.Form
 	currentID atomic.Uint32
 }
 
+// pendingForm is a form sent to the client that has not yet been responded to.
+type pendingForm struct {
+	id   uint32
+	f    form.Form
+	sent time.Time
+}
+
+const (
+	// formTimeout is the duration after which a form that the client has not responded to is considered
+	// closed, so that forms queued after it may be sent.
+	formTimeout = time.Minute * 5
+	// maxQueuedForms is the maximum amount of forms that may be queued for a client at once.
+	maxQueuedForms = 10
+)
+
 // Handle ...
 func (h *ModalFormResponseHandler) Handle(p packet.Packet, s *Session) error {
 	pk := p.(*packet.ModalFormResponse)
+	resp, exists := pk.ResponseData.Value()
 
 	h.mu.Lock()
-	f, ok := h.forms[pk.FormID]
-	delete(h.forms, pk.FormID)
-	h.mu.Unlock()
+	if h.current.f == nil || h.current.id != pk.FormID {
+		h.mu.Unlock()
+		if !exists || (pk.FormID != 0 && pk.FormID <= h.currentID.Load()) {
+			// Sometimes the client seems to send a second response with no data, which would cause the player to
+			// be kicked by the server. The response may also be for a form that has timed out already. Neither
+			// of these should lead to the player being kicked.
+			return nil
+		}
+		return fmt.Errorf("no form with ID %v currently opened", pk.FormID)
+	}
+	f := h.current.f
+	h.current = pendingForm{}
 
-	resp, exists := pk.ResponseData.Value()
-	if !ok && !exists {
-		// Sometimes the client seems to send a second response with no data, which would cause the player to be kicked
-		// by the server. This should patch that.
+	if reason, ok := pk.CancelReason.Value(); ok && reason == packet.ModalFormCancelReasonUserBusy {
+		// The client had another screen opened, so the form was never shown. Queue it again so that it is
+		// sent again later.
+		h.queue = append([]form.Form{f}, h.queue...)
+		h.mu.Unlock()
 		return nil
 	}
+	h.mu.Unlock()
+
 	if !exists || len(resp) == 0 {
 		// The form was cancelled: The cross in the top right corner was clicked.
 		resp = nil
 	}
-	if !ok {
-		return fmt.Errorf("no form with ID %v currently opened", pk.FormID)
-	}
-	if err := f.SubmitJSON(resp, s.c); err != nil {
+	err := f.SubmitJSON(resp, s.c)
+	h.next(s)
+	if err != nil {
 		return fmt.Errorf("error submitting form data: %w", err)
 	}
 	return nil
 }
+
+// send sends a form to the client of the Session passed. If the client currently has another form opened,
+// the form is queued and sent once the client has responded to the forms sent before it.
+func (h *ModalFormResponseHandler) send(f form.Form, s *Session) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.current.f != nil {
+		if len(h.queue) >= maxQueuedForms {
+			s.log.Debugf("SendForm %v: more than %v queued forms: dropping the oldest one.", s.c.Name(), maxQueuedForms)
+			h.queue = h.queue[1:]
+		}
+		h.queue = append(h.queue, f)
+		return
+	}
+	h.write(f, s)
+}
+
+// next sends the next queued form to the client of the Session passed, if no form is currently opened.
+func (h *ModalFormResponseHandler) next(s *Session) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.current.f != nil || len(h.queue) == 0 {
+		return
+	}
+	f := h.queue[0]
+	h.queue = h.queue[1:]
+	h.write(f, s)
+}
+
+// tick checks if the form currently opened has timed out. If so, the form is closed and the next queued
+// form, if any, is sent.
+func (h *ModalFormResponseHandler) tick(s *Session) {
+	h.mu.Lock()
+	f := h.current.f
+	if f == nil || time.Since(h.current.sent) < formTimeout {
+		h.mu.Unlock()
+		h.next(s)
+		return
+	}
+	h.current = pendingForm{}
+	h.mu.Unlock()
+
+	s.log.Debugf("SendForm %v: form timed out without a response.", s.c.Name())
+	_ = f.SubmitJSON(nil, s.c)
+	h.next(s)
+}
+
+// write writes a form to the client of the Session and marks it as currently opened. write must only be
+// called while h.mu is locked.
+func (h *ModalFormResponseHandler) write(f form.Form, s *Session) {
+	b, err := json.Marshal(f)
+	if err != nil {
+		s.log.Errorf("SendForm %v: error encoding form: %v", s.c.Name(), err)
+		return
+	}
+	id := h.currentID.Add(1)
+	h.current = pendingForm{id: id, f: f, sent: time.Now()}
+
+	s.writePacket(&packet.ModalFormRequest{
+		FormID:   id,
+		FormData: b,
+	})
+}
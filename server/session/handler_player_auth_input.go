@@ -3,6 +3,7 @@ package session
 import (
 	"fmt"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/player/device"
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
@@ -16,12 +17,20 @@ type PlayerAuthInputHandler struct{}
 // Handle ...
 func (h PlayerAuthInputHandler) Handle(p packet.Packet, s *Session) error {
 	pk := p.(*packet.PlayerAuthInput)
+	h.handleInputMode(pk, s)
 	if err := h.handleMovement(pk, s); err != nil {
 		return err
 	}
 	return h.handleActions(pk, s)
 }
 
+// handleInputMode updates the input mode of the controllable if the client switched to a different input mode.
+func (h PlayerAuthInputHandler) handleInputMode(pk *packet.PlayerAuthInput, s *Session) {
+	if mode, ok := device.InputModeByID(int(pk.InputMode)); ok && mode != s.c.InputMode() {
+		s.c.UpdateInputMode(mode)
+	}
+}
+
 // handleMovement handles the movement part of the packet.PlayerAuthInput.
 func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Session) error {
 	yaw, pitch := s.c.Rotation().Elem()
@@ -43,7 +52,12 @@ func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Se
 	pk.Position = pk.Position.Sub(mgl32.Vec3{0, 1.62}) // Sub the base offset of players from the pos.
 
 	newPos := vec32To64(pk.Position)
-	deltaPos, deltaYaw, deltaPitch := newPos.Sub(pos), float64(pk.Yaw)-yaw, float64(pk.Pitch)-pitch
+	s.inputTick.Store(pk.Tick)
+
+	// The client only knows its position as 32-bit floats, which lose precision at large coordinates. The delta is
+	// therefore calculated from the position as the client knows it, so that rounding differences are not seen
+	// as movement.
+	deltaPos, deltaYaw, deltaPitch := newPos.Sub(vec32To64(vec64To32(pos))), float64(pk.Yaw)-yaw, float64(pk.Pitch)-pitch
 	if mgl64.FloatEqual(deltaPos.Len(), 0) && mgl64.FloatEqual(deltaYaw, 0) && mgl64.FloatEqual(deltaPitch, 0) {
 		// The PlayerAuthInput packet is sent every tick, so don't do anything if the position and rotation
 		// were unchanged.
@@ -51,7 +65,7 @@ func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Se
 	}
 
 	if expected := s.teleportPos.Load(); expected != nil {
-		if newPos.Sub(*expected).Len() > 1 {
+		if newPos.Sub(vec32To64(vec64To32(*expected))).Len() > 1 {
 			// The player has moved before it received the teleport packet. Ignore this movement entirely and
 			// wait for the client to sync itself back to the server. Once we get a movement that is close
 			// enough to the teleport position, we'll allow the player to move around again.
@@ -0,0 +1,58 @@
+package session
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+// PositionTrackingDBClientRequestHandler handles the PositionTrackingDBClientRequest packet, sent by the client
+// to find the lodestone that a lodestone compass it holds is bound to.
+type PositionTrackingDBClientRequestHandler struct{}
+
+// Handle ...
+func (PositionTrackingDBClientRequestHandler) Handle(p packet.Packet, s *Session) error {
+	pk := p.(*packet.PositionTrackingDBClientRequest)
+	if pk.RequestAction != packet.PositionTrackingDBRequestActionQuery {
+		return fmt.Errorf("unknown position tracking request action %v", pk.RequestAction)
+	}
+
+	compass, ok := item.LodestoneCompassByHandle(pk.TrackingID)
+	if !ok {
+		s.writePacket(&packet.PositionTrackingDBServerBroadcast{
+			BroadcastAction: packet.PositionTrackingDBBroadcastActionNotFound,
+			TrackingID:      pk.TrackingID,
+			Payload:         map[string]any{"id": trackingID(pk.TrackingID), "version": uint8(1), "status": uint8(2)},
+		})
+		return nil
+	}
+	dim, _ := world.DimensionID(compass.Dimension)
+	action, status := byte(packet.PositionTrackingDBBroadcastActionUpdate), uint8(0)
+	if w := s.c.World(); w.Dimension() == compass.Dimension {
+		// The lodestone can only be checked if it is in the same dimension as the player. Compasses spin
+		// randomly in other dimensions anyway.
+		if _, ok := w.Block(compass.Pos).(block.Lodestone); !ok {
+			action, status = packet.PositionTrackingDBBroadcastActionDestroy, 2
+		}
+	}
+	s.writePacket(&packet.PositionTrackingDBServerBroadcast{
+		BroadcastAction: action,
+		TrackingID:      pk.TrackingID,
+		Payload: map[string]any{
+			"version": uint8(1),
+			"dim":     int32(dim),
+			"id":      trackingID(pk.TrackingID),
+			"pos":     []int32{int32(compass.Pos[0]), int32(compass.Pos[1]), int32(compass.Pos[2])},
+			"status":  status,
+		},
+	})
+	return nil
+}
+
+// trackingID formats a tracking handle as the ID string used in the payload of a
+// PositionTrackingDBServerBroadcast packet.
+func trackingID(handle int32) string {
+	return fmt.Sprintf("0x%08x", handle)
+}
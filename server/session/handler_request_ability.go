@@ -11,7 +11,11 @@ type RequestAbilityHandler struct{}
 func (a RequestAbilityHandler) Handle(p packet.Packet, s *Session) error {
 	pk := p.(*packet.RequestAbility)
 	if pk.Ability == packet.AbilityFlying {
-		if !s.c.GameMode().AllowsFlying() {
+		if flying, _ := pk.Value.(bool); !flying {
+			s.c.StopFlying()
+			return nil
+		}
+		if !s.c.FlightAllowed() {
 			s.log.Debugf("failed processing packet from %v (%v): RequestAbility: flying flag enabled while not being able to fly\n", s.conn.RemoteAddr(), s.c.Name())
 			s.sendAbilities()
 			return nil
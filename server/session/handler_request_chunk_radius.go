@@ -19,5 +19,10 @@ func (*RequestChunkRadiusHandler) Handle(p packet.Packet, s *Session) error {
 	s.chunkLoader.ChangeRadius(int(pk.ChunkRadius))
 
 	s.writePacket(&packet.ChunkRadiusUpdated{ChunkRadius: s.chunkRadius})
+
+	if settings := s.c.ClientSettings(); settings.RenderDistance != int(s.chunkRadius) {
+		settings.RenderDistance = int(s.chunkRadius)
+		s.c.UpdateClientSettings(settings)
+	}
 	return nil
 }
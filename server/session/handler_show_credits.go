@@ -0,0 +1,21 @@
+package session
+
+import (
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+// ShowCreditsHandler handles the ShowCredits packet, which the client sends once it closes the end credits.
+type ShowCreditsHandler struct{}
+
+// Handle ...
+func (*ShowCreditsHandler) Handle(p packet.Packet, s *Session) error {
+	pk := p.(*packet.ShowCredits)
+
+	if pk.PlayerRuntimeID != selfEntityRuntimeID {
+		return errSelfRuntimeID
+	}
+	if pk.StatusType == packet.ShowCreditsStatusEnd {
+		s.c.FinishCredits()
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package session
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"math/rand"
+)
+
+const (
+	// tradeFirstIngredientSlot is the slot index of the first ingredient in the trading UI.
+	tradeFirstIngredientSlot = 0x04
+	// tradeSecondIngredientSlot is the slot index of the second ingredient in the trading UI.
+	tradeSecondIngredientSlot = 0x05
+)
+
+// handleTrade handles a CraftRecipe stack request action made using the trading UI of a Merchant.
+func (h *ItemStackRequestHandler) handleTrade(a *protocol.CraftRecipeStackRequestAction, s *Session, m *inventory.Merchant) error {
+	index := int(a.RecipeNetworkID) - 1
+	offer, err := m.Offer(index)
+	if err != nil {
+		return fmt.Errorf("trade with network id %v: %w", a.RecipeNetworkID, err)
+	}
+	if offer.OutOfStock() {
+		return fmt.Errorf("trade with network id %v: %w", a.RecipeNetworkID, inventory.ErrOutOfStock)
+	}
+
+	first := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientOne, Slot: tradeFirstIngredientSlot}
+	second := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientTwo, Slot: tradeSecondIngredientSlot}
+	inputA, _ := h.itemInSlot(first, s)
+	inputB, _ := h.itemInSlot(second, s)
+	if !tradeInputMatches(inputA, offer.BuyA) || !tradeInputMatches(inputB, offer.BuyB) {
+		// The items may also be placed the other way around in the trading UI.
+		inputA, inputB, first, second = inputB, inputA, second, first
+		if !tradeInputMatches(inputA, offer.BuyA) || !tradeInputMatches(inputB, offer.BuyB) {
+			return fmt.Errorf("trade with network id %v: input items are not the same as expected input", a.RecipeNetworkID)
+		}
+	}
+
+	ctx := event.C()
+	if m.Handler().HandleTrade(ctx, s.c, index, offer); ctx.Cancelled() {
+		return fmt.Errorf("trade with network id %v: action was cancelled", a.RecipeNetworkID)
+	}
+	if err := m.Use(index); err != nil {
+		return fmt.Errorf("trade with network id %v: %w", a.RecipeNetworkID, err)
+	}
+
+	h.setItemInSlot(first, inputA.Grow(-offer.BuyA.Count()), s)
+	if !offer.BuyB.Empty() {
+		h.setItemInSlot(second, inputB.Grow(-offer.BuyB.Count()), s)
+	}
+	if offer.XP > 0 {
+		w := s.c.World()
+		for _, o := range entity.NewExperienceOrbs(entity.EyePosition(s.c), offer.XP) {
+			o.SetVelocity(mgl64.Vec3{(rand.Float64()*0.2 - 0.1) * 2, rand.Float64() * 0.4, (rand.Float64()*0.2 - 0.1) * 2})
+			w.AddEntity(o)
+		}
+	}
+	return h.createResults(s, offer.Sell)
+}
+
+// tradeInputMatches checks if the item.Stack placed in an ingredient slot of the trading UI satisfies the
+// expected item.Stack of an inventory.Offer. If the expected stack is empty, any item is accepted, as the slot
+// is simply not used by the trade.
+func tradeInputMatches(has, expected item.Stack) bool {
+	if expected.Empty() {
+		return true
+	}
+	return has.Count() >= expected.Count() && matchingStacks(has, expected)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
@@ -15,8 +16,10 @@ import (
 	"github.com/df-mc/dragonfly/server/player/form"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl32"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 	"math"
@@ -47,6 +50,8 @@ func (s *Session) StartShowingEntity(e world.Entity) {
 
 // closeCurrentContainer closes the container the player might currently have open.
 func (s *Session) closeCurrentContainer() {
+	s.closeMerchant()
+	s.closeCraftingMenu()
 	if !s.containerOpened.Load() {
 		return
 	}
@@ -62,6 +67,113 @@ func (s *Session) closeCurrentContainer() {
 	}
 }
 
+// OpenMerchant opens the trading UI of the inventory.Merchant passed. The trader passed is the entity shown in
+// the trading UI and must be visible to the Controllable of the Session.
+func (s *Session) OpenMerchant(m *inventory.Merchant, trader world.Entity) {
+	if s == Nop {
+		return
+	}
+	s.c.CloseBlockContainer()
+	s.closeMerchant()
+	s.closeCraftingMenu()
+
+	s.nextWindowID()
+	s.openedMerchant.Store(m)
+	s.openedTrader.Store(trader)
+	m.AddViewer(s)
+	s.sendOffers(m, trader)
+}
+
+// OpenedMerchant returns the inventory.Merchant of which the Controllable of the Session currently has the
+// trading UI opened. False is returned if no trading UI is opened.
+func (s *Session) OpenedMerchant() (*inventory.Merchant, bool) {
+	if s == Nop {
+		return nil, false
+	}
+	m := s.openedMerchant.Load()
+	return m, m != nil
+}
+
+// CloseMerchant closes the trading UI currently opened by the Controllable of the Session, if any.
+func (s *Session) CloseMerchant() {
+	if s == Nop {
+		return
+	}
+	s.closeMerchant()
+}
+
+// closeMerchant closes the trading UI currently opened. If no trading UI is open, closeMerchant does nothing.
+func (s *Session) closeMerchant() {
+	m := s.openedMerchant.Swap(nil)
+	if m == nil {
+		return
+	}
+	s.openedTrader.Store(nil)
+	m.RemoveViewer(s)
+	s.writePacket(&packet.ContainerClose{WindowID: byte(s.openedWindowID.Load())})
+}
+
+// ViewOffers ...
+func (s *Session) ViewOffers(m *inventory.Merchant) {
+	if s.openedMerchant.Load() != m {
+		return
+	}
+	s.sendOffers(m, s.openedTrader.Load())
+}
+
+// sendOffers sends the offers of the inventory.Merchant passed to the client, opening the trading UI in the
+// window currently opened.
+func (s *Session) sendOffers(m *inventory.Merchant, trader world.Entity) {
+	offers := m.Offers()
+	recipes := make([]any, 0, len(offers))
+	for index, o := range offers {
+		maxUses := int32(o.MaxUses)
+		if o.MaxUses == 0 {
+			maxUses = math.MaxInt32
+		}
+		r := map[string]any{
+			"buyA":             nbtconv.WriteItem(o.BuyA, true),
+			"buyCountA":        int32(o.BuyA.Count()),
+			"buyCountB":        int32(o.BuyB.Count()),
+			"sell":             nbtconv.WriteItem(o.Sell, true),
+			"uses":             int32(o.Uses),
+			"maxUses":          maxUses,
+			"rewardExp":        boolByte(o.XP > 0),
+			"traderExp":        int32(0),
+			"tier":             int32(0),
+			"demand":           int32(0),
+			"priceMultiplierA": float32(0),
+			"priceMultiplierB": float32(0),
+			"netId":            int32(index + 1),
+		}
+		if !o.BuyB.Empty() {
+			r["buyB"] = nbtconv.WriteItem(o.BuyB, true)
+		}
+		recipes = append(recipes, r)
+	}
+	b, err := nbt.Marshal(map[string]any{
+		"Recipes":             recipes,
+		"TierExpRequirements": []any{map[string]any{"0": int32(0)}},
+	})
+	if err != nil {
+		s.log.Errorf("error encoding offers of merchant: %v", err)
+		return
+	}
+	var traderID int64
+	if trader != nil {
+		traderID = int64(s.entityRuntimeID(trader))
+	}
+	s.writePacket(&packet.UpdateTrade{
+		WindowID:         byte(s.openedWindowID.Load()),
+		WindowType:       protocol.ContainerTypeTrade,
+		VillagerUniqueID: traderID,
+		EntityUniqueID:   selfEntityRuntimeID,
+		DisplayName:      m.Name(),
+		NewTradeUI:       true,
+		SerialisedOffers: b,
+	})
+}
+
 // EmptyUIInventory attempts to move all items in the UI inventory to the player's main inventory. If the main inventory
 // is full, the items are dropped on the ground instead.
 func (s *Session) EmptyUIInventory() {
@@ -93,12 +205,9 @@ func (s *Session) SendRespawn(pos mgl64.Vec3) {
 
 // sendRecipes sends the current crafting recipes to the session.
 func (s *Session) sendRecipes() {
-	recipes := make([]protocol.Recipe, 0, len(recipe.Recipes()))
-	for index, i := range recipe.Recipes() {
-		networkID := uint32(index) + 1
-		s.recipes[networkID] = i
-
-		switch i := i.(type) {
+	recipes := make([]protocol.Recipe, 0, len(s.recipes))
+	for networkID := uint32(1); networkID <= uint32(len(s.recipes)); networkID++ {
+		switch i := s.recipes[networkID].(type) {
 		case recipe.Shapeless:
 			recipes = append(recipes, &protocol.ShapelessRecipe{
 				RecipeID:        uuid.New().String(),
@@ -168,6 +277,8 @@ const (
 type smelter interface {
 	// ResetExperience resets the collected experience of the smelter, and returns the amount of experience that was reset.
 	ResetExperience() int
+	// Durations returns the remaining, maximum, and cook durations of the smelter.
+	Durations() (remaining time.Duration, max time.Duration, cook time.Duration)
 }
 
 // invByID attempts to return an inventory by the ID passed. If found, the inventory is returned and the bool
@@ -192,6 +303,8 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.openedWindow.Load(), true
 			} else if _, enderChest := b.(block.EnderChest); enderChest {
 				return s.openedWindow.Load(), true
+			} else if _, hopper := b.(block.Hopper); hopper {
+				return s.openedWindow.Load(), true
 			}
 		}
 	case protocol.ContainerBarrel:
@@ -200,6 +313,12 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.openedWindow.Load(), true
 			}
 		}
+	case protocol.ContainerShulkerBox:
+		if s.containerOpened.Load() {
+			if _, shulkerBox := s.c.World().Block(s.openedPos.Load()).(block.ShulkerBox); shulkerBox {
+				return s.openedWindow.Load(), true
+			}
+		}
 	case protocol.ContainerBeaconPayment:
 		if s.containerOpened.Load() {
 			if _, beacon := s.c.World().Block(s.openedPos.Load()).(block.Beacon); beacon {
@@ -242,6 +361,11 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.ui, true
 			}
 		}
+	case protocol.ContainerTradeIngredientOne, protocol.ContainerTradeIngredientTwo, protocol.ContainerTradeResultPreview,
+		protocol.ContainerTradeTwoIngredientOne, protocol.ContainerTradeTwoIngredientTwo, protocol.ContainerTradeTwoResultPreview:
+		if s.openedMerchant.Load() != nil {
+			return s.ui, true
+		}
 	case protocol.ContainerFurnaceIngredient, protocol.ContainerFurnaceFuel, protocol.ContainerFurnaceResult,
 		protocol.ContainerBlastFurnaceIngredient, protocol.ContainerSmokerIngredient:
 		if s.containerOpened.Load() {
@@ -257,6 +381,7 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 // it will be shown to the client.
 func (s *Session) Disconnect(message string) {
 	if s != Nop {
+		s.disconnecting.Store(true)
 		s.writePacket(&packet.Disconnect{
 			HideDisconnectionScreen: message == "",
 			Message:                 message,
@@ -313,32 +438,17 @@ func (s *Session) SendFood(food int, saturation, exhaustion float64) {
 }
 
 // SendForm sends a form to the client of the connection. The Submit method of the form is called when the
-// client submits the form.
+// client submits the form. If the client already has a form opened, the form is queued and sent once the
+// client has submitted or closed the forms sent before it.
 func (s *Session) SendForm(f form.Form) {
-	b, _ := json.Marshal(f)
-
-	h := s.handlers[packet.IDModalFormResponse].(*ModalFormResponseHandler)
-	id := h.currentID.Add(1)
-
-	h.mu.Lock()
-	if len(h.forms) > 10 {
-		s.log.Debugf("SendForm %v: more than 10 active forms: dropping an existing one.", s.c.Name())
-		for k := range h.forms {
-			delete(h.forms, k)
-			break
-		}
-	}
-	h.forms[id] = f
-	h.mu.Unlock()
-
-	s.writePacket(&packet.ModalFormRequest{
-		FormID:   id,
-		FormData: b,
-	})
+	s.handlers[packet.IDModalFormResponse].(*ModalFormResponseHandler).send(f, s)
 }
 
 // Transfer transfers the player to a server with the IP and port passed.
 func (s *Session) Transfer(ip net.IP, port int) {
+	// The client will leave the server after being transferred, so the session should not be detached once
+	// the connection closes.
+	s.disconnecting.Store(true)
 	s.writePacket(&packet.Transfer{
 		Address: ip.String(),
 		Port:    uint16(port),
@@ -355,10 +465,19 @@ func (s *Session) SendGameMode(mode world.GameMode) {
 	s.sendAbilities()
 }
 
+// SendAbilities sends the abilities of the Controllable entity of the session to the client, such as whether it
+// may fly and its flight speed.
+func (s *Session) SendAbilities() {
+	if s == Nop {
+		return
+	}
+	s.sendAbilities()
+}
+
 // sendAbilities sends the abilities of the Controllable entity of the session to the client.
 func (s *Session) sendAbilities() {
 	mode, abilities := s.c.GameMode(), uint32(0)
-	if mode.AllowsFlying() {
+	if s.c.FlightAllowed() {
 		abilities |= protocol.AbilityMayFly
 		if s.c.Flying() {
 			abilities |= protocol.AbilityFlying
@@ -387,12 +506,12 @@ func (s *Session) sendAbilities() {
 		EntityUniqueID:     selfEntityRuntimeID,
 		PlayerPermissions:  packet.PermissionLevelMember,
 		CommandPermissions: packet.CommandPermissionLevelNormal,
-		Layers: []protocol.AbilityLayer{ // TODO: Support customization of fly and walk speeds.
+		Layers: []protocol.AbilityLayer{
 			{
 				Type:      protocol.AbilityLayerTypeBase,
 				Abilities: protocol.AbilityCount - 1,
 				Values:    abilities,
-				FlySpeed:  protocol.AbilityBaseFlySpeed,
+				FlySpeed:  float32(s.c.HorizontalFlightSpeed()),
 				WalkSpeed: protocol.AbilityBaseWalkSpeed,
 			},
 		},
@@ -473,6 +592,49 @@ func (s *Session) EnableInstantRespawn(enable bool) {
 	s.sendGameRules([]protocol.GameRule{{Name: "doimmediaterespawn", Value: enable}})
 }
 
+// SendFog sends the stack of fog identifiers passed to the client, replacing the stack previously sent. Fog later
+// in the stack is rendered over fog earlier in the stack.
+func (s *Session) SendFog(stack []string) {
+	s.writePacket(&packet.PlayerFog{Stack: stack})
+}
+
+// ShakeCamera shakes the camera of the client with the intensity passed for the duration passed. If rotational is
+// true, the camera rotates while shaking rather than moving.
+func (s *Session) ShakeCamera(intensity float64, duration time.Duration, rotational bool) {
+	pk := &packet.CameraShake{Intensity: float32(intensity), Duration: float32(duration.Seconds()), Action: packet.CameraShakeActionAdd}
+	if rotational {
+		pk.Type = packet.CameraShakeTypeRotational
+	}
+	s.writePacket(pk)
+}
+
+// StopCameraShake stops all shaking of the camera of the client.
+func (s *Session) StopCameraShake() {
+	s.writePacket(&packet.CameraShake{Action: packet.CameraShakeActionStop})
+}
+
+// freeCameraPreset is the name of the camera preset sent to the client in Spawn, which is used by SetCamera to
+// position the camera freely. It is the only preset sent, so it has index 0.
+const freeCameraPreset = "minecraft:free"
+
+// SetCamera moves the camera of the client away from the player to the position passed and makes it look in the
+// direction of the rotation passed. If ease is non-zero, the camera moves there smoothly over that duration.
+func (s *Session) SetCamera(pos mgl64.Vec3, rot cube.Rotation, ease time.Duration) {
+	set := protocol.CameraInstructionSet{
+		Position: protocol.Option(vec64To32(pos)),
+		Rotation: protocol.Option(mgl32.Vec2{float32(rot.Pitch()), float32(rot.Yaw())}),
+	}
+	if ease > 0 {
+		set.Ease = protocol.Option(protocol.CameraEase{Type: protocol.EasingTypeLinear, Duration: float32(ease.Seconds())})
+	}
+	s.writePacket(&packet.CameraInstruction{Set: protocol.Option(set)})
+}
+
+// ClearCamera returns the camera of the client to the player after it was moved using SetCamera.
+func (s *Session) ClearCamera() {
+	s.writePacket(&packet.CameraInstruction{Clear: protocol.Option(true)})
+}
+
 // addToPlayerList adds the player of a session to the player list of this session. It will be shown in the
 // in-game pause menu screen.
 func (s *Session) addToPlayerList(session *Session) {
@@ -563,62 +725,87 @@ func (s *Session) removeFromPlayerList(session *Session) {
 // HandleInventories starts handling the inventories of the Controllable entity of the session. It sends packets when
 // slots in the inventory are changed.
 func (s *Session) HandleInventories() (inv, offHand, enderChest *inventory.Inventory, armour *inventory.Armour, heldSlot *atomic.Uint32) {
-	s.inv = inventory.New(36, func(slot int, _, item item.Stack) {
-		if s.c == nil {
-			return
-		}
-		if slot == int(s.heldSlot.Load()) {
-			for _, viewer := range s.c.World().Viewers(s.c.Position()) {
-				viewer.ViewEntityItems(s.c)
-			}
-		}
-		if !s.inTransaction.Load() {
-			s.sendItem(item, slot, protocol.WindowIDInventory)
-		}
-	})
-	s.offHand = inventory.New(1, func(slot int, _, item item.Stack) {
-		if s.c == nil {
-			return
-		}
+	s.inv = inventory.New(36, s.handleInventorySlotChange)
+	s.offHand = inventory.New(1, s.handleOffHandSlotChange)
+	s.enderChest = inventory.New(27, s.handleEnderChestSlotChange)
+	s.armour = inventory.NewArmour(s.handleArmourSlotChange)
+	return s.inv, s.offHand, s.enderChest, s.armour, s.heldSlot
+}
+
+// ResumeInventories starts handling inventories previously handled by a different session, taking over the
+// inventories of a Controllable that was detached from its session after losing its connection. The contents
+// of the inventories are kept, but changes are sent to the connection of this session from now on.
+func (s *Session) ResumeInventories(inv, offHand, enderChest *inventory.Inventory, armour *inventory.Armour, heldSlot *atomic.Uint32) {
+	s.inv, s.offHand, s.enderChest, s.armour, s.heldSlot = inv, offHand, enderChest, armour, heldSlot
+	s.resumed = true
+
+	inv.SlotFunc(s.handleInventorySlotChange)
+	offHand.SlotFunc(s.handleOffHandSlotChange)
+	enderChest.SlotFunc(s.handleEnderChestSlotChange)
+	armour.Inventory().SlotFunc(s.handleArmourSlotChange)
+}
+
+// handleInventorySlotChange handles a change of a slot in the main inventory of the Controllable.
+func (s *Session) handleInventorySlotChange(slot int, _, item item.Stack) {
+	if s.c == nil {
+		return
+	}
+	if slot == int(s.heldSlot.Load()) {
 		for _, viewer := range s.c.World().Viewers(s.c.Position()) {
 			viewer.ViewEntityItems(s.c)
 		}
-		if !s.inTransaction.Load() {
-			i, _ := s.offHand.Item(0)
-			s.writePacket(&packet.InventoryContent{
-				WindowID: protocol.WindowIDOffHand,
-				Content: []protocol.ItemInstance{
-					instanceFromItem(i),
-				},
-			})
-		}
-	})
-	s.enderChest = inventory.New(27, func(slot int, _, item item.Stack) {
-		if s.c == nil {
-			return
-		}
-		if !s.inTransaction.Load() {
-			if _, ok := s.c.World().Block(s.openedPos.Load()).(block.EnderChest); ok {
-				s.ViewSlotChange(slot, item)
-			}
-		}
-	})
-	s.armour = inventory.NewArmour(func(slot int, before, after item.Stack) {
-		if s.c == nil {
-			return
-		}
-		if !s.inTransaction.Load() {
-			s.sendItem(after, slot, protocol.WindowIDArmour)
-		}
-		if before.Comparable(after) && before.Empty() == after.Empty() {
-			// Only send armour if the item type actually changed.
-			return
-		}
-		for _, viewer := range s.c.World().Viewers(s.c.Position()) {
-			viewer.ViewEntityArmour(s.c)
+	}
+	if !s.inTransaction.Load() {
+		s.sendItem(item, slot, protocol.WindowIDInventory)
+	}
+}
+
+// handleOffHandSlotChange handles a change of the item in the off-hand of the Controllable.
+func (s *Session) handleOffHandSlotChange(int, item.Stack, item.Stack) {
+	if s.c == nil {
+		return
+	}
+	for _, viewer := range s.c.World().Viewers(s.c.Position()) {
+		viewer.ViewEntityItems(s.c)
+	}
+	if !s.inTransaction.Load() {
+		i, _ := s.offHand.Item(0)
+		s.writePacket(&packet.InventoryContent{
+			WindowID: protocol.WindowIDOffHand,
+			Content: []protocol.ItemInstance{
+				instanceFromItem(i),
+			},
+		})
+	}
+}
+
+// handleEnderChestSlotChange handles a change of a slot in the ender chest inventory of the Controllable.
+func (s *Session) handleEnderChestSlotChange(slot int, _, item item.Stack) {
+	if s.c == nil {
+		return
+	}
+	if !s.inTransaction.Load() {
+		if _, ok := s.c.World().Block(s.openedPos.Load()).(block.EnderChest); ok {
+			s.ViewSlotChange(slot, item)
 		}
-	})
-	return s.inv, s.offHand, s.enderChest, s.armour, s.heldSlot
+	}
+}
+
+// handleArmourSlotChange handles a change of a slot in the armour inventory of the Controllable.
+func (s *Session) handleArmourSlotChange(slot int, before, after item.Stack) {
+	if s.c == nil {
+		return
+	}
+	if !s.inTransaction.Load() {
+		s.sendItem(after, slot, protocol.WindowIDArmour)
+	}
+	if before.Comparable(after) && before.Empty() == after.Empty() {
+		// Only send armour if the item type actually changed.
+		return
+	}
+	for _, viewer := range s.c.World().Viewers(s.c.Position()) {
+		viewer.ViewEntityArmour(s.c)
+	}
 }
 
 // SetHeldSlot sets the currently held hotbar slot.
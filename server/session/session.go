@@ -7,12 +7,12 @@ import (
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/cmd"
 	"github.com/df-mc/dragonfly/server/internal/sliceutil"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/player/chat"
-	"github.com/df-mc/dragonfly/server/player/form"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft"
@@ -23,6 +23,7 @@ import (
 	"github.com/sandertv/gophertunnel/minecraft/text"
 	"io"
 	"net"
+	"slices"
 	"sync"
 	"time"
 )
@@ -40,6 +41,19 @@ type Session struct {
 	// onStop is called when the session is stopped. The controllable passed is the controllable that the
 	// session controls.
 	onStop func(controllable Controllable)
+	// detach is called when the connection of the session is lost. If it returns true, the controllable is
+	// kept in its world so that it may be resumed by a different session.
+	detach func(controllable Controllable) bool
+	// commands holds the commands available to the session in addition to those registered globally.
+	commands map[string]cmd.Command
+	// detached is true if the session was detached from its controllable after losing its connection.
+	// disconnecting is true if the server ended the connection of the session, in which case it is never
+	// detached.
+	detached, disconnecting atomic.Bool
+
+	packetsReceived, packetsSent atomic.Uint64
+	// resumed is true if the session took over the controllable of a session that was detached.
+	resumed bool
 
 	currentScoreboard atomic.Value[string]
 	currentLines      atomic.Value[[]string]
@@ -70,6 +84,12 @@ type Session struct {
 	openedContainerID              atomic.Uint32
 	openedWindow                   atomic.Value[*inventory.Inventory]
 	openedPos                      atomic.Value[cube.Pos]
+	openedMerchant                 atomic.Value[*inventory.Merchant]
+	craftingMenu                   atomic.Value[*craftingMenu]
+	menuRecipes                    atomic.Uint32
+	signOpened                     atomic.Bool
+	openedSign                     atomic.Value[cube.Pos]
+	openedTrader                   atomic.Value[world.Entity]
 	swingingArm                    atomic.Bool
 
 	recipes map[uint32]recipe.Recipe
@@ -80,10 +100,47 @@ type Session struct {
 	invOpened             bool
 
 	joinMessage, quitMessage string
+	// silent is true if the session joined silently: Its Controllable is not added to the player list of other
+	// sessions and no join or quit messages are broadcast for it.
+	silent bool
+	// vanishBypass is true if the session is able to see the Controllables of vanished sessions.
+	vanishBypass atomic.Bool
+
+	// rewind specifies if the client uses server authoritative movement with rewind. If true, movement that
+	// the server disagrees with is corrected using packet.CorrectPlayerMovePrediction.
+	rewind bool
+	// inputTick holds the client tick of the last packet.PlayerAuthInput received.
+	inputTick atomic.Uint64
 
 	closeBackground chan struct{}
 }
 
+// Config holds options that a Session may be created with.
+type Config struct {
+	// Log is the Logger that debug messages and errors are written to when handling packets fails.
+	Log Logger
+	// MaxChunkRadius is the maximum chunk radius that the client of the Session may request.
+	MaxChunkRadius int
+	// JoinMessage and QuitMessage are the messages broadcast when the Session is spawned and closed
+	// respectively. They may have a '%v' argument, which is replaced with the name of the player. If left
+	// empty, no message is broadcast.
+	JoinMessage, QuitMessage string
+	// MovementRewind specifies if the client of the Session uses server authoritative movement with rewind,
+	// as set in the StartGame packet. If true, movement that is rejected by the server is corrected by
+	// rewinding the client to the position the server expects, rather than teleporting it.
+	MovementRewind bool
+	// Detach, if not nil, is called when the connection of the Session is lost without the Session being
+	// disconnected by the server, such as when the connection of the client times out. If Detach returns
+	// true, the Controllable of the Session is not closed, but kept in its world without a connection, so
+	// that a new Session may take over its inventories using Session.ResumeInventories. The Controllable is
+	// closed as usual once it is closed or disconnected afterwards.
+	Detach func(c Controllable) bool
+	// Commands holds commands available to the Session in addition to those registered using cmd.Register,
+	// indexed by their name and aliases. If a command in Commands has the same name or alias as a registered
+	// command, the command in Commands is used.
+	Commands map[string]cmd.Command
+}
+
 // Conn represents a connection that packets are read from and written to by a Session. In addition, it holds some
 // information on the identity of the Session.
 type Conn interface {
@@ -138,11 +195,21 @@ var errSelfRuntimeID = errors.New("invalid entity runtime ID: runtime ID for sel
 // New returns a new session using a controllable entity. The session will control this entity using the
 // packets that it receives.
 // New takes the connection from which to accept packets. It will start handling these packets after a call to
-// Session.Spawn().
+// Session.Spawn(). Sessions with additional options may be created using Config.New.
 func New(conn Conn, maxChunkRadius int, log Logger, joinMessage, quitMessage string) *Session {
+	return Config{
+		Log:            log,
+		MaxChunkRadius: maxChunkRadius,
+		JoinMessage:    joinMessage,
+		QuitMessage:    quitMessage,
+	}.New(conn)
+}
+
+// New returns a new session using the options in the Config, like the package level New function.
+func (conf Config) New(conn Conn) *Session {
 	r := conn.ChunkRadius()
-	if r > maxChunkRadius {
-		r = maxChunkRadius
+	if r > conf.MaxChunkRadius {
+		r = conf.MaxChunkRadius
 		_ = conn.WritePacket(&packet.ChunkRadiusUpdated{ChunkRadius: int32(r)})
 	}
 
@@ -157,13 +224,16 @@ func New(conn Conn, maxChunkRadius int, log Logger, joinMessage, quitMessage str
 		hiddenEntities:         map[world.Entity]struct{}{},
 		blobs:                  map[uint64][]byte{},
 		chunkRadius:            int32(r),
-		maxChunkRadius:         int32(maxChunkRadius),
+		maxChunkRadius:         int32(conf.MaxChunkRadius),
 		conn:                   conn,
-		log:                    log,
+		log:                    conf.Log,
 		currentEntityRuntimeID: 1,
 		heldSlot:               atomic.NewUint32(0),
-		joinMessage:            joinMessage,
-		quitMessage:            quitMessage,
+		joinMessage:            conf.JoinMessage,
+		quitMessage:            conf.QuitMessage,
+		rewind:                 conf.MovementRewind,
+		detach:                 conf.Detach,
+		commands:               conf.Commands,
 		openedWindow:           *atomic.NewValue(inventory.New(1, nil)),
 	}
 
@@ -177,6 +247,9 @@ func (s *Session) Spawn(c Controllable, pos mgl64.Vec3, w *world.World, gm world
 	s.onStop = onStop
 	s.c = c
 	s.recipes = make(map[uint32]recipe.Recipe)
+	for index, r := range recipe.Recipes() {
+		s.recipes[uint32(index)+1] = r
+	}
 	s.entityRuntimeIDs[c] = selfEntityRuntimeID
 	s.entities[selfEntityRuntimeID] = c
 
@@ -188,8 +261,7 @@ func (s *Session) Spawn(c Controllable, pos mgl64.Vec3, w *world.World, gm world
 	})
 
 	s.sendAvailableEntities(w)
-
-	s.initPlayerList()
+	s.writePacket(&packet.CameraPresets{Presets: []protocol.CameraPreset{{Name: freeCameraPreset}}})
 
 	world_add(c, w)
 	s.c.SetGameMode(gm)
@@ -199,9 +271,6 @@ func (s *Session) Spawn(c Controllable, pos mgl64.Vec3, w *world.World, gm world
 	}
 
 	chat.Global.Subscribe(c)
-	if s.joinMessage != "" {
-		_, _ = fmt.Fprintln(chat.Global, text.Colourf("<yellow>%v</yellow>", fmt.Sprintf(s.joinMessage, s.conn.IdentityData().DisplayName)))
-	}
 
 	s.sendInv(s.inv, protocol.WindowIDInventory)
 	s.sendInv(s.ui, protocol.WindowIDUI)
@@ -211,9 +280,14 @@ func (s *Session) Spawn(c Controllable, pos mgl64.Vec3, w *world.World, gm world
 	s.sendRecipes()
 }
 
-// Start makes the session start handling incoming packets from the client.
+// Start makes the session start handling incoming packets from the client and adds its Controllable to the
+// player list of other sessions. If the session resumed a Controllable that was detached from a different
+// session, the Controllable is already in its world and is not added again.
 func (s *Session) Start() {
-	s.c.World().AddEntity(s.c)
+	s.initPlayerList()
+	if !s.resumed {
+		s.c.World().AddEntity(s.c)
+	}
 	go s.handlePackets()
 }
 
@@ -233,14 +307,7 @@ func (s *Session) Close() error {
 // manages.
 func (s *Session) close() {
 	_ = s.c.Close()
-
-	// Move UI inventory items to the main inventory.
-	for _, it := range s.ui.Items() {
-		if _, err := s.inv.AddItem(it); err != nil {
-			// We couldn't add the item to the main inventory (probably because it was full), so we drop it instead.
-			s.c.Drop(it)
-		}
-	}
+	s.returnUIItems()
 
 	s.onStop(s.c)
 
@@ -259,10 +326,64 @@ func (s *Session) close() {
 	s.entityRuntimeIDs, s.entities = map[world.Entity]uint64{}, map[uint64]world.Entity{}
 	s.entityMutex.Unlock()
 
-	if s.quitMessage != "" {
-		_, _ = fmt.Fprintln(chat.Global, text.Colourf("<yellow>%v</yellow>", fmt.Sprintf(s.quitMessage, s.conn.IdentityData().DisplayName)))
-	}
 	chat.Global.Unsubscribe(s.c)
+	vanished.Delete(s.c)
+
+	stats := s.Stats()
+	s.log.Debugf("closed connection %v (%v): %v packets in (%vB), %v packets out (%vB), compression ratio %.2f, latency %v\n", s.conn.RemoteAddr(), s.c.Name(), stats.PacketsReceived, stats.BytesReceived, stats.PacketsSent, stats.BytesSent, stats.CompressionRatio(), stats.Latency)
+}
+
+// JoinSilently makes the session join without a join message and without adding its Controllable to the
+// player list of other sessions. Its quit message is not broadcast either. JoinSilently must be called before
+// Start to have an effect.
+func (s *Session) JoinSilently() {
+	s.silent = true
+}
+
+// JoinMessage returns the message that should be broadcast when the session joins. An empty string is
+// returned if no message should be broadcast, such as when the session joined silently, is vanished or
+// resumed a detached Controllable.
+func (s *Session) JoinMessage() string {
+	if s.joinMessage == "" || s.silent || s.resumed || s.Vanished() {
+		return ""
+	}
+	return text.Colourf("<yellow>%v</yellow>", fmt.Sprintf(s.joinMessage, s.conn.IdentityData().DisplayName))
+}
+
+// QuitMessage returns the message that should be broadcast when the session quits. An empty string is
+// returned if no message should be broadcast, such as when the session joined silently or is vanished.
+func (s *Session) QuitMessage() string {
+	if s.quitMessage == "" || s.silent || s.Vanished() {
+		return ""
+	}
+	return text.Colourf("<yellow>%v</yellow>", fmt.Sprintf(s.quitMessage, s.conn.IdentityData().DisplayName))
+}
+
+// returnUIItems moves the items in the UI inventory back to the main inventory, dropping them if the main
+// inventory is full.
+func (s *Session) returnUIItems() {
+	for _, it := range s.ui.Items() {
+		if _, err := s.inv.AddItem(it); err != nil {
+			// We couldn't add the item to the main inventory (probably because it was full), so we drop it instead.
+			s.c.Drop(it)
+		}
+	}
+	_ = s.ui.Clear()
+}
+
+// detachControllable detaches the session from its Controllable after its connection was lost, if the Detach
+// function of the Config allows it. The Controllable is left in its world without a connection. False is
+// returned if the session should be closed instead.
+func (s *Session) detachControllable() bool {
+	if s.detach == nil || s.disconnecting.Load() || !s.detach(s.c) {
+		return false
+	}
+	s.CloseConnection()
+	s.returnUIItems()
+	s.closeCurrentContainer()
+	_ = s.chunkLoader.Close()
+	s.detached.Store(true)
+	return true
 }
 
 // CloseConnection closes the underlying connection of the session so that the session ends up being closed
@@ -272,6 +393,11 @@ func (s *Session) CloseConnection() {
 		_ = s.conn.Close()
 		s.closeBackground <- struct{}{}
 	})
+	if s.detached.Load() {
+		// The session was detached after its connection was lost, so it no longer handles packets and will not
+		// close by itself. We close it here instead.
+		go s.Close()
+	}
 }
 
 // Addr returns the net.Addr of the client.
@@ -284,6 +410,12 @@ func (s *Session) Latency() time.Duration {
 	return s.conn.Latency()
 }
 
+// ChunkRadius returns the chunk radius used for the client, which is the render distance it requested, limited to
+// the maximum chunk radius of the Session.
+func (s *Session) ChunkRadius() int {
+	return int(s.chunkRadius)
+}
+
 // ClientData returns the login.ClientData of the underlying *minecraft.Conn.
 func (s *Session) ClientData() login.ClientData {
 	return s.conn.ClientData()
@@ -302,6 +434,9 @@ func (s *Session) handlePackets() {
 		if err := recover(); err != nil {
 			panic(err)
 		}
+		if s.detachControllable() {
+			return
+		}
 		_ = s.Close()
 	}()
 	for {
@@ -309,6 +444,7 @@ func (s *Session) handlePackets() {
 		if err != nil {
 			return
 		}
+		s.packetsReceived.Inc()
 		if err := s.handlePacket(pk); err != nil {
 			// An error occurred during the handling of a packet. Print the error and stop handling any more
 			// packets.
@@ -339,6 +475,7 @@ func (s *Session) background() {
 				// Enum resending happens relatively often and frequent updates are more important than with full
 				// command changes. Those are generally only related to permission changes, which doesn't happen often.
 				s.resendEnums(enums, enumValues)
+				s.handlers[packet.IDModalFormResponse].(*ModalFormResponseHandler).tick(s)
 			}
 			if i%100 == 0 {
 				// Try to resend commands only every 5 seconds.
@@ -396,6 +533,7 @@ func (s *Session) handleWorldSwitch(w *world.World) {
 	dim, _ := world.DimensionID(w.Dimension())
 	same := w.Dimension() == s.chunkLoader.World().Dimension()
 	if !same {
+		s.sendDimensionData(w)
 		s.changeDimension(int32(dim), false)
 	}
 	s.ViewEntityTeleport(s.c, s.c.Position())
@@ -438,37 +576,39 @@ func (s *Session) handlePacket(pk packet.Packet) error {
 // registerHandlers registers all packet handlers found in the packetHandler package.
 func (s *Session) registerHandlers() {
 	s.handlers = map[uint32]packetHandler{
-		packet.IDActorEvent:            nil,
-		packet.IDAdventureSettings:     nil, // Deprecated, the client still sends this though.
-		packet.IDAnimate:               nil,
-		packet.IDAnvilDamage:           nil,
-		packet.IDBlockActorData:        &BlockActorDataHandler{},
-		packet.IDBlockPickRequest:      &BlockPickRequestHandler{},
-		packet.IDBookEdit:              &BookEditHandler{},
-		packet.IDBossEvent:             nil,
-		packet.IDClientCacheBlobStatus: &ClientCacheBlobStatusHandler{},
-		packet.IDCommandRequest:        &CommandRequestHandler{},
-		packet.IDContainerClose:        &ContainerCloseHandler{},
-		packet.IDEmote:                 &EmoteHandler{},
-		packet.IDEmoteList:             nil,
-		packet.IDFilterText:            nil,
-		packet.IDInteract:              &InteractHandler{},
-		packet.IDInventoryTransaction:  &InventoryTransactionHandler{},
-		packet.IDItemFrameDropItem:     nil,
-		packet.IDItemStackRequest:      &ItemStackRequestHandler{changes: map[byte]map[byte]changeInfo{}, responseChanges: map[int32]map[*inventory.Inventory]map[byte]responseChange{}},
-		packet.IDLecternUpdate:         &LecternUpdateHandler{},
-		packet.IDMobEquipment:          &MobEquipmentHandler{},
-		packet.IDModalFormResponse:     &ModalFormResponseHandler{forms: make(map[uint32]form.Form)},
-		packet.IDMovePlayer:            nil,
-		packet.IDPlayerAction:          &PlayerActionHandler{},
-		packet.IDPlayerAuthInput:       &PlayerAuthInputHandler{},
-		packet.IDPlayerSkin:            &PlayerSkinHandler{},
-		packet.IDRequestAbility:        &RequestAbilityHandler{},
-		packet.IDRequestChunkRadius:    &RequestChunkRadiusHandler{},
-		packet.IDRespawn:               &RespawnHandler{},
-		packet.IDSubChunkRequest:       &SubChunkRequestHandler{},
-		packet.IDText:                  &TextHandler{},
-		packet.IDTickSync:              nil,
+		packet.IDActorEvent:                      nil,
+		packet.IDAdventureSettings:               nil, // Deprecated, the client still sends this though.
+		packet.IDAnimate:                         nil,
+		packet.IDAnvilDamage:                     nil,
+		packet.IDBlockActorData:                  &BlockActorDataHandler{},
+		packet.IDBlockPickRequest:                &BlockPickRequestHandler{},
+		packet.IDBookEdit:                        &BookEditHandler{},
+		packet.IDBossEvent:                       nil,
+		packet.IDClientCacheBlobStatus:           &ClientCacheBlobStatusHandler{},
+		packet.IDCommandRequest:                  &CommandRequestHandler{},
+		packet.IDContainerClose:                  &ContainerCloseHandler{},
+		packet.IDEmote:                           &EmoteHandler{},
+		packet.IDEmoteList:                       nil,
+		packet.IDFilterText:                      nil,
+		packet.IDInteract:                        &InteractHandler{},
+		packet.IDInventoryTransaction:            &InventoryTransactionHandler{},
+		packet.IDItemFrameDropItem:               nil,
+		packet.IDItemStackRequest:                &ItemStackRequestHandler{changes: map[byte]map[byte]changeInfo{}, responseChanges: map[int32]map[*inventory.Inventory]map[byte]responseChange{}},
+		packet.IDLecternUpdate:                   &LecternUpdateHandler{},
+		packet.IDMobEquipment:                    &MobEquipmentHandler{},
+		packet.IDModalFormResponse:               &ModalFormResponseHandler{},
+		packet.IDMovePlayer:                      nil,
+		packet.IDPlayerAction:                    &PlayerActionHandler{},
+		packet.IDPlayerAuthInput:                 &PlayerAuthInputHandler{},
+		packet.IDPlayerSkin:                      &PlayerSkinHandler{},
+		packet.IDPositionTrackingDBClientRequest: &PositionTrackingDBClientRequestHandler{},
+		packet.IDRequestAbility:                  &RequestAbilityHandler{},
+		packet.IDRequestChunkRadius:              &RequestChunkRadiusHandler{},
+		packet.IDRespawn:                         &RespawnHandler{},
+		packet.IDShowCredits:                     &ShowCreditsHandler{},
+		packet.IDSubChunkRequest:                 &SubChunkRequestHandler{},
+		packet.IDText:                            &TextHandler{},
+		packet.IDTickSync:                        nil,
 	}
 }
 
@@ -489,6 +629,7 @@ func (s *Session) writePacket(pk packet.Packet) {
 	if s == Nop {
 		return
 	}
+	s.packetsSent.Inc()
 	_ = s.conn.WritePacket(pk)
 }
 
@@ -496,12 +637,30 @@ func (s *Session) writePacket(pk packet.Packet) {
 // sessions currently open.
 func (s *Session) initPlayerList() {
 	sessionMu.Lock()
+	if s.resumed {
+		if i := slices.IndexFunc(sessions, func(other *Session) bool { return other.c == s.c }); i != -1 {
+			// The session took over the controllable of a detached session. All other sessions already have the
+			// controllable in their player list, so we only replace the detached session.
+			s.silent = sessions[i].silent
+			sessions[i] = s
+			for _, session := range sessions {
+				if session.listedFor(s) {
+					s.addToPlayerList(session)
+				}
+			}
+			sessionMu.Unlock()
+			return
+		}
+	}
 	sessions = append(sessions, s)
 	for _, session := range sessions {
 		// AddStack the player of the session to all sessions currently open, and add the players of all sessions
-		// currently open to the player list of the new session.
-		session.addToPlayerList(s)
-		if s != session {
+		// currently open to the player list of the new session. Sessions that joined silently or are vanished
+		// are not added to the player list of other sessions.
+		if s.listedFor(session) {
+			session.addToPlayerList(s)
+		}
+		if s != session && session.listedFor(s) {
 			s.addToPlayerList(session)
 		}
 	}
@@ -520,6 +679,35 @@ func (s *Session) closePlayerList() {
 	sessionMu.Unlock()
 }
 
+// sendDimensionData sends the range of the world.World passed to the player, so that the client allows building
+// and renders chunks within the same vertical range as the World. The client only applies the range when it
+// changes dimension, so it must be sent before the dimension of the player changes.
+func (s *Session) sendDimensionData(w *world.World) {
+	if pk, ok := DimensionData(w); ok {
+		s.writePacket(pk)
+	}
+}
+
+// DimensionData returns a packet.DimensionData holding the range of the world.World passed. The client only
+// applies the range when the game starts or when it changes dimension, so the packet must be written to a Conn
+// before the game is started with the World. False is returned if the dimension of the World has no definition.
+func DimensionData(w *world.World) (*packet.DimensionData, bool) {
+	var def protocol.DimensionDefinition
+	switch w.Dimension() {
+	case world.Overworld:
+		def = protocol.DimensionDefinition{Name: "minecraft:overworld", Generator: protocol.GeneratorOverworld}
+	case world.Nether:
+		def = protocol.DimensionDefinition{Name: "minecraft:nether", Generator: protocol.GeneratorNether}
+	case world.End:
+		def = protocol.DimensionDefinition{Name: "minecraft:the_end", Generator: protocol.GeneratorEnd}
+	default:
+		return nil, false
+	}
+	r := w.Range()
+	def.Range = [2]int32{int32(r.Min()), int32(r.Max() + 1)}
+	return &packet.DimensionData{Definitions: []protocol.DimensionDefinition{def}}, true
+}
+
 // actorIdentifier represents the structure of an actor identifier sent over the network.
 type actorIdentifier struct {
 	// ID is a unique namespaced identifier for the entity.
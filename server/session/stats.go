@@ -0,0 +1,55 @@
+package session
+
+import (
+	"time"
+)
+
+// Stats holds statistics on the network traffic of the connection of a Session. A snapshot of these
+// statistics may be obtained by calling Session.Stats.
+type Stats struct {
+	// PacketsReceived and PacketsSent are the amount of packets read from and written to the connection.
+	PacketsReceived, PacketsSent uint64
+	// PayloadReceived and PayloadSent are the total size in bytes of the packets received and sent, before
+	// compression. They are only tracked if the Conn of the Session implements TrafficCounter.
+	PayloadReceived, PayloadSent uint64
+	// BytesReceived and BytesSent are the amount of bytes received and sent over the network, after
+	// compression and encryption. They are only tracked if the Conn of the Session implements TrafficCounter.
+	BytesReceived, BytesSent uint64
+	// Latency is the current latency of the connection. It is half the round trip time (RTT).
+	Latency time.Duration
+}
+
+// CompressionRatio returns the ratio between the size of the packets sent and the amount of bytes actually
+// sent over the network. A ratio of 4 means that packets were, on average, compressed to a quarter of their
+// size. If no traffic data is available, CompressionRatio returns 1.
+func (s Stats) CompressionRatio() float64 {
+	if s.BytesSent == 0 || s.PayloadSent == 0 {
+		return 1
+	}
+	return float64(s.PayloadSent) / float64(s.BytesSent)
+}
+
+// TrafficCounter may be implemented by a Conn to report the amount of data transferred over it. A Session
+// uses it to fill out the byte counts of its Stats.
+type TrafficCounter interface {
+	// Traffic returns the total size of the packets received and sent before compression, followed by the
+	// amount of bytes received and sent over the network.
+	Traffic() (payloadReceived, payloadSent, bytesReceived, bytesSent uint64)
+}
+
+// Stats returns a snapshot of the network statistics of the Session, such as the amount of packets and bytes
+// it has sent and received. Stats may be used to find clients using an unexpected amount of bandwidth.
+func (s *Session) Stats() Stats {
+	if s == Nop {
+		return Stats{}
+	}
+	stats := Stats{
+		PacketsReceived: s.packetsReceived.Load(),
+		PacketsSent:     s.packetsSent.Load(),
+		Latency:         s.conn.Latency(),
+	}
+	if t, ok := s.conn.(TrafficCounter); ok {
+		stats.PayloadReceived, stats.PayloadSent, stats.BytesReceived, stats.BytesSent = t.Traffic()
+	}
+	return stats
+}
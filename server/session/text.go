@@ -15,6 +15,16 @@ func (s *Session) SendMessage(message string) {
 	})
 }
 
+// SendTranslation ...
+func (s *Session) SendTranslation(key string, params []string) {
+	s.writePacket(&packet.Text{
+		TextType:         packet.TextTypeTranslation,
+		NeedsTranslation: true,
+		Message:          key,
+		Parameters:       params,
+	})
+}
+
 // SendTip ...
 func (s *Session) SendTip(message string) {
 	s.writePacket(&packet.Text{
@@ -55,6 +65,14 @@ func (s *Session) SendToast(title, message string) {
 	})
 }
 
+// SendCredits ...
+func (s *Session) SendCredits() {
+	s.writePacket(&packet.ShowCredits{
+		PlayerRuntimeID: selfEntityRuntimeID,
+		StatusType:      packet.ShowCreditsStatusStart,
+	})
+}
+
 // SendScoreboard ...
 func (s *Session) SendScoreboard(sb *scoreboard.Scoreboard) {
 	if s == Nop {
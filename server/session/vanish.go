@@ -0,0 +1,127 @@
+package session
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"slices"
+	"sync"
+)
+
+// vanished holds the Controllables of all sessions that are vanished. It is indexed by the Controllable, so
+// that a Controllable stays vanished if a different session takes it over after resuming.
+var vanished sync.Map
+
+// Vanish hides the Controllable of the Session from all other sessions, except for those that have the vanish
+// bypass enabled using SetVanishBypass. A vanished Controllable is not shown in the world or player list of
+// other sessions and no join or quit messages are broadcast for it. Vanish does nothing if the Controllable
+// is already vanished.
+func (s *Session) Vanish() {
+	if s == Nop {
+		return
+	}
+	if _, ok := vanished.LoadOrStore(s.c, struct{}{}); ok {
+		return
+	}
+	for _, other := range otherSessions(s) {
+		if !other.vanishBypass.Load() {
+			s.hideFrom(other)
+		}
+	}
+}
+
+// Unvanish makes the Controllable of the Session, previously vanished using Vanish, visible to all other
+// sessions again. Unvanish does nothing if the Controllable is not vanished.
+func (s *Session) Unvanish() {
+	if s == Nop {
+		return
+	}
+	if _, ok := vanished.LoadAndDelete(s.c); !ok {
+		return
+	}
+	for _, other := range otherSessions(s) {
+		if !other.vanishBypass.Load() {
+			s.showTo(other)
+		}
+	}
+}
+
+// Vanished checks if the Controllable of the Session is currently vanished.
+func (s *Session) Vanished() bool {
+	if s == Nop {
+		return false
+	}
+	_, ok := vanished.Load(s.c)
+	return ok
+}
+
+// SetVanishBypass changes if the Session is able to see the Controllables of sessions that are vanished.
+func (s *Session) SetVanishBypass(v bool) {
+	if s == Nop || s.vanishBypass.Swap(v) == v {
+		return
+	}
+	for _, other := range otherSessions(s) {
+		if !other.Vanished() {
+			continue
+		}
+		if v {
+			other.showTo(s)
+		} else {
+			other.hideFrom(s)
+		}
+	}
+}
+
+// VanishBypass checks if the Session is able to see the Controllables of sessions that are vanished.
+func (s *Session) VanishBypass() bool {
+	return s.vanishBypass.Load()
+}
+
+// vanishedFrom checks if the world.Entity passed is the Controllable of a vanished session that should be
+// hidden from the Session.
+func (s *Session) vanishedFrom(e world.Entity) bool {
+	if e == s.c || s.vanishBypass.Load() {
+		return false
+	}
+	_, ok := vanished.Load(e)
+	return ok
+}
+
+// listedFor checks if the Controllable of the Session should be in the player list of the Session viewer
+// passed.
+func (s *Session) listedFor(viewer *Session) bool {
+	if s == viewer {
+		return true
+	}
+	return !s.silent && (viewer.vanishBypass.Load() || !s.Vanished())
+}
+
+// hideFrom removes the Controllable of the Session from the world and the player list of the Session viewer
+// passed.
+func (s *Session) hideFrom(viewer *Session) {
+	viewer.HideEntity(s.c)
+	viewer.removeFromPlayerList(s)
+}
+
+// showTo adds the Controllable of the Session to the player list of the Session viewer passed and shows it in
+// its world if the viewer has the chunk that the Controllable is in loaded.
+func (s *Session) showTo(viewer *Session) {
+	if !s.silent {
+		viewer.addToPlayerList(s)
+	}
+	if slices.Contains(s.c.World().Viewers(s.c.Position()), world.Viewer(viewer)) {
+		viewer.ViewEntity(s.c)
+		viewer.ViewEntityState(s.c)
+	}
+}
+
+// otherSessions returns all open sessions other than the Session passed. If the Session passed was not yet
+// started, nil is returned, as the player lists are set up according to its vanish state once it starts.
+func otherSessions(s *Session) []*Session {
+	sessionMu.Lock()
+	defer sessionMu.Unlock()
+	if !slices.Contains(sessions, s) {
+		return nil
+	}
+	return slices.DeleteFunc(slices.Clone(sessions), func(other *Session) bool {
+		return other == s
+	})
+}
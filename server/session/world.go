@@ -19,6 +19,7 @@ import (
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 )
@@ -38,12 +39,13 @@ type OffsetEntity interface {
 	NetworkOffset() float64
 }
 
-// entityHidden checks if a world.Entity is being explicitly hidden from the Session.
+// entityHidden checks if a world.Entity is being explicitly hidden from the Session, or if it is the
+// Controllable of a vanished session.
 func (s *Session) entityHidden(e world.Entity) bool {
 	s.entityMutex.RLock()
 	_, ok := s.hiddenEntities[e]
 	s.entityMutex.RUnlock()
-	return ok
+	return ok || s.vanishedFrom(e)
 }
 
 // ViewEntity ...
@@ -131,7 +133,7 @@ func (s *Session) ViewEntity(e world.Entity) {
 				EntityMetadata:  metadata,
 			})
 			return
-		case entity.TextType:
+		case entity.TextType, entity.HologramLineType:
 			metadata[protocol.EntityDataKeyVariant] = int32(world.BlockRuntimeID(block.Air{}))
 		case entity.FallingBlockType:
 			metadata[protocol.EntityDataKeyVariant] = int32(world.BlockRuntimeID(v.Behaviour().(*entity.FallingBlockBehaviour).Block()))
@@ -237,6 +239,26 @@ func (s *Session) ViewTime(time int) {
 	s.writePacket(&packet.SetTime{Time: int32(time)})
 }
 
+// CorrectMovement corrects the position of the Controllable of the Session to the position passed if the
+// Session uses movement with rewind. The client is rewound to the position and velocity passed at the tick of
+// the last movement it sent, after which it replays its own input on top of it. Movement received from the
+// client is ignored until it has synced back to the position. CorrectMovement returns false if the Session does
+// not use movement with rewind, in which case the Controllable should be teleported instead.
+func (s *Session) CorrectMovement(pos, vel mgl64.Vec3, onGround bool) bool {
+	if s == Nop || !s.rewind {
+		return false
+	}
+	s.chunkLoader.Move(pos)
+	s.teleportPos.Store(&pos)
+	s.writePacket(&packet.CorrectPlayerMovePrediction{
+		Position: vec64To32(pos.Add(entityOffset(s.c))),
+		Delta:    vec64To32(vel),
+		OnGround: onGround,
+		Tick:     s.inputTick.Load(),
+	})
+	return true
+}
+
 // ViewEntityTeleport ...
 func (s *Session) ViewEntityTeleport(e world.Entity, position mgl64.Vec3) {
 	id := s.entityRuntimeID(e)
@@ -520,6 +542,21 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventExplode
 	case sound.Thunder:
 		pk.SoundType, pk.EntityType = packet.SoundEventThunder, "minecraft:lightning_bolt"
+	case sound.Custom:
+		volume, pitch := so.Volume, so.Pitch
+		if volume == 0 {
+			volume = 1
+		}
+		if pitch == 0 {
+			pitch = 1
+		}
+		s.writePacket(&packet.PlaySound{
+			SoundName: so.Name,
+			Position:  vec64To32(pos),
+			Volume:    float32(volume),
+			Pitch:     float32(pitch),
+		})
+		return
 	case sound.Click:
 		s.writePacket(&packet.LevelEvent{
 			EventType: packet.LevelEventSoundClick,
@@ -611,6 +648,14 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventExtinguishFire
 	case sound.Ignite:
 		pk.SoundType = packet.SoundEventIgnite
+	case sound.PowerOn:
+		pk.SoundType = packet.SoundEventPowerOn
+	case sound.PowerOff:
+		pk.SoundType = packet.SoundEventPowerOff
+	case sound.PistonExtend:
+		pk.SoundType = packet.SoundEventPistonOut
+	case sound.PistonRetract:
+		pk.SoundType = packet.SoundEventPistonIn
 	case sound.Burning:
 		pk.SoundType = packet.SoundEventPlayerHurtOnFire
 	case sound.Drowning:
@@ -666,10 +711,26 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventBarrelClose
 	case sound.BarrelOpen:
 		pk.SoundType = packet.SoundEventBarrelOpen
+	case sound.ShulkerBoxClose:
+		pk.SoundType = packet.SoundEventShulkerBoxClosed
+	case sound.ShulkerBoxOpen:
+		pk.SoundType = packet.SoundEventShulkerBoxOpen
+	case sound.BellRing:
+		pk.SoundType = packet.SoundEventBell
+	case sound.LodestoneCompassLink:
+		pk.SoundType = packet.SoundEventLinkCompassToLodestone
 	case sound.BlockBreaking:
 		pk.SoundType, pk.ExtraData = packet.SoundEventHit, int32(world.BlockRuntimeID(so.Block))
 	case sound.ItemBreak:
 		pk.SoundType = packet.SoundEventBreak
+	case sound.ShieldBlock:
+		pk.SoundType = packet.SoundEventShieldBlock
+	case sound.Totem:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventSoundTotemUsed,
+			Position:  vec64To32(pos),
+		})
+		return
 	case sound.ItemUseOn:
 		pk.SoundType, pk.ExtraData = packet.SoundEventItemUseOn, int32(world.BlockRuntimeID(so.Block))
 	case sound.Fizz:
@@ -693,8 +754,24 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 			break
 		}
 		pk.SoundType = packet.SoundEventBucketEmptyLava
+	case sound.PowderSnowBucketFill:
+		pk.SoundType = packet.SoundEventBucketFillPowderSnow
+	case sound.PowderSnowBucketEmpty:
+		pk.SoundType = packet.SoundEventBucketEmptyPowderSnow
+	case sound.BottleFill:
+		pk.SoundType = packet.SoundEventBottleFill
+	case sound.BottleEmpty:
+		pk.SoundType = packet.SoundEventBottleEmpty
 	case sound.BowShoot:
 		pk.SoundType = packet.SoundEventBow
+	case sound.CrossbowLoad:
+		t := uint32(packet.SoundEventCrossbowLoadingStart)
+		if so.QuickCharge {
+			t = uint32(packet.SoundEventCrossbowQuickChargeStart)
+		}
+		pk.SoundType = t + uint32(so.Stage)
+	case sound.CrossbowShoot:
+		pk.SoundType = packet.SoundEventCrossbowShoot
 	case sound.ArrowHit:
 		pk.SoundType = packet.SoundEventBowHit
 	case sound.ItemThrow:
@@ -773,6 +850,47 @@ func (s *Session) PlaySound(t world.Sound) {
 	s.playSound(entity.EyePosition(s.c), t, true)
 }
 
+// StopSound stops the sound with the name passed from playing to the client, such as a sound.Custom. If name
+// is empty, all sounds playing to the client are stopped.
+func (s *Session) StopSound(name string) {
+	s.writePacket(&packet.StopSound{SoundName: name, StopAll: name == ""})
+}
+
+// PlayMusic plays the sound.Music passed to the client. Unlike sounds, music is not positioned in the world,
+// and replaces the music the client is currently playing. The client repeats the track if it loops.
+func (s *Session) PlayMusic(m sound.Music) {
+	volume, repeatMode := m.Volume, int32(0)
+	if volume == 0 {
+		volume = 1
+	}
+	if m.Loop {
+		repeatMode = 1
+	}
+	s.writeMusicEvent(packet.LevelEventPlayCustomMusic, map[string]any{
+		"trackName":   m.Name,
+		"volume":      float32(volume),
+		"fadeSeconds": float32(m.Fade.Seconds()),
+		"repeatMode":  repeatMode,
+	})
+}
+
+// StopMusic stops the sound.Music currently playing to the client, fading it out over the duration passed.
+func (s *Session) StopMusic(fade time.Duration) {
+	s.writeMusicEvent(packet.LevelEventStopCustomMusic, map[string]any{"fadeSeconds": float32(fade.Seconds())})
+}
+
+// writeMusicEvent writes a custom music level event with the ID and data passed to the client.
+func (s *Session) writeMusicEvent(id int32, data map[string]any) {
+	b, err := nbt.MarshalEncoding(data, nbt.NetworkLittleEndian)
+	if err != nil {
+		s.log.Errorf("encode music event: %v", err)
+		return
+	}
+	// The data of a generic level event is not wrapped in a compound tag, so the compound tag type and
+	// empty name at the start and the end tag at the end are removed.
+	s.writePacket(&packet.LevelEventGeneric{EventID: id, SerialisedEventData: b[2 : len(b)-1]})
+}
+
 // ViewSound ...
 func (s *Session) ViewSound(pos mgl64.Vec3, soundType world.Sound) {
 	s.playSound(pos, soundType, false)
@@ -780,6 +898,8 @@ func (s *Session) ViewSound(pos mgl64.Vec3, soundType world.Sound) {
 
 // OpenSign ...
 func (s *Session) OpenSign(pos cube.Pos, frontSide bool) {
+	s.openedSign.Store(pos)
+	s.signOpened.Store(true)
 	blockPos := protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
 	s.writePacket(&packet.OpenSign{
 		Position:  blockPos,
@@ -877,6 +997,16 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 			EventType:       packet.ActorEventShake,
 			EventData:       int32(act.Duration.Milliseconds() / 50),
 		})
+	case entity.TotemUseAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventTalismanActivate,
+		})
+	case entity.WakeUpAction:
+		s.writePacket(&packet.Animate{
+			ActionType:      packet.AnimateActionStopSleep,
+			EntityRuntimeID: s.entityRuntimeID(e),
+		})
 	case entity.FireworkExplosionAction:
 		s.writePacket(&packet.ActorEvent{
 			EntityRuntimeID: s.entityRuntimeID(e),
@@ -929,7 +1059,9 @@ func (s *Session) OpenBlockContainer(pos cube.Pos) {
 	if s.containerOpened.Load() && s.openedPos.Load() == pos {
 		return
 	}
-	s.closeCurrentContainer()
+	s.c.CloseBlockContainer()
+	s.closeMerchant()
+	s.closeCraftingMenu()
 
 	w := s.c.World()
 	b := w.Block(pos)
@@ -979,6 +1111,24 @@ func (s *Session) OpenBlockContainer(pos cube.Pos) {
 	})
 }
 
+// OpenedBlockContainer returns the position of the block container currently opened by the Controllable of
+// the Session. False is returned if no block container is opened.
+func (s *Session) OpenedBlockContainer() (cube.Pos, bool) {
+	if s == Nop || !s.containerOpened.Load() {
+		return cube.Pos{}, false
+	}
+	return s.openedPos.Load(), true
+}
+
+// CloseBlockContainer closes the block container currently opened by the Controllable of the Session, if
+// any.
+func (s *Session) CloseBlockContainer() {
+	if s == Nop {
+		return
+	}
+	s.closeCurrentContainer()
+}
+
 // openNormalContainer opens a normal container that can hold items in it server-side.
 func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 	b.AddViewer(s, s.c.World(), pos)
@@ -996,6 +1146,8 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 		containerType = protocol.ContainerTypeBlastFurnace
 	case block.Smoker:
 		containerType = protocol.ContainerTypeSmoker
+	case block.Hopper:
+		containerType = protocol.ContainerTypeHopper
 	}
 
 	s.writePacket(&packet.ContainerOpen{
@@ -1005,6 +1157,11 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 		ContainerEntityUniqueID: -1,
 	})
 	s.sendInv(b.Inventory(), uint32(nextID))
+	if sm, ok := b.(smelter); ok {
+		// Send the current progress of the smelter, as only changes are sent to viewers afterwards.
+		remaining, maximum, cook := sm.Durations()
+		s.ViewFurnaceUpdate(0, cook, 0, remaining, 0, maximum)
+	}
 }
 
 // ViewSlotChange ...
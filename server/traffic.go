@@ -0,0 +1,151 @@
+package server
+
+import (
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/session"
+	"github.com/sandertv/gophertunnel/minecraft"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"net"
+	"sync"
+	"time"
+)
+
+// trafficNetworkID is the ID under which trafficNetwork is registered with gophertunnel.
+const trafficNetworkID = "dragonfly:raknet"
+
+func init() {
+	minecraft.RegisterNetwork(trafficNetworkID, trafficNetwork{})
+}
+
+// conns holds the traffic counters of all connections currently accepted by a trafficNetwork, indexed by the
+// string representation of their remote address.
+var conns sync.Map
+
+// traffic holds the traffic counters of a single connection.
+type traffic struct {
+	payloadReceived, payloadSent, bytesReceived, bytesSent atomic.Uint64
+}
+
+// trafficOf returns the traffic counters of the connection with the remote address passed.
+func trafficOf(addr net.Addr) (*traffic, bool) {
+	if addr == nil {
+		return nil, false
+	}
+	t, ok := conns.Load(addr.String())
+	if !ok {
+		return nil, false
+	}
+	return t.(*traffic), true
+}
+
+// countPayload is used as minecraft.ListenConfig.PacketFunc to count the uncompressed size of all packets sent
+// and received by connections of a trafficNetwork.
+func countPayload(_ packet.Header, payload []byte, src, dst net.Addr) {
+	if t, ok := trafficOf(src); ok {
+		t.payloadReceived.Add(uint64(len(payload)))
+	} else if t, ok := trafficOf(dst); ok {
+		t.payloadSent.Add(uint64(len(payload)))
+	}
+}
+
+// trafficNetwork is a minecraft.Network that wraps around minecraft.RakNet to keep track of the amount of bytes
+// sent and received over every connection accepted.
+type trafficNetwork struct {
+	minecraft.RakNet
+}
+
+// Listen ...
+func (n trafficNetwork) Listen(address string) (minecraft.NetworkListener, error) {
+	l, err := n.RakNet.Listen(address)
+	if err != nil {
+		return nil, err
+	}
+	return trafficListener{NetworkListener: l}, nil
+}
+
+// trafficListener wraps around a minecraft.NetworkListener so that all connections accepted by it are wrapped
+// by a trafficConn.
+type trafficListener struct {
+	minecraft.NetworkListener
+}
+
+// Accept ...
+func (l trafficListener) Accept() (net.Conn, error) {
+	c, err := l.NetworkListener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	r, ok := c.(packetReader)
+	if !ok {
+		// Connections that cannot read packets directly are not counted.
+		return c, nil
+	}
+	t := &traffic{}
+	conns.Store(c.RemoteAddr().String(), t)
+	return &trafficConn{Conn: c, r: r, t: t}, nil
+}
+
+// packetReader is a net.Conn that is able to read a single packet at a time, such as a RakNet connection.
+type packetReader interface {
+	ReadPacket() ([]byte, error)
+}
+
+// trafficConn is a net.Conn that counts the bytes read from and written to it.
+type trafficConn struct {
+	net.Conn
+	r    packetReader
+	t    *traffic
+	once sync.Once
+}
+
+// ReadPacket ...
+func (c *trafficConn) ReadPacket() ([]byte, error) {
+	b, err := c.r.ReadPacket()
+	c.t.bytesReceived.Add(uint64(len(b)))
+	return b, err
+}
+
+// Read ...
+func (c *trafficConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.t.bytesReceived.Add(uint64(n))
+	return n, err
+}
+
+// Write ...
+func (c *trafficConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.t.bytesSent.Add(uint64(n))
+	return n, err
+}
+
+// Latency returns the latency of the underlying connection, or 0 if it does not keep track of it.
+func (c *trafficConn) Latency() time.Duration {
+	if l, ok := c.Conn.(interface{ Latency() time.Duration }); ok {
+		return l.Latency()
+	}
+	return 0
+}
+
+// Close closes the underlying connection and stops tracking its traffic.
+func (c *trafficConn) Close() error {
+	c.once.Do(func() {
+		conns.CompareAndDelete(c.RemoteAddr().String(), c.t)
+	})
+	return c.Conn.Close()
+}
+
+// countedConn wraps around a *minecraft.Conn accepted from a trafficNetwork and implements
+// session.TrafficCounter, so that the Stats of a session include the bytes transferred over the connection.
+type countedConn struct {
+	*minecraft.Conn
+	t *traffic
+}
+
+// Compile time check to make sure countedConn implements session.TrafficCounter.
+var _ session.TrafficCounter = countedConn{}
+
+// Traffic ...
+func (c countedConn) Traffic() (payloadReceived, payloadSent, bytesReceived, bytesSent uint64) {
+	return c.t.payloadReceived.Load(), c.t.payloadSent.Load(), c.t.bytesReceived.Load(), c.t.bytesSent.Load()
+}
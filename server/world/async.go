@@ -0,0 +1,113 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"runtime"
+)
+
+// asyncWorkers limits the amount of functions passed to World.Async that may run simultaneously, across all
+// worlds, to the amount of CPUs available.
+var asyncWorkers = make(chan struct{}, runtime.NumCPU())
+
+// Async runs f on a worker goroutine, so that expensive work, such as rendering a map, preparing a large
+// structure or rolling a loot table with a large pool, does not slow down the ticking of the World. f should
+// not modify the World itself. Instead, the function returned by f is run on the goroutine that ticks the
+// World at the start of the next tick, so that its results may be applied to the World in a predictable
+// order, as if they were applied by a block or entity tick.
+// If f returns nil, nothing is run on the World. If the World is closed before f has finished, the function
+// it returns is never run.
+func (w *World) Async(f func() func(w *World)) {
+	if w == nil {
+		return
+	}
+	go func() {
+		select {
+		case asyncWorkers <- struct{}{}:
+		case <-w.closing:
+			return
+		}
+		apply := f()
+		<-asyncWorkers
+
+		if apply == nil {
+			return
+		}
+		w.asyncMu.Lock()
+		w.asyncResults = append(w.asyncResults, apply)
+		w.asyncMu.Unlock()
+	}()
+}
+
+// applyAsync runs the functions returned by functions passed to World.Async that finished since the last tick.
+func (t ticker) applyAsync() {
+	t.w.asyncMu.Lock()
+	results := t.w.asyncResults
+	t.w.asyncResults = nil
+	t.w.asyncMu.Unlock()
+
+	for _, apply := range results {
+		apply(t.w)
+	}
+}
+
+// BuildStructureAsync builds a Structure at the position passed like BuildStructure, but computes the blocks of
+// the Structure on a worker goroutine using Async. Only placing the blocks computed happens on the goroutine that
+// ticks the World, at the start of a later tick, so that Structures that are expensive to compute, such as
+// procedurally generated ones, do not slow down the ticking of the World.
+// The blockAt function passed to Structure.At reads blocks using World.Block, so the blocks it returns may be
+// changed by the time the Structure is built.
+func (w *World) BuildStructureAsync(pos cube.Pos, s Structure) {
+	if w == nil {
+		return
+	}
+	w.Async(func() func(w *World) {
+		computed := computeStructure(pos, s, w)
+		return func(w *World) {
+			w.BuildStructure(pos, computed)
+		}
+	})
+}
+
+// computedStructure is a Structure of which all blocks and liquids were computed in advance, so that building it
+// does not call Structure.At of the original Structure.
+type computedStructure struct {
+	dim     [3]int
+	blocks  []Block
+	liquids []Liquid
+}
+
+// computeStructure computes all blocks and liquids of the Structure passed as if it were built at the position
+// passed in the World passed.
+func computeStructure(pos cube.Pos, s Structure, w *World) computedStructure {
+	dim := s.Dimensions()
+	n := dim[0] * dim[1] * dim[2]
+	c := computedStructure{dim: dim, blocks: make([]Block, n), liquids: make([]Liquid, n)}
+	blockAt := func(x, y, z int) Block {
+		return w.Block(cube.Pos{pos[0] + x, pos[1] + y, pos[2] + z})
+	}
+	for y := 0; y < dim[1]; y++ {
+		for z := 0; z < dim[2]; z++ {
+			for x := 0; x < dim[0]; x++ {
+				i := c.index(x, y, z)
+				c.blocks[i], c.liquids[i] = s.At(x, y, z, blockAt)
+			}
+		}
+	}
+	return c
+}
+
+// index returns the index in the blocks and liquids of the computedStructure of the position passed.
+func (c computedStructure) index(x, y, z int) int {
+	return (y*c.dim[2]+z)*c.dim[0] + x
+}
+
+// Dimensions ...
+func (c computedStructure) Dimensions() [3]int {
+	return c.dim
+}
+
+// At ...
+func (c computedStructure) At(x, y, z int, _ func(x, y, z int) Block) (Block, Liquid) {
+	i := c.index(x, y, z)
+	return c.blocks[i], c.liquids[i]
+}
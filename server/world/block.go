@@ -3,12 +3,16 @@ package world
 import (
 	"fmt"
 	"github.com/brentp/intintmap"
+	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/block/customblock"
 	"github.com/df-mc/dragonfly/server/world/chunk"
+	"golang.org/x/exp/maps"
 	"image"
 	"math"
 	"math/rand"
+	"slices"
+	"strings"
 )
 
 // Block is a block that may be placed or found in a world. In addition, the block may also be added to an
@@ -75,12 +79,22 @@ type Liquid interface {
 // runtime IDs. It is used to look up a block's runtime ID quickly.
 var hashes = intintmap.New(7000, 0.999)
 
+// worldCreated is set to true once the first World is created. Custom blocks may no longer be registered from
+// then on, as the runtime IDs of blocks in the chunks of that World would change.
+var worldCreated atomic.Bool
+
 // RegisterBlock registers the Block passed. The EncodeBlock method will be used to encode and decode the
 // block passed. RegisterBlock panics if the block properties returned were not valid, existing properties.
+//
+// A CustomBlock must have an identifier with a namespace other than 'minecraft', such as 'example:ruby_block'.
+// If the CustomBlock has multiple states, returned by a States method like block.Permutable, all states are
+// allocated a runtime ID when the first state is registered, in the same order as the client does, and each
+// state must then still be registered using RegisterBlock. Custom blocks must be registered before the first
+// World is created, as registering them changes the runtime IDs of other blocks.
 func RegisterBlock(b Block) {
 	name, properties := b.EncodeBlock()
-	if _, ok := b.(CustomBlock); ok {
-		registerBlockState(blockState{Name: name, Properties: properties}, true)
+	if c, ok := b.(CustomBlock); ok {
+		registerCustomBlockStates(name, properties, c)
 	}
 	rid, ok := stateRuntimeIDs[stateHash{name: name, properties: hashProperties(properties)}]
 	if !ok {
@@ -123,6 +137,53 @@ func RegisterBlock(b Block) {
 	}
 }
 
+// customBlockStates is implemented by custom blocks with multiple states, such as block.Permutable.
+type customBlockStates interface {
+	// States returns a map of all properties of the block, with all values possible for each property.
+	States() map[string][]any
+}
+
+// registerCustomBlockStates registers the block states of a CustomBlock with the name and properties passed. If
+// the CustomBlock has multiple states, all of them are registered at once, ordered by the names of their
+// properties, with the values of the last property changing first. This matches the order in which the client
+// creates these states from the properties sent to it.
+func registerCustomBlockStates(name string, properties map[string]any, c CustomBlock) {
+	if worldCreated.Load() {
+		panic(fmt.Sprintf("custom block %v registered after creating a World", name))
+	}
+	if namespace, _, ok := strings.Cut(name, ":"); !ok || namespace == "" || namespace == "minecraft" {
+		panic(fmt.Sprintf("custom block identifier %v must have a namespace other than minecraft", name))
+	}
+	if _, ok := blockProperties[name]; ok {
+		// The states of the block were already registered by an earlier call.
+		return
+	}
+	s, ok := c.(customBlockStates)
+	if !ok || len(s.States()) == 0 {
+		registerBlockState(blockState{Name: name, Properties: properties}, true)
+		return
+	}
+	states := s.States()
+	keys := maps.Keys(states)
+	slices.Sort(keys)
+
+	permutations := []map[string]any{{}}
+	for _, key := range keys {
+		next := make([]map[string]any, 0, len(permutations)*len(states[key]))
+		for _, permutation := range permutations {
+			for _, v := range states[key] {
+				m := maps.Clone(permutation)
+				m[key] = v
+				next = append(next, m)
+			}
+		}
+		permutations = next
+	}
+	for _, permutation := range permutations {
+		registerBlockState(blockState{Name: name, Properties: permutation}, true)
+	}
+}
+
 // BlockRuntimeID attempts to return a runtime ID of a block previously registered using RegisterBlock().
 // If the runtime ID cannot be found because the Block wasn't registered, BlockRuntimeID will panic.
 func BlockRuntimeID(b Block) uint32 {
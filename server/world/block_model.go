@@ -14,6 +14,24 @@ type BlockModel interface {
 	FaceSolid(pos cube.Pos, face cube.Face, w *World) bool
 }
 
+// EntityBlockModel is a BlockModel of which the bounding boxes that entities collide with depend on the entity,
+// such as the model of powder snow, which only holds up entities wearing leather boots.
+type EntityBlockModel interface {
+	BlockModel
+	// EntityBBox returns the bounding boxes that the Entity passed collides with. BBox is used for all other
+	// collisions, such as those with projectiles.
+	EntityBBox(pos cube.Pos, w *World, e Entity) []cube.BBox
+}
+
+// EntityBBox returns the bounding boxes of the BlockModel passed that the Entity passed collides with. If the
+// BlockModel implements EntityBlockModel, its EntityBBox method is used. Otherwise, its BBox method is used.
+func EntityBBox(m BlockModel, pos cube.Pos, w *World, e Entity) []cube.BBox {
+	if em, ok := m.(EntityBlockModel); ok {
+		return em.EntityBBox(pos, w, e)
+	}
+	return m.BBox(pos, w)
+}
+
 // unknownModel is the model used for unknown blocks. It is the equivalent of a fully solid model.
 type unknownModel struct{}
 
@@ -47,6 +47,23 @@ func (chunk *Chunk) Range() cube.Range {
 	return chunk.r
 }
 
+// Resize returns a Chunk with the cube.Range passed that holds the blocks and biomes of the Chunk within that
+// range. Sub chunks outside the range are dropped and sub chunks that were not previously part of the Chunk are
+// empty. The sub chunks are shared with the original Chunk. Both the minimum of the range and the maximum plus
+// one must be multiples of 16. If the range is equal to that of the Chunk, the Chunk itself is returned.
+func (chunk *Chunk) Resize(r cube.Range) *Chunk {
+	if r == chunk.r {
+		return chunk
+	}
+	c := New(chunk.air, r)
+	for i := range c.sub {
+		if j := int(chunk.SubIndex(c.SubY(int16(i)))); j >= 0 && j < len(chunk.sub) {
+			c.sub[i], c.biomes[i] = chunk.sub[j], chunk.biomes[j]
+		}
+	}
+	return c
+}
+
 // Sub returns a list of all sub chunks present in the chunk.
 func (chunk *Chunk) Sub() []*SubChunk {
 	return chunk.sub
@@ -99,6 +116,12 @@ func (chunk *Chunk) Light(x uint8, y int16, z uint8) uint8 {
 	return sky
 }
 
+// BlockLight returns the light level emitted by blocks at a specific position in the chunk. Unlike Light, the
+// skylight at the position is not taken into account.
+func (chunk *Chunk) BlockLight(x uint8, y int16, z uint8) uint8 {
+	return chunk.SubChunk(y).BlockLight(x&15, uint8(y&15), z&15)
+}
+
 // SkyLight returns the skylight level at a specific position in the chunk.
 func (chunk *Chunk) SkyLight(x uint8, y int16, z uint8) uint8 {
 	return chunk.SubChunk(y).SkyLight(x&15, uint8(y&15), z&15)
@@ -0,0 +1,53 @@
+package world
+
+import "time"
+
+// CombatConfig holds settings that influence combat between entities in a World. It allows, for example, PvP
+// servers to choose between modern and legacy (1.8-style) combat per World. The zero value of CombatConfig
+// results in modern, vanilla combat.
+type CombatConfig struct {
+	// AttackImmunity is the duration for which an entity is immune to further damage after being hurt. If set to
+	// 0, the vanilla duration of 500ms is used. Setting a negative value removes the immunity altogether, so that
+	// entities may be hurt again immediately.
+	AttackImmunity time.Duration
+	// KnockBackForce and KnockBackHeight are the horizontal and vertical knock back dealt by melee attacks,
+	// before enchantments are applied. If set to 0, the vanilla values of 0.45 and 0.3608 are used respectively.
+	KnockBackForce, KnockBackHeight float64
+	// SprintCriticals specifies if players are able to deal critical hits while sprinting, as was the case in
+	// legacy combat. By default, sprinting players cannot deal critical hits.
+	SprintCriticals bool
+	// DisableThrowableKnockBack specifies if projectiles that deal no damage, such as snowballs and eggs, should
+	// no longer hurt and knock back the entities they hit. By default, these projectiles knock entities back.
+	DisableThrowableKnockBack bool
+	// FishingRodHits specifies if fishing hooks should hurt and knock back the entities they hit, as was the case
+	// in legacy combat. By default, fishing hooks do not affect the entities they hit.
+	FishingRodHits bool
+}
+
+// LegacyCombat returns a CombatConfig resembling the combat of Minecraft: Java Edition 1.8, with a lower
+// vertical knock back, critical hits while sprinting and fishing rod hits.
+func LegacyCombat() CombatConfig {
+	return CombatConfig{KnockBackForce: 0.4, KnockBackHeight: 0.4, SprintCriticals: true, FishingRodHits: true}
+}
+
+// Immunity returns the duration for which an entity is immune to damage after being hurt, taking the default
+// into account.
+func (c CombatConfig) Immunity() time.Duration {
+	if c.AttackImmunity == 0 {
+		return time.Second / 2
+	}
+	return max(c.AttackImmunity, 0)
+}
+
+// KnockBack returns the horizontal force and vertical height of the knock back dealt by melee attacks, taking
+// the defaults into account.
+func (c CombatConfig) KnockBack() (force, height float64) {
+	force, height = c.KnockBackForce, c.KnockBackHeight
+	if force == 0 {
+		force = 0.45
+	}
+	if height == 0 {
+		height = 0.3608
+	}
+	return force, height
+}
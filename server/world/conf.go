@@ -24,13 +24,54 @@ type Config struct {
 	// be NopProvider, which does not store any data to disk.
 	Provider Provider
 	// Generator is the Generator implementation used to generate new areas of the World. If set to nil, the Provider
-	// used will be NopGenerator, which generates completely empty chunks.
+	// used will be NopGenerator, which generates completely empty chunks. Generator may be changed at runtime using
+	// World.SetGenerator.
 	Generator Generator
 	// ReadOnly specifies if the World should be read-only, meaning no new data will be written to the Provider.
 	ReadOnly bool
+	// Immutable specifies if blocks in the World may not be modified. If set to true, all block changes, such as
+	// those by players, liquids, fire and explosions, are rejected. Events, such as players interacting with
+	// blocks, are still called. Immutable may be changed at runtime using World.SetImmutable.
+	Immutable bool
+	// Combat holds settings that influence combat in the World, such as the knock back dealt by attacks. The
+	// zero value results in vanilla combat. Combat may be changed at runtime using World.SetCombat.
+	Combat CombatConfig
+	// Despawn holds settings that influence how long entities such as dropped items exist before despawning.
+	// The zero value results in the vanilla lifetimes. Despawn may be changed at runtime using
+	// World.SetDespawn.
+	Despawn DespawnConfig
+	// EntityCaps holds hard caps on the amount of monsters, animals, items and projectiles in the World and in
+	// every chunk. Entities spawned beyond a cap are discarded. The zero value does not cap any entities.
+	// EntityCaps may be changed at runtime using World.SetEntityCaps.
+	EntityCaps EntityCaps
+	// Griefing holds the policy on blocks being destroyed or changed by explosions, mobs and fire. The zero value
+	// allows all griefing. Griefing may be changed at runtime using World.SetGriefing.
+	Griefing GriefingConfig
+	// EntityTickBudget limits the time spent ticking entities in the World every tick, degrading entity ticking
+	// when it is exceeded. The zero value disables the budget. EntityTickBudget may be changed at runtime using
+	// World.SetEntityTickBudget.
+	EntityTickBudget EntityTickBudget
+	// Watchdog monitors the duration of the ticks of the World, dumping its state when ticks are slow and
+	// optionally taking emergency measures when the World keeps exceeding its threshold. The zero value
+	// disables the Watchdog. Watchdog may be changed at runtime using World.SetWatchdog.
+	Watchdog Watchdog
+	// HorizontalBound is the maximum absolute X and Z block coordinate of the World. Blocks beyond it are always
+	// air and cannot be changed, chunks beyond it are not generated and entities cannot move past it. If 0 or
+	// lower or higher than 30,000,000, a bound of 30,000,000 blocks is used, which matches the world border
+	// of vanilla worlds. Further out, clients can no longer handle positions reliably.
+	HorizontalBound int
+	// Range is the vertical range in blocks of the World, limiting the heights at which blocks may be placed and
+	// generated. If left empty, the range of Dim is used. Range is rounded outwards to whole sub chunks, so that
+	// its minimum and its maximum plus one are multiples of 16.
+	// Range can only narrow the range of Dim, never widen it: Chunks are allocated, stored by the Provider and
+	// sent to clients with the sub chunks of Dim's range, so Range is clamped to Dim.Range(). Worlds that need
+	// a taller range must use a Dimension with a wider Range instead. A Range that does not overlap with the
+	// range of Dim is ignored.
+	Range cube.Range
 	// RandomTickSpeed specifies the rate at which blocks should be ticked in the World. By default, each sub chunk has
 	// 3 blocks randomly ticked per sub chunk, so the default value is 3. Setting this value to -1 or lower will stop
-	// random ticking altogether, while setting it higher results in faster ticking.
+	// random ticking altogether, while setting it higher results in faster ticking. RandomTickSpeed may be changed at
+	// runtime using World.SetRandomTickSpeed.
 	RandomTickSpeed int
 	// RandSource is the rand.Source used for generation of random numbers in a World, such as when selecting blocks to
 	// tick or when deciding where to strike lightning. If set to nil, `rand.NewSource(time.Now().Unix())` will be used
@@ -41,6 +82,25 @@ type Config struct {
 	Entities EntityRegistry
 }
 
+// maxHorizontalBound is the maximum and default value of Config.HorizontalBound. Entity positions are sent as
+// 32-bit floats over the network, which already only have a precision of 2 blocks at this distance from the
+// origin, so clients stop moving and rendering properly beyond it.
+const maxHorizontalBound = 30_000_000
+
+// worldRange returns the cube.Range r clamped to the range of the dimension passed and aligned to sub chunks. If
+// r is empty or does not overlap with the dimension range, the dimension range is returned. r is never widened
+// beyond dim, as chunks only hold the sub chunks of the dimension range.
+func worldRange(r, dim cube.Range) cube.Range {
+	if r == (cube.Range{}) {
+		return dim
+	}
+	r = cube.Range{max(r[0]>>4<<4, dim[0]), min(r[1]|15, dim[1])}
+	if r[0] > r[1] {
+		return dim
+	}
+	return r
+}
+
 // Logger is a logger implementation that may be passed to the Log field of Config. World will send errors and debug
 // messages to this Logger when appropriate.
 type Logger interface {
@@ -63,16 +123,23 @@ func (conf Config) New() *World {
 	if conf.Generator == nil {
 		conf.Generator = NopGenerator{}
 	}
+	if conf.HorizontalBound <= 0 {
+		conf.HorizontalBound = maxHorizontalBound
+	}
+	conf.HorizontalBound = min(conf.HorizontalBound, maxHorizontalBound)
+	conf.Range = worldRange(conf.Range, conf.Dim.Range())
 	if conf.RandomTickSpeed == 0 {
 		conf.RandomTickSpeed = 3
 	}
 	if conf.RandSource == nil {
 		conf.RandSource = rand.NewSource(time.Now().Unix())
 	}
+	worldCreated.Store(true)
 	s := conf.Provider.Settings()
 	w := &World{
 		scheduledUpdates: make(map[cube.Pos]int64),
 		entities:         make(map[Entity]ChunkPos),
+		chunkCounts:      make(map[ChunkPos]*entityCounts),
 		viewers:          make(map[*Loader]Viewer),
 		chunks:           make(map[ChunkPos]*Column),
 		closing:          make(chan struct{}),
@@ -80,12 +147,22 @@ func (conf Config) New() *World {
 		r:                rand.New(conf.RandSource),
 		advance:          s.ref.Inc() == 1,
 		conf:             conf,
-		ra:               conf.Dim.Range(),
+		ra:               conf.Range,
 		set:              s,
 	}
 	w.weather, w.ticker = weather{w: w}, ticker{w: w}
+	w.immutable.Store(conf.Immutable)
+	w.combat.Store(conf.Combat)
+	w.despawn.Store(conf.Despawn)
+	w.generator.Store(conf.Generator)
+	w.caps.Store(conf.EntityCaps)
+	w.griefing.Store(conf.Griefing)
+	w.budget.Store(conf.EntityTickBudget)
+	w.watchdog.Store(conf.Watchdog)
+	w.SetRandomTickSpeed(conf.RandomTickSpeed)
 
 	go w.tickLoop()
 	go w.chunkCacheJanitor()
+	go w.watchdogLoop()
 	return w
 }
@@ -0,0 +1,47 @@
+package world
+
+import "time"
+
+// DespawnConfig holds settings that influence how long entities such as dropped items, arrows and experience
+// orbs exist in a World before they despawn. The zero value of DespawnConfig results in the vanilla
+// lifetimes.
+type DespawnConfig struct {
+	// ItemLifetime is the duration that item entities exist before despawning. If set to 0, the vanilla
+	// duration of 5 minutes is used. Setting a negative value stops item entities from despawning altogether.
+	// Individual item entities may override this duration using entity.ItemBehaviourConfig.
+	ItemLifetime time.Duration
+	// ArrowLifetime is the duration that arrows and other projectiles that survive hitting a block stay stuck
+	// in that block before despawning. If set to 0, the vanilla duration of 1 minute is used. Setting a
+	// negative value stops stuck projectiles from despawning altogether.
+	ArrowLifetime time.Duration
+	// ExperienceOrbLifetime is the duration that experience orbs exist before despawning. If set to 0, the
+	// vanilla duration of 5 minutes is used. Setting a negative value stops experience orbs from despawning
+	// altogether.
+	ExperienceOrbLifetime time.Duration
+}
+
+// Item returns the duration that item entities exist before despawning, taking the default into account. A
+// negative duration is returned if items never despawn.
+func (c DespawnConfig) Item() time.Duration {
+	return lifetime(c.ItemLifetime, time.Minute*5)
+}
+
+// Arrow returns the duration that arrows stay stuck in a block before despawning, taking the default into
+// account. A negative duration is returned if arrows never despawn.
+func (c DespawnConfig) Arrow() time.Duration {
+	return lifetime(c.ArrowLifetime, time.Minute)
+}
+
+// ExperienceOrb returns the duration that experience orbs exist before despawning, taking the default into
+// account. A negative duration is returned if experience orbs never despawn.
+func (c DespawnConfig) ExperienceOrb() time.Duration {
+	return lifetime(c.ExperienceOrbLifetime, time.Minute*5)
+}
+
+// lifetime returns d, or def if d is 0.
+func lifetime(d, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
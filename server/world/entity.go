@@ -51,6 +51,23 @@ type SaveableEntityType interface {
 	EncodeNBT(e Entity) map[string]any
 }
 
+// NewEntity creates a new Entity of the EntityType passed at a position and with a rotation, for example to
+// spawn an entity from a spawn egg or a mob spawner. The Entity is created by calling DecodeNBT with only
+// these properties set. False is returned if the EntityType does not implement SaveableEntityType or if it
+// cannot create an Entity without additional data, such as item entities.
+func NewEntity(t EntityType, pos mgl64.Vec3, rot cube.Rotation) (Entity, bool) {
+	st, ok := t.(SaveableEntityType)
+	if !ok {
+		return nil, false
+	}
+	e := st.DecodeNBT(map[string]any{
+		"Pos":   []float32{float32(pos[0]), float32(pos[1]), float32(pos[2])},
+		"Yaw":   float32(rot.Yaw()),
+		"Pitch": float32(rot.Pitch()),
+	})
+	return e, e != nil
+}
+
 // TickerEntity represents an entity that has a Tick method which should be called every time the entity is
 // ticked every 20th of a second.
 type TickerEntity interface {
@@ -87,6 +104,18 @@ type HealingSource interface {
 	HealingSource()
 }
 
+// ExhaustionSource represents a source of exhaustion for an entity. This
+// source may be passed to the Exhaust() method of an entity with a food bar.
+type ExhaustionSource interface {
+	ExhaustionSource()
+}
+
+// LevelCostSource represents a source of an experience level cost, such as
+// enchanting an item. It is passed when a player spends experience levels.
+type LevelCostSource interface {
+	LevelCostSource()
+}
+
 // EntityRegistry is a mapping that EntityTypes may be registered to. It is used
 // for loading entities from disk in a World's Provider.
 type EntityRegistry struct {
@@ -97,20 +126,28 @@ type EntityRegistry struct {
 // EntityRegistryConfig holds functions used by the block and item packages to
 // create entities as a result of their behaviour. ALL functions of
 // EntityRegistryConfig must be filled out for the behaviour of these blocks and
-// items not to fail.
+// items not to fail, except for the ones documented to be optional.
 type EntityRegistryConfig struct {
 	Item               func(it any, pos, vel mgl64.Vec3) Entity
 	FallingBlock       func(bl Block, pos mgl64.Vec3) Entity
-	TNT                func(pos mgl64.Vec3, fuse time.Duration) Entity
+	TNT                func(pos mgl64.Vec3, fuse time.Duration, igniter Entity) Entity
 	BottleOfEnchanting func(pos, vel mgl64.Vec3, owner Entity) Entity
 	Arrow              func(pos, vel mgl64.Vec3, rot cube.Rotation, damage float64, owner Entity, critical, disallowPickup, obtainArrowOnPickup bool, punchLevel int, tip any) Entity
 	Egg                func(pos, vel mgl64.Vec3, owner Entity) Entity
 	EnderPearl         func(pos, vel mgl64.Vec3, owner Entity) Entity
 	Firework           func(pos mgl64.Vec3, rot cube.Rotation, attached bool, firework Item, owner Entity) Entity
+	FishingHook        func(pos, vel mgl64.Vec3, owner Entity) Entity
 	LingeringPotion    func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Snowball           func(pos, vel mgl64.Vec3, owner Entity) Entity
 	SplashPotion       func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Lightning          func(pos mgl64.Vec3) Entity
+
+	// CrossbowArrow and CrossbowFirework create the projectiles shot by a
+	// crossbow. They are optional: If left nil, crossbows fall back to Arrow and
+	// Firework, without Piercing and with fireworks flying like they would when
+	// used normally.
+	CrossbowArrow    func(pos, vel mgl64.Vec3, rot cube.Rotation, damage float64, owner Entity, obtainArrowOnPickup bool, piercingLevel int, tip any) Entity
+	CrossbowFirework func(pos, vel mgl64.Vec3, rot cube.Rotation, firework Item, owner Entity) Entity
 }
 
 // New creates an EntityRegistry using conf and the EntityTypes passed.
@@ -0,0 +1,171 @@
+package world
+
+// EntityCategory is a category of entities, such as monsters or items, that may be capped using EntityCaps.
+type EntityCategory uint8
+
+const (
+	// EntityCategoryMonster is the category of hostile mobs, such as zombies and skeletons.
+	EntityCategoryMonster EntityCategory = iota + 1
+	// EntityCategoryAnimal is the category of passive mobs, such as cows and pigs.
+	EntityCategoryAnimal
+	// EntityCategoryItem is the category of dropped item entities.
+	EntityCategoryItem
+	// EntityCategoryProjectile is the category of projectiles, such as arrows and snowballs.
+	EntityCategoryProjectile
+)
+
+// String ...
+func (c EntityCategory) String() string {
+	switch c {
+	case EntityCategoryMonster:
+		return "monster"
+	case EntityCategoryAnimal:
+		return "animal"
+	case EntityCategoryItem:
+		return "item"
+	case EntityCategoryProjectile:
+		return "projectile"
+	}
+	return "unknown"
+}
+
+// CategorisedEntityType is an EntityType of which the entities belong to an EntityCategory. Only entities with
+// an EntityType that implements CategorisedEntityType are subject to EntityCaps. Players are never capped.
+type CategorisedEntityType interface {
+	EntityType
+	// EntityCategory returns the EntityCategory that entities of the type belong to.
+	EntityCategory() EntityCategory
+}
+
+// EntityCaps holds hard caps on the amount of entities of each EntityCategory in a World. Caps are enforced
+// when an entity is spawned using World.AddEntity: Entities that would exceed a cap are discarded and AddEntity
+// returns false. Entities that are already in the World, or that are added using World.AddEntityUncapped, are
+// never removed. The zero value of EntityCaps does not cap any entities.
+type EntityCaps struct {
+	// World holds the maximum amount of entities of each category in the entire World.
+	World EntityLimits
+	// Chunk holds the maximum amount of entities of each category in a single chunk.
+	Chunk EntityLimits
+}
+
+// EntityLimits holds a maximum amount of entities for each EntityCategory. A limit of 0 or lower means the
+// category is not limited.
+type EntityLimits struct {
+	Monsters, Animals, Items, Projectiles int
+}
+
+// limit returns the limit of the EntityCategory passed, or 0 if it is not limited.
+func (l EntityLimits) limit(c EntityCategory) int {
+	switch c {
+	case EntityCategoryMonster:
+		return l.Monsters
+	case EntityCategoryAnimal:
+		return l.Animals
+	case EntityCategoryItem:
+		return l.Items
+	case EntityCategoryProjectile:
+		return l.Projectiles
+	}
+	return 0
+}
+
+// entityCategory returns the EntityCategory of the Entity passed. False is returned if its type does not
+// implement CategorisedEntityType.
+func entityCategory(e Entity) (EntityCategory, bool) {
+	if t, ok := e.Type().(CategorisedEntityType); ok {
+		return t.EntityCategory(), true
+	}
+	return 0, false
+}
+
+// entityCounts holds the amount of entities of each EntityCategory.
+type entityCounts [EntityCategoryProjectile + 1]int
+
+// trackEntity stores the chunk position that the Entity passed is in and updates the entity counts of the World
+// accordingly. w.entityMu must be held while calling trackEntity.
+func (w *World) trackEntity(e Entity, pos ChunkPos) {
+	if prev, ok := w.entities[e]; ok {
+		w.countEntity(e, prev, -1)
+	}
+	w.entities[e] = pos
+	w.countEntity(e, pos, 1)
+}
+
+// untrackEntity removes the Entity passed from the entities of the World and updates the entity counts. The chunk
+// position the Entity was in is returned, or false if the Entity was not in the World. w.entityMu must be held
+// while calling untrackEntity.
+func (w *World) untrackEntity(e Entity) (ChunkPos, bool) {
+	pos, ok := w.entities[e]
+	if ok {
+		delete(w.entities, e)
+		w.countEntity(e, pos, -1)
+	}
+	return pos, ok
+}
+
+// countEntity adds n to the count of the EntityCategory of the Entity passed, both for the entire World and for
+// the chunk at the position passed. w.entityMu must be held while calling countEntity.
+func (w *World) countEntity(e Entity, pos ChunkPos, n int) {
+	c, ok := entityCategory(e)
+	if !ok {
+		return
+	}
+	w.counts[c] += n
+
+	counts, ok := w.chunkCounts[pos]
+	if !ok {
+		counts = &entityCounts{}
+		w.chunkCounts[pos] = counts
+	}
+	counts[c] += n
+	if *counts == (entityCounts{}) {
+		delete(w.chunkCounts, pos)
+	}
+}
+
+// EntityCaps returns the EntityCaps that limit the amount of entities spawned in the World.
+func (w *World) EntityCaps() EntityCaps {
+	if w == nil {
+		return EntityCaps{}
+	}
+	return w.caps.Load()
+}
+
+// SetEntityCaps changes the EntityCaps that limit the amount of entities spawned in the World. The new caps
+// are enforced for all entities spawned after the call. Entities already in the World are not removed.
+func (w *World) SetEntityCaps(c EntityCaps) {
+	if w == nil {
+		return
+	}
+	w.caps.Store(c)
+}
+
+// RejectedEntities returns the amount of entities of the EntityCategory passed that were discarded when being
+// spawned because they would have exceeded the EntityCaps of the World.
+func (w *World) RejectedEntities(c EntityCategory) int64 {
+	if w == nil || int(c) >= len(w.rejected) {
+		return 0
+	}
+	return w.rejected[c].Load()
+}
+
+// exceedsCaps checks if adding the Entity passed to the chunk at the position passed would exceed the
+// EntityCaps of the World. If so, the rejection is recorded. w.entityMu must be held while calling exceedsCaps, so
+// that the Entity can be counted in the same critical section if it does not exceed the caps.
+func (w *World) exceedsCaps(e Entity, pos ChunkPos) bool {
+	c, ok := entityCategory(e)
+	if !ok {
+		return false
+	}
+	caps := w.caps.Load()
+	worldLimit, chunkLimit := caps.World.limit(c), caps.Chunk.limit(c)
+
+	exceeded := worldLimit > 0 && w.counts[c] >= worldLimit
+	if counts, ok := w.chunkCounts[pos]; ok && chunkLimit > 0 && counts[c] >= chunkLimit {
+		exceeded = true
+	}
+	if exceeded {
+		w.rejected[c].Inc()
+	}
+	return exceeded
+}
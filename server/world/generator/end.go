@@ -0,0 +1,180 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"math"
+	"math/rand"
+)
+
+// End is a generator that generates the terrain of the End: A main island of end stone surrounded by void, with a
+// ring of obsidian pillars and the exit portal at its centre, and smaller outer islands far away from it. Like
+// Overworld, the terrain generated only depends on the seed of the generator. End may be constructed by calling
+// NewEnd.
+type End struct {
+	seed int64
+
+	shape, islands *Noise
+
+	air, endStone, obsidian, bedrock, portal, biome uint32
+	pillars                                         []endPillar
+}
+
+// endPillar is an obsidian pillar on the main island of the End.
+type endPillar struct {
+	x, z, radius, height int
+}
+
+const (
+	// endIslandRadius is the approximate radius in blocks of the main island of the End.
+	endIslandRadius = 90
+	// endOuterIslands is the distance in blocks from the centre of the End beyond which outer islands are
+	// generated.
+	endOuterIslands = 1000
+	// endSurface is the approximate Y level of the surface of the islands of the End.
+	endSurface = 58
+	// endPillarDistance is the distance of the obsidian pillars from the centre of the main island.
+	endPillarDistance = 42
+)
+
+// NewEnd creates a new End generator that generates terrain using the seed passed. End generators with the same
+// seed generate the same terrain.
+func NewEnd(seed int64) End {
+	rid := world.BlockRuntimeID
+	g := End{
+		seed:    seed,
+		shape:   NewNoise(seed),
+		islands: NewNoise(seed + 1),
+
+		air:      rid(block.Air{}),
+		endStone: rid(block.EndStone{}),
+		obsidian: rid(block.Obsidian{}),
+		bedrock:  rid(block.Bedrock{}),
+		portal:   rid(block.EndPortal{}),
+		biome:    uint32(biome.End{}.EncodeBiome()),
+	}
+	// The pillars are placed in a circle around the centre of the main island, each with a different height and
+	// a radius that grows with the height.
+	heights := rand.New(rand.NewSource(seed)).Perm(10)
+	for i, h := range heights {
+		angle := 2 * math.Pi * float64(i) / 10
+		g.pillars = append(g.pillars, endPillar{
+			x:      int(math.Round(endPillarDistance * math.Cos(angle))),
+			z:      int(math.Round(endPillarDistance * math.Sin(angle))),
+			radius: 2 + h/3,
+			height: 76 + h*3,
+		})
+	}
+	return g
+}
+
+// GenerateChunk ...
+func (g End) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	baseX, baseZ := int(pos[0])<<4, int(pos[1])<<4
+	rg := c.Range()
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			bottom, top := g.island(baseX+int(x), baseZ+int(z))
+			for y := max(bottom, rg.Min()); y <= min(top, rg.Max()); y++ {
+				c.SetBlock(x, int16(y), z, 0, g.endStone)
+			}
+			for y := int16(rg.Min()); y <= int16(rg.Max()); y++ {
+				c.SetBiome(x, y, z, g.biome)
+			}
+		}
+	}
+	if baseX > endIslandRadius || baseX+15 < -endIslandRadius || baseZ > endIslandRadius || baseZ+15 < -endIslandRadius {
+		return
+	}
+	for _, p := range g.pillars {
+		g.generatePillar(c, p, baseX, baseZ)
+	}
+	g.generateExitPortal(c, baseX, baseZ)
+}
+
+// island returns the lowest and highest Y values of the end stone at the x and z coordinates passed. If the
+// column has no end stone, bottom is higher than top.
+func (g End) island(x, z int) (bottom, top int) {
+	fx, fz := float64(x), float64(z)
+	dist := math.Sqrt(fx*fx + fz*fz)
+	var thickness float64
+	switch {
+	case dist < endIslandRadius+30:
+		// The edge of the main island is made irregular using noise.
+		radius := endIslandRadius + g.shape.Octaves2D(fx/50, fz/50, 3)*30
+		thickness = 1 - dist/radius
+	case dist > endOuterIslands:
+		thickness = (g.islands.Octaves2D(fx/100, fz/100, 3) - 0.3) / 0.4
+	}
+	if thickness <= 0 {
+		return 1, 0
+	}
+	thickness = math.Min(thickness, 1)
+	top = endSurface + int(math.Sqrt(thickness)*6+g.shape.Octaves2D(fx/30, fz/30, 2)*3)
+	bottom = top - int(math.Sqrt(thickness)*50+g.shape.Noise2D(fx/12, fz/12)*4)
+	return bottom, top
+}
+
+// generatePillar generates the part of the obsidian pillar passed that is inside the chunk with the minimum X and
+// Z coordinates passed. Pillars start at the bottom of the island and have bedrock on top.
+func (g End) generatePillar(c *chunk.Chunk, p endPillar, baseX, baseZ int) {
+	rg := c.Range()
+	for x := 0; x < 16; x++ {
+		for z := 0; z < 16; z++ {
+			dx, dz := baseX+x-p.x, baseZ+z-p.z
+			if dx*dx+dz*dz > p.radius*p.radius+1 {
+				continue
+			}
+			bottom, top := g.island(baseX+x, baseZ+z)
+			if bottom > top {
+				bottom = endSurface
+			}
+			for y := max(bottom, rg.Min()); y <= min(p.height, rg.Max()); y++ {
+				c.SetBlock(uint8(x), int16(y), uint8(z), 0, g.obsidian)
+			}
+			if dx == 0 && dz == 0 && p.height < rg.Max() {
+				c.SetBlock(uint8(x), int16(p.height+1), uint8(z), 0, g.bedrock)
+			}
+		}
+	}
+}
+
+// generateExitPortal generates the part of the exit portal at the centre of the main island that is inside the
+// chunk with the minimum X and Z coordinates passed. The portal consists of a bowl of bedrock filled with end
+// portal blocks around a bedrock pillar.
+func (g End) generateExitPortal(c *chunk.Chunk, baseX, baseZ int) {
+	_, y := g.island(0, 0)
+	rg := c.Range()
+	if y < rg.Min() || y+4 > rg.Max() {
+		return
+	}
+	for x := -4; x <= 4; x++ {
+		for z := -4; z <= 4; z++ {
+			lx, lz := x-baseX, z-baseZ
+			if lx < 0 || lx > 15 || lz < 0 || lz > 15 {
+				continue
+			}
+			dist := x*x + z*z
+			if dist > 3*3+3 {
+				continue
+			}
+			// The space above the portal is cleared, so that the terrain of the island never covers it.
+			for ly := y + 2; ly <= min(y+6, rg.Max()); ly++ {
+				c.SetBlock(uint8(lx), int16(ly), uint8(lz), 0, g.air)
+			}
+			c.SetBlock(uint8(lx), int16(y), uint8(lz), 0, g.bedrock)
+			if dist <= 2*2+2 {
+				c.SetBlock(uint8(lx), int16(y+1), uint8(lz), 0, g.portal)
+			} else {
+				c.SetBlock(uint8(lx), int16(y+1), uint8(lz), 0, g.bedrock)
+			}
+			if x == 0 && z == 0 {
+				for ly := y + 1; ly <= y+4; ly++ {
+					c.SetBlock(uint8(lx), int16(ly), uint8(lz), 0, g.bedrock)
+				}
+			}
+		}
+	}
+}
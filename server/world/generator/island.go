@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+)
+
+// Island is a generator that only generates terrain in chunks within a Shape, using a different world.Generator.
+// All chunks outside the Shape are left empty, resulting in islands of terrain surrounded by void, as used by
+// skyblock servers. The void chunks are filled with a single biome, so that the sky and fog colours remain
+// consistent around the islands. Island may be constructed by calling NewIsland.
+type Island struct {
+	terrain world.Generator
+	shape   Shape
+	// biome is the encoded biome that void chunks are filled with.
+	biome uint32
+}
+
+// NewIsland creates a new Island generator that generates terrain using the world.Generator passed in all chunks
+// contained in the Shape passed. Chunks outside the Shape are void and are completely filled with the world.Biome
+// passed. If terrain is nil, the chunks within the Shape are void too.
+func NewIsland(terrain world.Generator, shape Shape, void world.Biome) Island {
+	if terrain == nil {
+		terrain = world.NopGenerator{}
+	}
+	return Island{terrain: terrain, shape: shape, biome: uint32(void.EncodeBiome())}
+}
+
+// GenerateChunk ...
+func (i Island) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	if i.shape != nil && i.shape.Contains(pos) {
+		i.terrain.GenerateChunk(pos, c)
+		return
+	}
+	min, max := int16(c.Range().Min()), int16(c.Range().Max())
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			for y := min; y <= max; y++ {
+				c.SetBiome(x, y, z, i.biome)
+			}
+		}
+	}
+}
+
+// Shape is a shape of chunks, used by the Island generator to decide which chunks should have terrain generated.
+type Shape interface {
+	// Contains checks if the chunk at the world.ChunkPos passed is within the Shape.
+	Contains(pos world.ChunkPos) bool
+}
+
+// Rectangle is a Shape that contains all chunks between two chunk positions, Min and Max, inclusively.
+type Rectangle struct {
+	Min, Max world.ChunkPos
+}
+
+// Contains ...
+func (r Rectangle) Contains(pos world.ChunkPos) bool {
+	return pos[0] >= r.Min[0] && pos[0] <= r.Max[0] && pos[1] >= r.Min[1] && pos[1] <= r.Max[1]
+}
+
+// Circle is a Shape that contains all chunks within a Radius in chunks from the chunk at Centre.
+type Circle struct {
+	Centre world.ChunkPos
+	Radius int32
+}
+
+// Contains ...
+func (c Circle) Contains(pos world.ChunkPos) bool {
+	dx, dz := int64(pos[0]-c.Centre[0]), int64(pos[1]-c.Centre[1])
+	return dx*dx+dz*dz <= int64(c.Radius)*int64(c.Radius)
+}
+
+// Shapes is a Shape that contains all chunks contained by any of its Shapes, such as multiple islands spread out
+// over a world.
+type Shapes []Shape
+
+// Contains ...
+func (s Shapes) Contains(pos world.ChunkPos) bool {
+	for _, shape := range s {
+		if shape.Contains(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShapeFunc is a Shape implemented by a function, which may be used for shapes that are not covered by the other
+// Shape implementations.
+type ShapeFunc func(pos world.ChunkPos) bool
+
+// Contains ...
+func (f ShapeFunc) Contains(pos world.ChunkPos) bool {
+	return f(pos)
+}
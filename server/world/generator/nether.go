@@ -0,0 +1,248 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"math/rand"
+)
+
+// Nether is a generator that generates nether terrain using layered noise. It generates netherrack caverns
+// enclosed by bedrock, with seas of lava, hanging glowstone, nether ores and biomes that each have their own
+// surface blocks and features. Like Overworld, the terrain generated only depends on the seed of the
+// generator. Nether may be constructed by calling NewNether.
+type Nether struct {
+	seed int64
+
+	terrain, biomeA, biomeB *Noise
+
+	air, bedrock, netherrack, lava, glowstone uint32
+	ores                                      []netherOre
+	biomes                                    []netherBiome
+}
+
+// netherBiome holds the properties of a biome that the Nether generator uses to generate the surfaces of the
+// caverns in that biome.
+type netherBiome struct {
+	// id is the encoded ID of the biome.
+	id uint32
+	// top is the runtime ID of the block at floors of caverns and filler is the runtime ID of the blocks directly
+	// below it.
+	top, filler uint32
+	// features are the runtime IDs of the blocks, such as fire or sprouts, placed on top of the floors of
+	// caverns and featureChance is the chance that one of them is placed on a floor block.
+	features      []uint32
+	featureChance float64
+}
+
+// netherOre holds the properties of a type of ore generated in veins by the Nether generator. Veins only
+// replace netherrack.
+type netherOre struct {
+	// rid is the runtime ID of the ore.
+	rid uint32
+	// attempts is the amount of veins attempted to be generated per chunk, size is the maximum amount of blocks in
+	// a single vein.
+	attempts, size int
+	// minY and maxY are the minimum and maximum Y values of the centre of a vein.
+	minY, maxY int
+}
+
+const (
+	// netherLavaLevel is the Y level up to which open space in the Nether is filled with lava.
+	netherLavaLevel = 31
+	// netherCeiling is the Y level of the highest block of the roof of the Nether.
+	netherCeiling = 127
+)
+
+const (
+	netherBiomeWastes = iota
+	netherBiomeSoulSandValley
+	netherBiomeCrimsonForest
+	netherBiomeWarpedForest
+	netherBiomeBasaltDeltas
+	netherBiomeCount
+)
+
+// NewNether creates a new Nether generator that generates terrain using the seed passed. Nether generators with
+// the same seed generate the same terrain.
+func NewNether(seed int64) Nether {
+	rid := world.BlockRuntimeID
+	id := func(b world.Biome) uint32 { return uint32(b.EncodeBiome()) }
+	g := Nether{
+		seed:    seed,
+		terrain: NewNoise(seed),
+		biomeA:  NewNoise(seed + 1),
+		biomeB:  NewNoise(seed + 2),
+
+		air:        rid(block.Air{}),
+		bedrock:    rid(block.Bedrock{}),
+		netherrack: rid(block.Netherrack{}),
+		lava:       rid(block.Lava{Still: true, Depth: 8}),
+		glowstone:  rid(block.Glowstone{}),
+
+		ores: []netherOre{
+			{rid: rid(block.NetherQuartzOre{}), attempts: 16, size: 14, minY: 10, maxY: 117},
+			{rid: rid(block.NetherGoldOre{}), attempts: 10, size: 10, minY: 10, maxY: 117},
+			{rid: rid(block.AncientDebris{}), attempts: 1, size: 3, minY: 8, maxY: 24},
+		},
+		biomes: make([]netherBiome, netherBiomeCount),
+	}
+	fire, soulFire := rid(block.Fire{Type: block.NormalFire()}), rid(block.Fire{Type: block.SoulFire()})
+
+	g.biomes[netherBiomeWastes] = netherBiome{
+		id: id(biome.NetherWastes{}), top: g.netherrack, filler: g.netherrack,
+		features: []uint32{fire}, featureChance: 0.004,
+	}
+	g.biomes[netherBiomeSoulSandValley] = netherBiome{
+		id: id(biome.SoulSandValley{}), top: rid(block.SoulSand{}), filler: rid(block.SoulSoil{}),
+		features: []uint32{soulFire}, featureChance: 0.006,
+	}
+	g.biomes[netherBiomeCrimsonForest] = netherBiome{
+		id: id(biome.CrimsonForest{}), top: rid(block.NetherWartBlock{}), filler: g.netherrack,
+	}
+	g.biomes[netherBiomeWarpedForest] = netherBiome{
+		id: id(biome.WarpedForest{}), top: rid(block.NetherWartBlock{Warped: true}), filler: g.netherrack,
+		features: []uint32{rid(block.NetherSprouts{})}, featureChance: 0.2,
+	}
+	g.biomes[netherBiomeBasaltDeltas] = netherBiome{
+		id: id(biome.BasaltDeltas{}), top: rid(block.Basalt{Axis: cube.Y}), filler: rid(block.Blackstone{}),
+	}
+	return g
+}
+
+// GenerateChunk ...
+func (g Nether) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	baseX, baseZ := int(pos[0])<<4, int(pos[1])<<4
+	r := rand.New(rand.NewSource(g.positionSeed(int64(pos[0]), int64(pos[1]))))
+
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			wx, wz := baseX+int(x), baseZ+int(z)
+			g.generateColumn(c, x, z, wx, wz, &g.biomes[g.biome(wx, wz)], r)
+		}
+	}
+	g.generateOres(c, r)
+	g.generateGlowstone(c, r)
+}
+
+// biome returns the index of the netherBiome of the column at the x and z coordinates passed.
+func (g Nether) biome(x, z int) int {
+	fx, fz := float64(x), float64(z)
+	a, b := g.biomeA.Octaves2D(fx/300, fz/300, 3)*1.8, g.biomeB.Octaves2D(fx/200, fz/200, 2)
+	switch {
+	case a > 0.3:
+		return choose(b > 0, netherBiomeCrimsonForest, netherBiomeWarpedForest)
+	case a < -0.3:
+		return choose(b > 0, netherBiomeSoulSandValley, netherBiomeBasaltDeltas)
+	}
+	return netherBiomeWastes
+}
+
+// solid checks if the block at the position passed is part of the terrain rather than of a cavern. The terrain
+// closes up near the roof and the floor of the Nether, so that caverns are mostly found in between.
+func (g Nether) solid(x, y, z int) bool {
+	density := g.terrain.Octaves3D(float64(x)/80, float64(y)/35, float64(z)/80, 4) - 0.06
+	if y < 12 {
+		density += float64(12-y) / 12
+	}
+	if top := netherCeiling - 24; y > top {
+		density += float64(y-top) / 16
+	}
+	return density > 0
+}
+
+// generateColumn fills a single column of the chunk with bedrock, netherrack and lava, and covers the floors of
+// caverns with the surface blocks and features of the biome passed.
+func (g Nether) generateColumn(c *chunk.Chunk, x, z uint8, wx, wz int, b *netherBiome, r *rand.Rand) {
+	rg := c.Range()
+	floor, ceiling := rg.Min()+r.Intn(5), min(netherCeiling, rg.Max())-r.Intn(5)
+
+	// The column is generated from the top down, so that solid blocks directly below open space are known to be
+	// the floor of a cavern. depth is the amount of blocks since the floor, used to place the filler blocks.
+	open, depth := false, 0
+	for y := min(netherCeiling, rg.Max()); y >= rg.Min(); y-- {
+		var rid uint32
+		switch {
+		case y <= floor || y >= ceiling:
+			rid, open, depth = g.bedrock, false, 0
+		case g.solid(wx, y, wz):
+			switch {
+			case open && y > netherLavaLevel:
+				rid, depth = b.top, 1
+				if len(b.features) > 0 && y < rg.Max() && r.Float64() < b.featureChance {
+					c.SetBlock(x, int16(y+1), z, 0, b.features[r.Intn(len(b.features))])
+				}
+			case depth > 0 && depth <= 3:
+				rid, depth = b.filler, depth+1
+			default:
+				rid, depth = g.netherrack, 0
+			}
+			open = false
+		case y <= netherLavaLevel:
+			rid, open, depth = g.lava, false, 0
+		default:
+			open = true
+			continue
+		}
+		c.SetBlock(x, int16(y), z, 0, rid)
+	}
+	for y := int16(rg.Min()); y <= int16(rg.Max()); y++ {
+		c.SetBiome(x, y, z, b.id)
+	}
+}
+
+// generateOres generates veins of ores in the chunk. Veins only replace netherrack.
+func (g Nether) generateOres(c *chunk.Chunk, r *rand.Rand) {
+	rg := c.Range()
+	for _, ore := range g.ores {
+		for i := 0; i < ore.attempts; i++ {
+			x, z := r.Intn(16), r.Intn(16)
+			y := max(ore.minY, rg.Min()) + r.Intn(max(min(ore.maxY, rg.Max())-max(ore.minY, rg.Min()), 1))
+			for n := 0; n < ore.size; n++ {
+				if x >= 0 && x < 16 && z >= 0 && z < 16 && y >= rg.Min() && y <= rg.Max() && c.Block(uint8(x), int16(y), uint8(z), 0) == g.netherrack {
+					c.SetBlock(uint8(x), int16(y), uint8(z), 0, ore.rid)
+				}
+				// Veins spread out in a random walk from their centre.
+				x, y, z = x+r.Intn(3)-1, y+r.Intn(3)-1, z+r.Intn(3)-1
+			}
+		}
+	}
+}
+
+// generateGlowstone generates clusters of glowstone hanging from the roofs of caverns in the chunk. Clusters
+// are only generated within the chunk, so that they never need to be placed in neighbouring chunks.
+func (g Nether) generateGlowstone(c *chunk.Chunk, r *rand.Rand) {
+	rg := c.Range()
+	for i := 0; i < 10; i++ {
+		x, z := 2+r.Intn(12), 2+r.Intn(12)
+		// Look for the roof of a cavern, starting from a random height and moving down.
+		y := min(netherCeiling, rg.Max()) - 4 - r.Intn(64)
+		for ; y > netherLavaLevel && y > rg.Min(); y-- {
+			if c.Block(uint8(x), int16(y), uint8(z), 0) == g.air && c.Block(uint8(x), int16(y+1), uint8(z), 0) != g.air {
+				break
+			}
+		}
+		if y <= netherLavaLevel || y <= rg.Min() {
+			continue
+		}
+		c.SetBlock(uint8(x), int16(y), uint8(z), 0, g.glowstone)
+		for n, size := 0, 10+r.Intn(20); n < size; n++ {
+			bx, by, bz := x+r.Intn(5)-2, y-r.Intn(6), z+r.Intn(5)-2
+			if bx < 0 || bx > 15 || bz < 0 || bz > 15 || by <= rg.Min() || c.Block(uint8(bx), int16(by), uint8(bz), 0) != g.air {
+				continue
+			}
+			// Glowstone only grows attached to glowstone placed earlier above it.
+			if c.Block(uint8(bx), int16(by+1), uint8(bz), 0) == g.glowstone {
+				c.SetBlock(uint8(bx), int16(by), uint8(bz), 0, g.glowstone)
+			}
+		}
+	}
+}
+
+// positionSeed returns a seed for a random number generator that is unique for the seed of the generator and the
+// chunk coordinates passed.
+func (g Nether) positionSeed(x, z int64) int64 {
+	return g.seed ^ (x * 341873128712) ^ (z * 132897987541)
+}
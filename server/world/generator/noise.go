@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Noise is a seeded gradient noise function based on improved Perlin noise. It produces smooth, continuous values
+// in the range of roughly -1 to 1 in two and three dimensions. Noise may be constructed by calling NewNoise.
+type Noise struct {
+	// perm is a shuffled permutation of 0-255, repeated once so that lookups never have to wrap around.
+	perm [512]uint8
+	// ox, oy and oz are random offsets added to the coordinates passed, so that noise with different seeds does
+	// not share the zero values at integer coordinates.
+	ox, oy, oz float64
+}
+
+// NewNoise creates a new Noise function using the seed passed. Two Noise functions with the same seed always
+// produce the same values.
+func NewNoise(seed int64) *Noise {
+	r := rand.New(rand.NewSource(seed))
+	n := &Noise{ox: r.Float64() * 256, oy: r.Float64() * 256, oz: r.Float64() * 256}
+	p := r.Perm(256)
+	for i := 0; i < 512; i++ {
+		n.perm[i] = uint8(p[i&255])
+	}
+	return n
+}
+
+// Noise2D returns the noise value at the coordinates passed.
+func (n *Noise) Noise2D(x, z float64) float64 {
+	return n.Noise3D(x, 0, z)
+}
+
+// Noise3D returns the noise value at the coordinates passed.
+func (n *Noise) Noise3D(x, y, z float64) float64 {
+	x, y, z = x+n.ox, y+n.oy, z+n.oz
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+
+	p := &n.perm
+	a, b := int(p[xi])+yi, int(p[xi+1])+yi
+	aa, ab, ba, bb := int(p[a])+zi, int(p[a+1])+zi, int(p[b])+zi, int(p[b+1])+zi
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad(p[aa], x, y, z), grad(p[ba], x-1, y, z)),
+			lerp(u, grad(p[ab], x, y-1, z), grad(p[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, grad(p[aa+1], x, y, z-1), grad(p[ba+1], x-1, y, z-1)),
+			lerp(u, grad(p[ab+1], x, y-1, z-1), grad(p[bb+1], x-1, y-1, z-1))),
+	)
+}
+
+// Octaves2D returns fractal noise at the coordinates passed, created by adding the specified amount of octaves
+// of noise, each with double the frequency and half the amplitude of the previous one. The result is normalised
+// to the range of roughly -1 to 1.
+func (n *Noise) Octaves2D(x, z float64, octaves int) float64 {
+	var sum, amplitude, max = 0.0, 1.0, 0.0
+	for i := 0; i < octaves; i++ {
+		sum += n.Noise2D(x, z) * amplitude
+		max += amplitude
+		x, z, amplitude = x*2, z*2, amplitude/2
+	}
+	return sum / max
+}
+
+// Octaves3D returns fractal noise at the coordinates passed, similarly to Octaves2D.
+func (n *Noise) Octaves3D(x, y, z float64, octaves int) float64 {
+	var sum, amplitude, max = 0.0, 1.0, 0.0
+	for i := 0; i < octaves; i++ {
+		sum += n.Noise3D(x, y, z) * amplitude
+		max += amplitude
+		x, y, z, amplitude = x*2, y*2, z*2, amplitude/2
+	}
+	return sum / max
+}
+
+// fade is the smoothstep function 6t^5 - 15t^4 + 10t^3 used to ease coordinates towards integral values.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// lerp linearly interpolates between a and b using t.
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+// grad returns the dot product of the offset vector passed and one of twelve gradient vectors selected by the
+// hash passed.
+func grad(hash uint8, x, y, z float64) float64 {
+	h := hash & 15
+	u, v := x, y
+	if h >= 8 {
+		u = y
+	}
+	if h >= 4 {
+		v = x
+		if h != 12 && h != 14 {
+			v = z
+		}
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
@@ -0,0 +1,234 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"math"
+	"math/rand"
+)
+
+// Overworld is a generator that generates natural overworld terrain using layered noise. It generates continents
+// and oceans with hills and mountains, caves, ore veins, bedrock and biomes selected using temperature and
+// humidity, each with their own surface blocks and features such as trees, grass and flowers. The terrain
+// generated only depends on the seed of the generator, so that chunks generated later line up with chunks
+// generated before. Overworld may be constructed by calling NewOverworld.
+type Overworld struct {
+	seed int64
+
+	continental, hills, caves, caveTunnelsA, caveTunnelsB, temperature, humidity *Noise
+
+	air, bedrock, stone, deepslate, dirt, grass, water, lava, ice, snow uint32
+	ores                                                                []oreVein
+	biomes                                                              []surfaceBiome
+	trees                                                               []treeBlocks
+}
+
+// oreVein holds the properties of a type of ore or stone variant generated in veins by the Overworld generator.
+type oreVein struct {
+	// stone and deepslate are the runtime IDs of the block placed when the vein replaces stone and deepslate
+	// respectively.
+	stone, deepslate uint32
+	// attempts is the amount of veins attempted to be generated per chunk, size is the maximum amount of blocks in
+	// a single vein.
+	attempts, size int
+	// minY and maxY are the minimum and maximum Y values of the centre of a vein.
+	minY, maxY int
+}
+
+const (
+	// seaLevel is the Y level up to which oceans and lakes are filled with water.
+	seaLevel = 62
+	// lavaLevel is the height above the bottom of the world up to which caves are filled with lava.
+	lavaLevel = 8
+)
+
+// NewOverworld creates a new Overworld generator that generates terrain using the seed passed. Overworld
+// generators with the same seed generate the same terrain.
+func NewOverworld(seed int64) Overworld {
+	rid := world.BlockRuntimeID
+	g := Overworld{
+		seed:         seed,
+		continental:  NewNoise(seed),
+		hills:        NewNoise(seed + 1),
+		caves:        NewNoise(seed + 2),
+		caveTunnelsA: NewNoise(seed + 3),
+		caveTunnelsB: NewNoise(seed + 4),
+		temperature:  NewNoise(seed + 5),
+		humidity:     NewNoise(seed + 6),
+
+		air:       rid(block.Air{}),
+		bedrock:   rid(block.Bedrock{}),
+		stone:     rid(block.Stone{}),
+		deepslate: rid(block.Deepslate{Type: block.NormalDeepslate(), Axis: cube.Y}),
+		dirt:      rid(block.Dirt{}),
+		grass:     rid(block.Grass{}),
+		water:     rid(block.Water{Still: true, Depth: 8}),
+		lava:      rid(block.Lava{Still: true, Depth: 8}),
+		ice:       rid(block.Ice{}),
+		snow:      rid(block.SnowLayer{}),
+
+		biomes: newSurfaceBiomes(),
+		trees:  newTreeBlocks(),
+	}
+	g.ores = []oreVein{
+		{stone: g.dirt, deepslate: g.deepslate, attempts: 7, size: 30, minY: 0, maxY: 160},
+		{stone: rid(block.Gravel{}), deepslate: g.deepslate, attempts: 7, size: 30, minY: -64, maxY: 160},
+		{stone: rid(block.Granite{}), deepslate: g.deepslate, attempts: 5, size: 40, minY: 0, maxY: 128},
+		{stone: rid(block.Diorite{}), deepslate: g.deepslate, attempts: 5, size: 40, minY: 0, maxY: 128},
+		{stone: rid(block.Andesite{}), deepslate: g.deepslate, attempts: 5, size: 40, minY: 0, maxY: 128},
+		{stone: rid(block.CoalOre{Type: block.StoneOre()}), deepslate: rid(block.CoalOre{Type: block.DeepslateOre()}), attempts: 20, size: 14, minY: 0, maxY: 192},
+		{stone: rid(block.CopperOre{Type: block.StoneOre()}), deepslate: rid(block.CopperOre{Type: block.DeepslateOre()}), attempts: 12, size: 10, minY: -16, maxY: 112},
+		{stone: rid(block.IronOre{Type: block.StoneOre()}), deepslate: rid(block.IronOre{Type: block.DeepslateOre()}), attempts: 18, size: 9, minY: -24, maxY: 72},
+		{stone: rid(block.GoldOre{Type: block.StoneOre()}), deepslate: rid(block.GoldOre{Type: block.DeepslateOre()}), attempts: 4, size: 9, minY: -64, maxY: 32},
+		{stone: rid(block.LapisOre{Type: block.StoneOre()}), deepslate: rid(block.LapisOre{Type: block.DeepslateOre()}), attempts: 2, size: 7, minY: -32, maxY: 32},
+		{stone: rid(block.DiamondOre{Type: block.StoneOre()}), deepslate: rid(block.DiamondOre{Type: block.DeepslateOre()}), attempts: 2, size: 8, minY: -64, maxY: 16},
+		{stone: rid(block.EmeraldOre{Type: block.StoneOre()}), deepslate: rid(block.EmeraldOre{Type: block.DeepslateOre()}), attempts: 1, size: 3, minY: 32, maxY: 256},
+	}
+	return g
+}
+
+// GenerateChunk ...
+func (g Overworld) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	baseX, baseZ := int(pos[0])<<4, int(pos[1])<<4
+	r := rand.New(rand.NewSource(g.positionSeed(int64(pos[0]), int64(pos[1]), 0)))
+
+	var heights, biomes [16][16]int
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			wx, wz := baseX+int(x), baseZ+int(z)
+			h := g.height(wx, wz)
+			heights[x][z], biomes[x][z] = h, g.biome(wx, wz, h)
+			g.generateColumn(c, x, z, wx, wz, h, &g.biomes[biomes[x][z]], r)
+		}
+	}
+	g.generateOres(c, r)
+	g.generateTrees(c, baseX, baseZ)
+	g.decorate(c, heights, biomes, r)
+}
+
+// height returns the height of the terrain at the x and z coordinates passed. The height only depends on the
+// seed of the generator, so that it may also be calculated for columns outside the chunk being generated.
+func (g Overworld) height(x, z int) int {
+	fx, fz := float64(x), float64(z)
+	// Continentalness decides between deep oceans, lowlands and highlands over large distances.
+	continent := g.continental.Octaves2D(fx/700, fz/700, 4) * 1.6
+	// Hills add local variation, which is stronger further inland where mountains are formed.
+	hills := g.hills.Octaves2D(fx/160, fz/160, 5)
+	amplitude := 8 + math.Max(continent, 0)*60
+
+	h := float64(seaLevel) + 2 + continent*40 + hills*amplitude
+	return int(math.Max(math.Min(h, 250), -40))
+}
+
+// generateColumn fills a single column of the chunk with bedrock, stone, the surface blocks of the biome passed
+// and water, and carves caves out of it.
+func (g Overworld) generateColumn(c *chunk.Chunk, x, z uint8, wx, wz, h int, b *surfaceBiome, r *rand.Rand) {
+	min, top := int(c.Range().Min()), min(max(h, seaLevel), int(c.Range().Max()))
+	bedrockTop, deepslateTop := min+r.Intn(5), r.Intn(8)
+
+	for y := min; y <= top; y++ {
+		var rid uint32
+		switch {
+		case y <= bedrockTop:
+			rid = g.bedrock
+		case y > h:
+			rid = g.water
+			if y == seaLevel && b.frozen {
+				rid = g.ice
+			}
+		case g.cave(wx, y, wz, h):
+			if y <= min+lavaLevel {
+				rid = g.lava
+			} else {
+				continue
+			}
+		case y == h:
+			rid = b.top
+		case y > h-4:
+			rid = b.filler
+		case y > h-7:
+			rid = b.under
+		case y < deepslateTop:
+			rid = g.deepslate
+		default:
+			rid = g.stone
+		}
+		c.SetBlock(x, int16(y), z, 0, rid)
+	}
+	for y := int16(min); y <= int16(c.Range().Max()); y++ {
+		c.SetBiome(x, y, z, b.id)
+	}
+}
+
+// cave checks if the block at the position passed should be carved out by a cave. Caves consist of long tunnels
+// and large caverns deep underground. Caves never break through the ocean floor, to prevent oceans from draining.
+func (g Overworld) cave(x, y, z, h int) bool {
+	if y >= h || (h < seaLevel+3 && y > h-6) {
+		return false
+	}
+	fx, fy, fz := float64(x), float64(y), float64(z)
+	a, b := g.caveTunnelsA.Noise3D(fx/70, fy/35, fz/70), g.caveTunnelsB.Noise3D(fx/70, fy/35, fz/70)
+	if a*a+b*b < 0.0035 {
+		return true
+	}
+	if y < 32 && y < h-8 {
+		// Caverns are only generated deep enough underground, where they are wider near the bottom of the world.
+		threshold := 0.3 + float64(y+64)/96*0.15
+		return g.caves.Octaves3D(fx/90, fy/45, fz/90, 2) > threshold
+	}
+	return false
+}
+
+// generateOres generates veins of ores and stone variants in the chunk. Veins only replace stone and deepslate.
+func (g Overworld) generateOres(c *chunk.Chunk, r *rand.Rand) {
+	rg := c.Range()
+	for _, ore := range g.ores {
+		for i := 0; i < ore.attempts; i++ {
+			x, z := float64(r.Intn(16)), float64(r.Intn(16))
+			y := float64(max(ore.minY, rg.Min()) + r.Intn(max(min(ore.maxY, rg.Max())-max(ore.minY, rg.Min()), 1)))
+			for n := 0; n < ore.size; n++ {
+				bx, by, bz := int(x), int(y), int(z)
+				if bx >= 0 && bx < 16 && bz >= 0 && bz < 16 && by >= rg.Min() && by <= rg.Max() {
+					switch c.Block(uint8(bx), int16(by), uint8(bz), 0) {
+					case g.stone:
+						c.SetBlock(uint8(bx), int16(by), uint8(bz), 0, ore.stone)
+					case g.deepslate:
+						c.SetBlock(uint8(bx), int16(by), uint8(bz), 0, ore.deepslate)
+					}
+				}
+				// Veins spread out in a random walk from their centre.
+				x, y, z = x+float64(r.Intn(3)-1), y+float64(r.Intn(3)-1), z+float64(r.Intn(3)-1)
+			}
+		}
+	}
+}
+
+// decorate places the plants and flowers of the biomes of the chunk on top of their surface blocks, and covers
+// the surface of frozen biomes in snow.
+func (g Overworld) decorate(c *chunk.Chunk, heights, biomes [16][16]int, r *rand.Rand) {
+	rg := c.Range()
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			h, b := int16(heights[x][z]), &g.biomes[biomes[x][z]]
+			if h < seaLevel || int(h) < rg.Min() || int(h) >= rg.Max() || c.Block(x, h, z, 0) != b.top || c.Block(x, h+1, z, 0) != g.air {
+				continue
+			}
+			switch v := r.Float64(); {
+			case b.frozen:
+				c.SetBlock(x, h+1, z, 0, g.snow)
+			case v < b.flowerChance:
+				c.SetBlock(x, h+1, z, 0, b.flowers[r.Intn(len(b.flowers))])
+			case v < b.flowerChance+b.plantChance:
+				c.SetBlock(x, h+1, z, 0, b.plants[r.Intn(len(b.plants))])
+			}
+		}
+	}
+}
+
+// positionSeed returns a seed for a random number generator that is unique for the seed of the generator and the
+// coordinates and salt passed.
+func (g Overworld) positionSeed(x, z, salt int64) int64 {
+	return g.seed ^ (x * 341873128712) ^ (z * 132897987541) ^ (salt * 42317861)
+}
@@ -0,0 +1,203 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"math"
+)
+
+// surfaceBiome holds the properties of a biome that the Overworld generator uses to generate the surface and
+// features of the columns in that biome.
+type surfaceBiome struct {
+	// id is the encoded ID of the biome, which is stored in the chunk and sent to the client to colour grass,
+	// foliage and water.
+	id uint32
+	// top is the runtime ID of the block at the surface, filler is the runtime ID of the blocks directly below it
+	// and under is the runtime ID of the blocks below the filler, before stone starts.
+	top, filler, under uint32
+	// frozen specifies if water at sea level freezes and the surface is covered in snow in the biome.
+	frozen bool
+	// tree is the kind of tree generated in the biome and trees is the chance that a tree is generated in every
+	// tree cell.
+	tree  treeKind
+	trees float64
+	// plants are the runtime IDs of the small plants, such as tall grass, placed on top of the surface and
+	// plantChance is the chance that one of them is placed on a surface block.
+	plants      []uint32
+	plantChance float64
+	// flowers are the runtime IDs of flowers placed on top of the surface and flowerChance is the chance that
+	// one of them is placed on a surface block.
+	flowers      []uint32
+	flowerChance float64
+}
+
+const (
+	biomeOcean = iota
+	biomeDeepOcean
+	biomeFrozenOcean
+	biomeDeepFrozenOcean
+	biomeColdOcean
+	biomeDeepColdOcean
+	biomeLukewarmOcean
+	biomeDeepLukewarmOcean
+	biomeWarmOcean
+	biomeBeach
+	biomeSnowyBeach
+	biomePlains
+	biomeSnowyPlains
+	biomeForest
+	biomeBirchForest
+	biomeTaiga
+	biomeSnowyTaiga
+	biomeSwamp
+	biomeJungle
+	biomeSavanna
+	biomeDesert
+	biomeWindsweptHills
+	biomeSnowySlopes
+	biomeCount
+)
+
+// newSurfaceBiomes creates the surfaceBiomes used by the Overworld generator, indexed by the biome constants.
+func newSurfaceBiomes() []surfaceBiome {
+	rid := world.BlockRuntimeID
+	id := func(b world.Biome) uint32 { return uint32(b.EncodeBiome()) }
+	grass, dirt, sand, gravel, stone := rid(block.Grass{}), rid(block.Dirt{}), rid(block.Sand{}), rid(block.Gravel{}), rid(block.Stone{})
+	sandstone := rid(block.Sandstone{Type: block.NormalSandstone()})
+
+	tallGrass := []uint32{rid(block.TallGrass{Type: block.NormalTallGrass()})}
+	ferns := []uint32{rid(block.TallGrass{Type: block.NormalTallGrass()}), rid(block.TallGrass{Type: block.FernTallGrass()})}
+	flowers := func(types ...block.FlowerType) (f []uint32) {
+		for _, t := range types {
+			f = append(f, rid(block.Flower{Type: t}))
+		}
+		return f
+	}
+	plainsFlowers := flowers(block.Dandelion(), block.Poppy(), block.AzureBluet(), block.OxeyeDaisy(), block.Cornflower())
+	forestFlowers := flowers(block.Dandelion(), block.Poppy(), block.LilyOfTheValley())
+
+	ocean := func(b world.Biome, top uint32, frozen bool) surfaceBiome {
+		return surfaceBiome{id: id(b), top: top, filler: top, under: stone, frozen: frozen}
+	}
+	land := func(b world.Biome, frozen bool, tree treeKind, trees float64, plants []uint32, plantChance float64, flowers []uint32, flowerChance float64) surfaceBiome {
+		return surfaceBiome{
+			id: id(b), top: grass, filler: dirt, under: stone, frozen: frozen,
+			tree: tree, trees: trees,
+			plants: plants, plantChance: plantChance,
+			flowers: flowers, flowerChance: flowerChance,
+		}
+	}
+
+	b := make([]surfaceBiome, biomeCount)
+	b[biomeOcean] = ocean(biome.Ocean{}, sand, false)
+	b[biomeDeepOcean] = ocean(biome.DeepOcean{}, gravel, false)
+	b[biomeFrozenOcean] = ocean(biome.FrozenOcean{}, gravel, true)
+	b[biomeDeepFrozenOcean] = ocean(biome.DeepFrozenOcean{}, gravel, true)
+	b[biomeColdOcean] = ocean(biome.ColdOcean{}, gravel, false)
+	b[biomeDeepColdOcean] = ocean(biome.DeepColdOcean{}, gravel, false)
+	b[biomeLukewarmOcean] = ocean(biome.LukewarmOcean{}, sand, false)
+	b[biomeDeepLukewarmOcean] = ocean(biome.DeepLukewarmOcean{}, sand, false)
+	b[biomeWarmOcean] = ocean(biome.WarmOcean{}, sand, false)
+	b[biomeBeach] = surfaceBiome{id: id(biome.Beach{}), top: sand, filler: sand, under: sandstone}
+	b[biomeSnowyBeach] = surfaceBiome{id: id(biome.SnowyBeach{}), top: sand, filler: sand, under: sandstone, frozen: true}
+
+	b[biomePlains] = land(biome.Plains{}, false, treeOak, 0.02, tallGrass, 0.2, plainsFlowers, 0.015)
+	b[biomeSnowyPlains] = land(biome.SnowyPlains{}, true, treeSpruce, 0.02, nil, 0, nil, 0)
+	b[biomeForest] = land(biome.Forest{}, false, treeForest, 0.55, tallGrass, 0.08, forestFlowers, 0.01)
+	b[biomeBirchForest] = land(biome.BirchForest{}, false, treeBirch, 0.55, tallGrass, 0.08, forestFlowers, 0.01)
+	b[biomeTaiga] = land(biome.Taiga{}, false, treeSpruce, 0.45, ferns, 0.1, nil, 0)
+	b[biomeSnowyTaiga] = land(biome.SnowyTaiga{}, true, treeSpruce, 0.4, nil, 0, nil, 0)
+	b[biomeSwamp] = land(biome.Swamp{}, false, treeOak, 0.2, tallGrass, 0.1, flowers(block.BlueOrchid()), 0.01)
+	b[biomeJungle] = land(biome.Jungle{}, false, treeJungle, 0.7, ferns, 0.25, nil, 0)
+	b[biomeSavanna] = land(biome.Savanna{}, false, treeAcacia, 0.08, tallGrass, 0.3, nil, 0)
+	b[biomeWindsweptHills] = land(biome.WindsweptHills{}, false, treeSpruce, 0.08, tallGrass, 0.05, nil, 0)
+	b[biomeSnowySlopes] = surfaceBiome{id: id(biome.SnowySlopes{}), top: rid(block.Snow{}), filler: dirt, under: stone, frozen: true}
+	b[biomeDesert] = surfaceBiome{
+		id: id(biome.Desert{}), top: sand, filler: sand, under: sandstone,
+		tree: treeCactus, trees: 0.12,
+		plants: []uint32{rid(block.DeadBush{})}, plantChance: 0.005,
+	}
+	return b
+}
+
+// biome returns the index of the surfaceBiome of the column at the x and z coordinates passed with a terrain
+// height of h. Biomes are selected using temperature and humidity noise, with the height of the terrain deciding
+// between oceans, beaches, lowlands and mountains. Like the height, the biome only depends on the seed of the
+// generator.
+func (g Overworld) biome(x, z, h int) int {
+	fx, fz := float64(x), float64(z)
+	temperature := g.temperature.Octaves2D(fx/1100, fz/1100, 3) * 1.8
+	humidity := g.humidity.Octaves2D(fx/900, fz/900, 3) * 1.8
+	// The temperature drops higher up in the mountains.
+	temperature -= math.Max(float64(h-110), 0) / 120
+
+	switch {
+	case h < seaLevel:
+		deep := h < seaLevel-20
+		switch {
+		case temperature < -0.45:
+			return choose(deep, biomeDeepFrozenOcean, biomeFrozenOcean)
+		case temperature < -0.15:
+			return choose(deep, biomeDeepColdOcean, biomeColdOcean)
+		case temperature > 0.5:
+			return choose(deep, biomeDeepLukewarmOcean, biomeWarmOcean)
+		case temperature > 0.25:
+			return choose(deep, biomeDeepLukewarmOcean, biomeLukewarmOcean)
+		}
+		return choose(deep, biomeDeepOcean, biomeOcean)
+	case h <= seaLevel+2:
+		switch {
+		case temperature < -0.45:
+			return biomeSnowyBeach
+		case temperature > 0.5 && humidity < -0.1:
+			return biomeDesert
+		case humidity > 0.35 && temperature > -0.15 && temperature < 0.25:
+			return biomeSwamp
+		}
+		return biomeBeach
+	case h > 150:
+		return biomeSnowySlopes
+	case h > 120 && temperature > -0.45:
+		return biomeWindsweptHills
+	}
+	switch {
+	case temperature < -0.45:
+		return choose(humidity < 0, biomeSnowyPlains, biomeSnowyTaiga)
+	case temperature < -0.15:
+		return choose(humidity < -0.3, biomePlains, biomeTaiga)
+	case temperature < 0.25:
+		switch {
+		case humidity < -0.25:
+			return biomePlains
+		case humidity < 0.05:
+			return biomeForest
+		case humidity < 0.35:
+			return biomeBirchForest
+		}
+		return biomeSwamp
+	case temperature < 0.5:
+		switch {
+		case humidity < -0.1:
+			return biomePlains
+		case humidity < 0.3:
+			return biomeForest
+		}
+		return biomeJungle
+	}
+	switch {
+	case humidity < -0.1:
+		return biomeDesert
+	case humidity < 0.3:
+		return biomeSavanna
+	}
+	return biomeJungle
+}
+
+// choose returns a if cond is true and b otherwise.
+func choose(cond bool, a, b int) int {
+	if cond {
+		return a
+	}
+	return b
+}
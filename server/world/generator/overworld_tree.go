@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/mathutil"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"math/rand"
+)
+
+// treeKind is a kind of tree generated by the Overworld generator.
+type treeKind int
+
+const (
+	treeNone treeKind = iota
+	treeOak
+	treeBirch
+	// treeForest generates either oak or birch trees, with oak trees being more common.
+	treeForest
+	treeSpruce
+	treeJungle
+	treeAcacia
+	// treeCactus generates cacti rather than trees.
+	treeCactus
+	treeKindCount
+)
+
+// treeCell is the size of the grid cells in which at most one tree is generated.
+const treeCell = 5
+
+// treeBlocks holds the runtime IDs of the log and leaves of a treeKind.
+type treeBlocks struct {
+	log, leaves uint32
+}
+
+// newTreeBlocks returns the treeBlocks of all treeKinds, indexed by the treeKind.
+func newTreeBlocks() []treeBlocks {
+	wood := func(w block.WoodType) treeBlocks {
+		return treeBlocks{
+			log:    world.BlockRuntimeID(block.Log{Wood: w, Axis: cube.Y}),
+			leaves: world.BlockRuntimeID(block.Leaves{Wood: w}),
+		}
+	}
+	t := make([]treeBlocks, treeKindCount)
+	t[treeOak], t[treeForest] = wood(block.OakWood()), wood(block.OakWood())
+	t[treeBirch] = wood(block.BirchWood())
+	t[treeSpruce] = wood(block.SpruceWood())
+	t[treeJungle] = wood(block.JungleWood())
+	t[treeAcacia] = wood(block.AcaciaWood())
+	t[treeCactus] = treeBlocks{log: world.BlockRuntimeID(block.Cactus{})}
+	return t
+}
+
+// generateTrees generates the trees that are at least partially inside the chunk with the minimum X and Z
+// coordinates passed. Trees are generated in a grid of cells, each having at most one tree, so that trees that
+// cross chunk borders are generated identically in every chunk they are part of. The kind of tree and the chance
+// of a tree being generated in a cell depend on the biome of the cell.
+func (g Overworld) generateTrees(c *chunk.Chunk, baseX, baseZ int) {
+	minCellX, maxCellX := mathutil.FloorDiv(baseX-3, treeCell), mathutil.FloorDiv(baseX+18, treeCell)
+	minCellZ, maxCellZ := mathutil.FloorDiv(baseZ-3, treeCell), mathutil.FloorDiv(baseZ+18, treeCell)
+	for cx := minCellX; cx <= maxCellX; cx++ {
+		for cz := minCellZ; cz <= maxCellZ; cz++ {
+			r := rand.New(rand.NewSource(g.positionSeed(int64(cx), int64(cz), 1)))
+			x, z := cx*treeCell+1+r.Intn(treeCell-2), cz*treeCell+1+r.Intn(treeCell-2)
+
+			h := g.height(x, z)
+			b := &g.biomes[g.biome(x, z, h)]
+			if b.tree == treeNone || h < seaLevel || r.Float64() >= b.trees {
+				continue
+			}
+			kind := b.tree
+			if kind == treeForest && r.Intn(5) == 0 {
+				kind = treeBirch
+			}
+			g.generateTree(c, kind, x-baseX, h+1, z-baseZ, r)
+		}
+	}
+}
+
+// generateTree generates a tree of the treeKind passed with its trunk starting at the position passed, relative
+// to the chunk. Only the parts of the tree that are inside the chunk are placed. Leaves only replace air.
+func (g Overworld) generateTree(c *chunk.Chunk, kind treeKind, x, y, z int, r *rand.Rand) {
+	t := g.trees[kind]
+	switch kind {
+	case treeCactus:
+		for ly := y; ly < y+1+r.Intn(3); ly++ {
+			g.setTreeBlock(c, x, ly, z, t.log, false)
+		}
+		return
+	case treeSpruce:
+		g.generateSpruce(c, t, x, y, z, r)
+	case treeAcacia:
+		g.generateAcacia(c, t, x, y, z, r)
+	default:
+		height := 4 + r.Intn(3)
+		switch kind {
+		case treeBirch:
+			height++
+		case treeJungle:
+			height += 3 + r.Intn(4)
+		}
+		g.generateBlobTree(c, t, x, y, z, height, r)
+	}
+	g.setTreeBlock(c, x, y-1, z, g.dirt, true)
+}
+
+// generateBlobTree generates a tree with a trunk of the height passed and a round crown of leaves around the top
+// of the trunk, such as an oak or birch tree.
+func (g Overworld) generateBlobTree(c *chunk.Chunk, t treeBlocks, x, y, z, height int, r *rand.Rand) {
+	top := y + height - 1
+	for ly := top - 2; ly <= top+1; ly++ {
+		radius := 2
+		if ly >= top {
+			radius = 1
+		}
+		for dx := -radius; dx <= radius; dx++ {
+			for dz := -radius; dz <= radius; dz++ {
+				if abs(dx) == radius && abs(dz) == radius && (ly == top+1 || r.Intn(2) == 0) {
+					// Corners of the leaves are randomly left out to give trees a rounder shape.
+					continue
+				}
+				g.setTreeBlock(c, x+dx, ly, z+dz, t.leaves, false)
+			}
+		}
+	}
+	for ly := y; ly <= top; ly++ {
+		g.setTreeBlock(c, x, ly, z, t.log, true)
+	}
+}
+
+// generateSpruce generates a spruce tree: A tall trunk with layers of leaves that alternate in size, getting
+// wider towards the bottom of the tree.
+func (g Overworld) generateSpruce(c *chunk.Chunk, t treeBlocks, x, y, z int, r *rand.Rand) {
+	height := 6 + r.Intn(4)
+	top := y + height - 1
+	for i, ly := 0, top+1; ly >= y+2; i, ly = i+1, ly-1 {
+		radius := min((i+1)/2, 2)
+		if i > 2 && i%2 == 0 {
+			radius--
+		}
+		for dx := -radius; dx <= radius; dx++ {
+			for dz := -radius; dz <= radius; dz++ {
+				if radius > 0 && abs(dx) == radius && abs(dz) == radius {
+					continue
+				}
+				g.setTreeBlock(c, x+dx, ly, z+dz, t.leaves, false)
+			}
+		}
+	}
+	for ly := y; ly <= top; ly++ {
+		g.setTreeBlock(c, x, ly, z, t.log, true)
+	}
+}
+
+// generateAcacia generates an acacia tree: A trunk that bends diagonally near the top, ending in a wide, flat
+// crown of leaves.
+func (g Overworld) generateAcacia(c *chunk.Chunk, t treeBlocks, x, y, z int, r *rand.Rand) {
+	height := 5 + r.Intn(2)
+	dx, dz := r.Intn(2)*2-1, r.Intn(2)*2-1
+	for ly := y; ly < y+height-2; ly++ {
+		g.setTreeBlock(c, x, ly, z, t.log, true)
+	}
+	for i := 0; i < 2; i++ {
+		x, z = x+dx, z+dz
+		g.setTreeBlock(c, x, y+height-2+i, z, t.log, true)
+	}
+	top := y + height - 1
+	for ly, radius := top, 3; ly <= top+1; ly, radius = ly+1, radius-1 {
+		for lx := -radius; lx <= radius; lx++ {
+			for lz := -radius; lz <= radius; lz++ {
+				if abs(lx)+abs(lz) > radius+1 {
+					continue
+				}
+				g.setTreeBlock(c, x+lx, ly, z+lz, t.leaves, false)
+			}
+		}
+	}
+}
+
+// setTreeBlock sets the block at the position passed, relative to the chunk, if it is inside the chunk and its
+// range. If replace is false, the block is only set if the current block is air.
+func (g Overworld) setTreeBlock(c *chunk.Chunk, x, y, z int, rid uint32, replace bool) {
+	if x < 0 || x > 15 || z < 0 || z > 15 || y < c.Range().Min() || y > c.Range().Max() {
+		return
+	}
+	if replace || c.Block(uint8(x), int16(y), uint8(z), 0) == g.air {
+		c.SetBlock(uint8(x), int16(y), uint8(z), 0, rid)
+	}
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,16 @@
+package world
+
+// GriefingConfig holds the policy on blocks in a World being destroyed or changed by sources other than players,
+// such as explosions, mobs and fire. Blocks, entities and other sources that change blocks on their own consult
+// the GriefingConfig of the World before doing so. The zero value of GriefingConfig allows all griefing, like in
+// vanilla.
+type GriefingConfig struct {
+	// DisableExplosions stops explosions, such as those of TNT and beds, from destroying blocks and starting
+	// fires. Entities are still damaged and knocked back by explosions.
+	DisableExplosions bool
+	// DisableMobs stops mobs, such as endermen, from picking up, placing or destroying blocks.
+	DisableMobs bool
+	// DisableFire stops fire from burning away blocks and igniting TNT. Fire may still spread to air next to
+	// flammable blocks.
+	DisableFire bool
+}
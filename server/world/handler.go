@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
 )
 
 // Handler handles events that are called by a world. Implementations of Handler may be used to listen to
@@ -35,10 +36,24 @@ type Handler interface {
 	// wood, that can be broken by fire. HandleBlockBurn is often succeeded by HandleFireSpread, when fire spreads to
 	// the position of the original block and the event.Context is not cancelled in HandleBlockBurn.
 	HandleBlockBurn(ctx *event.Context, pos cube.Pos)
+	// HandleEntityBlockChange handles a block at a cube.Pos being changed from before to after by an entity other
+	// than a player, such as a falling block landing or a mob trampling farmland. ctx.Cancel() may be called to
+	// prevent the block from being changed. Changes made by mobs on their own accord are not made, and this event
+	// is not called, if GriefingConfig.DisableMobs is set.
+	HandleEntityBlockChange(ctx *event.Context, e Entity, pos cube.Pos, before, after Block)
+	// HandleItemDespawn handles an item entity despawning because it existed for longer than its lifetime. The
+	// item held by the entity may be obtained through its behaviour. ctx.Cancel() may be called to keep the item
+	// entity in the World for another full lifetime.
+	HandleItemDespawn(ctx *event.Context, e Entity)
 	// HandleEntitySpawn handles an entity being spawned into a World through a call to World.AddEntity.
 	HandleEntitySpawn(e Entity)
 	// HandleEntityDespawn handles an entity being despawned from a World through a call to World.RemoveEntity.
 	HandleEntityDespawn(e Entity)
+	// HandleSpawnSelection handles the selection of the spawn position of a player with a UUID that does not have a
+	// spawn of its own in the World, for example because it joins the World for the first time or because it
+	// respawns without having slept in a bed. The position selected, a random position within the spawn radius
+	// of the World, may be changed by assigning to *pos.
+	HandleSpawnSelection(id uuid.UUID, pos *cube.Pos)
 	// HandleClose handles the World being closed. HandleClose may be used as a moment to finish code running on other
 	// goroutines that operates on the World specifically. HandleClose is called directly before the World stops
 	// ticking and before any chunks are saved to disk.
@@ -53,12 +68,15 @@ var _ Handler = (*NopHandler)(nil)
 // Users may embed NopHandler to avoid having to implement each method.
 type NopHandler struct{}
 
-func (NopHandler) HandleLiquidFlow(*event.Context, cube.Pos, cube.Pos, Liquid, Block) {}
-func (NopHandler) HandleLiquidDecay(*event.Context, cube.Pos, Liquid, Liquid)         {}
-func (NopHandler) HandleLiquidHarden(*event.Context, cube.Pos, Block, Block, Block)   {}
-func (NopHandler) HandleSound(*event.Context, Sound, mgl64.Vec3)                      {}
-func (NopHandler) HandleFireSpread(*event.Context, cube.Pos, cube.Pos)                {}
-func (NopHandler) HandleBlockBurn(*event.Context, cube.Pos)                           {}
-func (NopHandler) HandleEntitySpawn(Entity)                                           {}
-func (NopHandler) HandleEntityDespawn(Entity)                                         {}
-func (NopHandler) HandleClose()                                                       {}
+func (NopHandler) HandleLiquidFlow(*event.Context, cube.Pos, cube.Pos, Liquid, Block)     {}
+func (NopHandler) HandleLiquidDecay(*event.Context, cube.Pos, Liquid, Liquid)             {}
+func (NopHandler) HandleLiquidHarden(*event.Context, cube.Pos, Block, Block, Block)       {}
+func (NopHandler) HandleSound(*event.Context, Sound, mgl64.Vec3)                          {}
+func (NopHandler) HandleFireSpread(*event.Context, cube.Pos, cube.Pos)                    {}
+func (NopHandler) HandleBlockBurn(*event.Context, cube.Pos)                               {}
+func (NopHandler) HandleEntityBlockChange(*event.Context, Entity, cube.Pos, Block, Block) {}
+func (NopHandler) HandleItemDespawn(*event.Context, Entity)                               {}
+func (NopHandler) HandleEntitySpawn(Entity)                                               {}
+func (NopHandler) HandleEntityDespawn(Entity)                                             {}
+func (NopHandler) HandleSpawnSelection(uuid.UUID, *cube.Pos)                              {}
+func (NopHandler) HandleClose()                                                           {}
@@ -89,6 +89,10 @@ func (l *Loader) Load(n int) {
 		}
 
 		pos := l.loadQueue[0]
+		if l.w.generationPaused() && !l.w.chunkCached(pos) {
+			// The World is in emergency mode, so no new chunks are loaded or generated until it recovers.
+			break
+		}
 		c := l.w.chunk(pos)
 
 		l.viewer.ViewChunk(pos, c.Chunk, c.BlockEntities)
@@ -6,7 +6,9 @@ import (
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/mcdb/leveldat"
 	"github.com/df-mc/goleveldb/leveldb"
+	"github.com/df-mc/goleveldb/leveldb/errors"
 	"github.com/df-mc/goleveldb/leveldb/opt"
+	"github.com/df-mc/goleveldb/leveldb/storage"
 	"github.com/sirupsen/logrus"
 	"os"
 	"path/filepath"
@@ -37,6 +39,12 @@ type Config struct {
 	// ReadOnly opens the DB in read-only mode. This will leave the data in the
 	// database unedited.
 	ReadOnly bool
+	// SyncWrites makes every write to the database wait until the data is
+	// flushed to disk. Without SyncWrites, writes that were not yet flushed by
+	// the operating system may be lost if the machine crashes, although the
+	// database is never left corrupted. Enabling SyncWrites makes saving
+	// chunks considerably slower.
+	SyncWrites bool
 
 	// Entities is an EntityRegistry with all entity types registered that may
 	// be read from the DB. Entities will default to entity.DefaultRegistry.
@@ -48,18 +56,13 @@ type Config struct {
 // initialise the world with it. If the data cannot be parsed, an error is
 // returned.
 func (conf Config) Open(dir string) (*DB, error) {
-	if conf.Log == nil {
-		conf.Log = logrus.New()
-	}
-	if conf.BlockSize == 0 {
-		conf.BlockSize = 16 * opt.KiB
-	}
-	if len(conf.Entities.Types()) == 0 {
-		conf.Entities = entity.DefaultRegistry
-	}
+	conf = conf.withDefaults()
 	_ = os.MkdirAll(filepath.Join(dir, "db"), 0777)
 
 	db := &DB{conf: conf, dir: dir, ldat: &leveldat.Data{}}
+	if !conf.ReadOnly {
+		db.removeIncomplete()
+	}
 	if _, err := os.Stat(filepath.Join(dir, "level.dat")); os.IsNotExist(err) {
 		// A level.dat was not currently present for the world.
 		db.ldat.FillDefault()
@@ -81,14 +84,75 @@ func (conf Config) Open(dir string) (*DB, error) {
 		}
 	}
 	db.set = db.ldat.Settings()
-	ldb, err := leveldb.OpenFile(filepath.Join(dir, "db"), &opt.Options{
+	o := &opt.Options{
 		Compression: conf.Compression,
 		BlockSize:   conf.BlockSize,
 		ReadOnly:    conf.ReadOnly,
-	})
+	}
+	ldb, err := leveldb.OpenFile(filepath.Join(dir, "db"), o)
+	if errors.IsCorrupted(err) && !conf.ReadOnly {
+		// The manifest of the database was damaged, for example because the process was stopped while it was
+		// written. The database can be restored from the tables that are still intact.
+		conf.Log.Errorf("leveldb database is corrupted (%v), attempting recovery", err)
+		ldb, err = leveldb.RecoverFile(filepath.Join(dir, "db"), o)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error opening leveldb database: %w", err)
 	}
 	db.ldb = ldb
 	return db, nil
 }
+
+// OpenMemory creates a new DB that keeps all of its data in memory. Data is
+// stored in the same format as in a DB created using Open, but nothing is
+// ever written to disk and all data is lost once the DB is closed. This makes
+// OpenMemory useful for tests and for temporary worlds, such as minigame maps
+// that are discarded after a game. Config.ReadOnly is ignored by OpenMemory.
+func (conf Config) OpenMemory() (*DB, error) {
+	conf = conf.withDefaults()
+	conf.ReadOnly = false
+
+	db := &DB{conf: conf, ldat: &leveldat.Data{}, memory: true}
+	db.ldat.FillDefault()
+	db.set = db.ldat.Settings()
+
+	ldb, err := leveldb.Open(storage.NewMemStorage(), &opt.Options{
+		Compression: conf.Compression,
+		BlockSize:   conf.BlockSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error opening in-memory leveldb database: %w", err)
+	}
+	db.ldb = ldb
+	return db, nil
+}
+
+// withDefaults returns a copy of the Config with default values filled out
+// for all fields that were left empty.
+func (conf Config) withDefaults() Config {
+	if conf.Log == nil {
+		conf.Log = logrus.New()
+	}
+	if conf.BlockSize == 0 {
+		conf.BlockSize = 16 * opt.KiB
+	}
+	if len(conf.Entities.Types()) == 0 {
+		conf.Entities = entity.DefaultRegistry
+	}
+	return conf
+}
+
+// removeIncomplete removes the temporary files of writes to the level.dat and
+// levelname.txt files that were interrupted, for example by a crash. The
+// files themselves are only replaced once the write completed, so they are
+// always left intact.
+func (db *DB) removeIncomplete() {
+	for _, name := range []string{"level.dat", "levelname.txt"} {
+		removed, err := leveldat.RemoveIncomplete(filepath.Join(db.dir, name))
+		if err != nil {
+			db.conf.Log.Errorf("remove incomplete %v: %v", name, err)
+		} else if removed {
+			db.conf.Log.Debugf("Removed incomplete write of %v left behind by previous shutdown.", name)
+		}
+	}
+}
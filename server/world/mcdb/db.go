@@ -10,10 +10,10 @@ import (
 	"github.com/df-mc/dragonfly/server/world/chunk"
 	"github.com/df-mc/dragonfly/server/world/mcdb/leveldat"
 	"github.com/df-mc/goleveldb/leveldb"
+	"github.com/df-mc/goleveldb/leveldb/opt"
 	"github.com/google/uuid"
 	"github.com/sandertv/gophertunnel/minecraft/nbt"
 	"golang.org/x/exp/maps"
-	"os"
 	"path/filepath"
 	"time"
 )
@@ -26,6 +26,9 @@ type DB struct {
 	dir  string
 	ldat *leveldat.Data
 	set  *world.Settings
+	// memory is true if the DB was opened using OpenMemory. No files are
+	// written for DBs that are kept in memory.
+	memory bool
 }
 
 // Open creates a new provider reading and writing from/to files under the path
@@ -37,6 +40,13 @@ func Open(dir string) (*DB, error) {
 	return conf.Open(dir)
 }
 
+// OpenMemory creates a new provider that keeps all of its data in memory
+// using default options. All data is lost once the provider is closed.
+func OpenMemory() (*DB, error) {
+	var conf Config
+	return conf.OpenMemory()
+}
+
 // Settings returns the world.Settings of the world loaded by the DB.
 func (db *DB) Settings() *world.Settings {
 	return db.set
@@ -61,6 +71,10 @@ func (db *DB) LoadPlayerSpawnPosition(id uuid.UUID) (pos cube.Pos, exists bool,
 		return cube.Pos{}, exists, err
 	}
 	x, y, z := serverData["SpawnX"], serverData["SpawnY"], serverData["SpawnZ"]
+	if x == nil && y == nil && z == nil {
+		// The spawn position of the player was cleared.
+		return cube.Pos{}, false, nil
+	}
 	if x == nil || y == nil || z == nil {
 		return cube.Pos{}, true, fmt.Errorf("error reading spawn fields from server data for player %v", id)
 	}
@@ -108,7 +122,7 @@ func (db *DB) SavePlayerSpawnPosition(id uuid.UUID, pos cube.Pos) error {
 		if err != nil {
 			panic(err)
 		}
-		if err := db.ldb.Put([]byte("player_"+id.String()), data, nil); err != nil {
+		if err := db.ldb.Put([]byte("player_"+id.String()), data, db.writeOptions()); err != nil {
 			return fmt.Errorf("error writing player data for id %v: %w", id, err)
 		}
 	} else {
@@ -124,7 +138,28 @@ func (db *DB) SavePlayerSpawnPosition(id uuid.UUID, pos cube.Pos) error {
 	if err != nil {
 		panic(err)
 	}
-	if err = db.ldb.Put([]byte(k), data, nil); err != nil {
+	if err = db.ldb.Put([]byte(k), data, db.writeOptions()); err != nil {
+		return fmt.Errorf("error writing server data for player %v: %w", id, err)
+	}
+	return nil
+}
+
+// ClearPlayerSpawnPosition removes the spawn position of the player with the UUID passed from the levelDB
+// database. Any other data stored for the player is kept.
+func (db *DB) ClearPlayerSpawnPosition(id uuid.UUID) error {
+	d, k, exists, err := db.loadPlayerData(id)
+	if !exists || err != nil {
+		return err
+	}
+	delete(d, "SpawnX")
+	delete(d, "SpawnY")
+	delete(d, "SpawnZ")
+
+	data, err := nbt.MarshalEncoding(d, nbt.LittleEndian)
+	if err != nil {
+		panic(err)
+	}
+	if err = db.ldb.Put([]byte(k), data, db.writeOptions()); err != nil {
 		return fmt.Errorf("error writing server data for player %v: %w", id, err)
 	}
 	return nil
@@ -315,6 +350,9 @@ func (db *DB) StoreColumn(pos world.ChunkPos, dim world.Dimension, col *world.Co
 	return nil
 }
 
+// storeColumn writes all data of a column in a single batch. The batch is
+// committed atomically by leveldb, so a column is never partially stored, even
+// if the process is stopped while writing.
 func (db *DB) storeColumn(k dbKey, col *world.Column) error {
 	data := chunk.Encode(col.Chunk, chunk.DiskEncoding)
 	n := 5 + len(data.SubChunks)
@@ -327,7 +365,12 @@ func (db *DB) storeColumn(k dbKey, col *world.Column) error {
 	db.storeEntities(batch, k, col.Entities)
 	db.storeBlockEntities(batch, k, col.BlockEntities)
 
-	return db.ldb.Write(batch, nil)
+	return db.ldb.Write(batch, db.writeOptions())
+}
+
+// writeOptions returns the options used for writes to the leveldb database.
+func (db *DB) writeOptions() *opt.WriteOptions {
+	return &opt.WriteOptions{Sync: db.conf.SyncWrites}
 }
 
 func (db *DB) storeVersion(batch *leveldb.Batch, k dbKey, ver uint8) {
@@ -407,8 +450,12 @@ func (db *DB) NewColumnIterator(r *IteratorRange) *ColumnIterator {
 	return newColumnIterator(db, r)
 }
 
-// Close closes the provider, saving any file that might need to be saved, such as the level.dat.
+// Close closes the provider, saving any file that might need to be saved, such as the level.dat. If the DB
+// was opened using OpenMemory, all of its data is discarded.
 func (db *DB) Close() error {
+	if db.memory {
+		return db.ldb.Close()
+	}
 	db.ldat.LastPlayed = time.Now().Unix()
 
 	var ldat leveldat.LevelDat
@@ -418,7 +465,7 @@ func (db *DB) Close() error {
 	if err := ldat.WriteFile(filepath.Join(db.dir, "level.dat")); err != nil {
 		return fmt.Errorf("close: %w", err)
 	}
-	if err := os.WriteFile(filepath.Join(db.dir, "levelname.txt"), []byte(db.ldat.LevelName), 0644); err != nil {
+	if err := leveldat.WriteFileAtomic(filepath.Join(db.dir, "levelname.txt"), []byte(db.ldat.LevelName)); err != nil {
 		return fmt.Errorf("close: write levelname.txt: %w", err)
 	}
 	return db.ldb.Close()
@@ -207,7 +207,6 @@ func (d *Data) FillDefault() {
 	d.ShowTags = true
 	d.SpawnMobs = true
 	d.SpawnRadius = 5
-	d.SpawnRadius = 5
 	d.SpawnY = math.MaxInt16
 	d.StorageVersion = 9
 	d.TNTExplodes = true
@@ -221,30 +220,38 @@ func (d *Data) Settings() *world.Settings {
 	difficulty, _ := world.DifficultyByID(int(d.Difficulty))
 	mode, _ := world.GameModeByID(int(d.GameType))
 	return &world.Settings{
-		Name:            d.LevelName,
-		Spawn:           cube.Pos{int(d.SpawnX), int(d.SpawnY), int(d.SpawnZ)},
-		Time:            d.Time,
-		TimeCycle:       d.DoDayLightCycle,
-		RainTime:        int64(d.RainTime),
-		Raining:         d.RainLevel > 0,
-		ThunderTime:     int64(d.LightningTime),
-		Thundering:      d.LightningLevel > 0,
-		WeatherCycle:    d.DoWeatherCycle,
-		CurrentTick:     d.CurrentTick,
-		DefaultGameMode: mode,
-		Difficulty:      difficulty,
-		TickRange:       d.ServerChunkTickRange,
+		Name:                 d.LevelName,
+		Seed:                 d.RandomSeed,
+		Spawn:                cube.Pos{int(d.SpawnX), int(d.SpawnY), int(d.SpawnZ)},
+		SpawnRadius:          d.SpawnRadius,
+		Time:                 d.Time,
+		TimeCycle:            d.DoDayLightCycle,
+		RainTime:             int64(d.RainTime),
+		Raining:              d.RainLevel > 0,
+		ThunderTime:          int64(d.LightningTime),
+		Thundering:           d.LightningLevel > 0,
+		WeatherCycle:         d.DoWeatherCycle,
+		FireTick:             d.DoFireTick,
+		RespawnBlocksExplode: d.RespawnBlocksExplode,
+		CurrentTick:          d.CurrentTick,
+		DefaultGameMode:      mode,
+		Difficulty:           difficulty,
+		TickRange:            d.ServerChunkTickRange,
 	}
 }
 
 // PutSettings updates d with the Settings stored in s.
 func (d *Data) PutSettings(s *world.Settings) {
 	d.LevelName = s.Name
+	d.RandomSeed = s.Seed
 	d.SpawnX, d.SpawnY, d.SpawnZ = int32(s.Spawn.X()), int32(s.Spawn.Y()), int32(s.Spawn.Z())
 	d.LimitedWorldOriginX, d.LimitedWorldOriginY, d.LimitedWorldOriginZ = d.SpawnX, d.SpawnY, d.SpawnZ
+	d.SpawnRadius = s.SpawnRadius
 	d.Time = s.Time
 	d.DoDayLightCycle = s.TimeCycle
 	d.DoWeatherCycle = s.WeatherCycle
+	d.DoFireTick = s.FireTick
+	d.RespawnBlocksExplode = s.RespawnBlocksExplode
 	d.RainTime, d.RainLevel = int32(s.RainTime), 0
 	d.LightningTime, d.LightningLevel = int32(s.ThunderTime), 0
 	if s.Raining {
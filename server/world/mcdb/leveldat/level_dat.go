@@ -2,11 +2,14 @@ package leveldat
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"github.com/sandertv/gophertunnel/minecraft/nbt"
 	"io"
 	"os"
+	"path/filepath"
+	"runtime"
 )
 
 // LevelDat implements the encoding and decoding of level.dat files. An empty
@@ -39,8 +42,12 @@ func Read(r io.Reader) (*LevelDat, error) {
 	if err := binary.Read(r, binary.LittleEndian, &ldat.hdr); err != nil {
 		return nil, fmt.Errorf("level.dat: read header: %w", err)
 	}
+	if ldat.hdr.FileLength < 0 {
+		return nil, fmt.Errorf("level.dat: invalid file length %v", ldat.hdr.FileLength)
+	}
 	ldat.data = make([]byte, ldat.hdr.FileLength)
-	if n, err := r.Read(ldat.data); err != nil || int32(n) != ldat.hdr.FileLength {
+	if _, err := io.ReadFull(r, ldat.data); err != nil {
+		// A level.dat shorter than the length in its header was not written completely.
 		return nil, fmt.Errorf("level.dat: read data: %w", err)
 	}
 	return &ldat, nil
@@ -88,16 +95,84 @@ func (ld *LevelDat) Write(w io.Writer) error {
 	return nil
 }
 
-// WriteFile writes ld to a file at name.
+// WriteFile writes ld to a file at name using WriteFileAtomic, so that the file
+// at name is never left partially written if the process is stopped while
+// writing. The temporary file of an interrupted write may be removed using
+// RemoveIncomplete.
 func (ld *LevelDat) WriteFile(name string) error {
-	f, err := os.OpenFile(name, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	buf := bytes.NewBuffer(make([]byte, 0, len(ld.data)+8))
+	if err := ld.Write(buf); err != nil {
+		return err
+	}
+	if err := WriteFileAtomic(name, buf.Bytes()); err != nil {
+		return fmt.Errorf("level.dat: write file: %w", err)
+	}
+	return nil
+}
+
+// TempSuffix is the suffix of the temporary file that WriteFile and
+// WriteFileAtomic write to before replacing the file.
+const TempSuffix = ".tmp"
+
+// RemoveIncomplete removes the temporary file left behind by a call to
+// WriteFile or WriteFileAtomic for the file at name that was interrupted, for example by a crash.
+// RemoveIncomplete returns true if such a file was found.
+func RemoveIncomplete(name string) (bool, error) {
+	if err := os.Remove(name + TempSuffix); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("level.dat: remove incomplete file: %w", err)
+	}
+	return true, nil
+}
+
+// WriteFileAtomic writes data to the file at name through a temporary file,
+// which then replaces the file at name, so that the file is never left
+// partially written. The temporary file and its directory are synced to disk
+// before renaming, and the directory is synced again afterwards, so that a
+// crash cannot leave an empty or missing file behind. WriteFileAtomic is used
+// for all files of a world that are replaced as a whole, such as level.dat.
+func WriteFileAtomic(name string, data []byte) error {
+	tmp := name + TempSuffix
+	f, err := os.OpenFile(tmp, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
-		return fmt.Errorf("level.dat: open file: %w", err)
-	}
-	w := bufio.NewWriter(f)
-	defer func() {
-		_ = w.Flush()
-		_ = f.Close()
-	}()
-	return ld.Write(w)
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = syncDir(filepath.Dir(name))
+	}
+	if err == nil {
+		err = os.Rename(tmp, name)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return syncDir(filepath.Dir(name))
+}
+
+// syncDir syncs the directory at dir to disk, so that changes to the entries
+// in it, such as created or renamed files, are persisted. Directories cannot
+// be synced on Windows, where syncDir does nothing.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if closeErr := d.Close(); err == nil {
+		err = closeErr
+	}
+	return err
 }
@@ -0,0 +1,12 @@
+// Package plot implements a plot world generator and primitives to find out which plot a position belongs to.
+// A plot world is divided into a grid of square plots of the same size, separated by roads. Plot ownership,
+// claiming and permissions are not implemented by this package, but may be built on top of it by plugins,
+// using the Pos of a plot as its identifier.
+//
+//	l := plot.Layout{Size: 32, RoadWidth: 7}
+//	w := world.Config{Generator: plot.Config{Layout: l}.New()}.New()
+//
+//	if p, ok := l.PlotAt(pos); ok {
+//		// The block at pos is part of plot p.
+//	}
+package plot
@@ -0,0 +1,110 @@
+package plot
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+)
+
+// Config holds the configuration of a plot Generator. A Generator may be created by calling Config.New.
+type Config struct {
+	// Layout is the Layout of the plots generated.
+	Layout Layout
+	// Biome is the biome that the world is filled with. If nil, biome.Plains is used.
+	Biome world.Biome
+	// Layers is a list of block layers placed in plots, ordered from the top layer to the bottom layer. If
+	// empty, plots are made up of grass, two layers of dirt and bedrock.
+	Layers []world.Block
+	// Road is the block that the top layer of roads is made of. If nil, roads are oak planks.
+	Road world.Block
+	// Border is the block placed on top of the road along the edges of every plot. If nil, smooth stone slabs
+	// are used. Use block.Air to generate no border.
+	Border world.Block
+}
+
+// New creates a Generator using the fields of the Config.
+func (conf Config) New() Generator {
+	if conf.Biome == nil {
+		conf.Biome = biome.Plains{}
+	}
+	if len(conf.Layers) == 0 {
+		conf.Layers = []world.Block{block.Grass{}, block.Dirt{}, block.Dirt{}, block.Bedrock{}}
+	}
+	if conf.Road == nil {
+		conf.Road = block.Planks{Wood: block.OakWood()}
+	}
+	if conf.Border == nil {
+		conf.Border = block.Slab{Block: block.Stone{Smooth: true}}
+	}
+	g := Generator{
+		layout: conf.Layout,
+		biome:  uint32(conf.Biome.EncodeBiome()),
+		layers: make([]uint32, len(conf.Layers)),
+		road:   world.BlockRuntimeID(conf.Road),
+		border: world.BlockRuntimeID(conf.Border),
+	}
+	for i, b := range conf.Layers {
+		g.layers[i] = world.BlockRuntimeID(b)
+	}
+	return g
+}
+
+// Generator is a world.Generator that generates a flat world divided into plots by roads, following a Layout.
+type Generator struct {
+	layout Layout
+
+	biome        uint32
+	layers       []uint32
+	road, border uint32
+}
+
+// Layout returns the Layout of the plots generated by the Generator.
+func (g Generator) Layout() Layout {
+	return g.layout
+}
+
+// Height returns the Y coordinate of the top layer of the plots generated in a world with the cube.Range passed.
+// Players may be teleported to a plot at a Y coordinate of Height+1.
+func (g Generator) Height(r cube.Range) int {
+	return r.Min() + len(g.layers) - 1
+}
+
+// GenerateChunk ...
+func (g Generator) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	min, max := int16(c.Range().Min()), int16(c.Range().Max())
+	n := int16(len(g.layers))
+	size, cell := g.layout.size(), g.layout.cell()
+
+	for x := uint8(0); x < 16; x++ {
+		mx := mod(int(pos[0])*16+int(x), cell)
+		for z := uint8(0); z < 16; z++ {
+			mz := mod(int(pos[1])*16+int(z), cell)
+			road := mx >= size || mz >= size
+
+			for y := int16(0); y <= max-min; y++ {
+				if y < n {
+					rid := g.layers[n-y-1]
+					if road && y == n-1 {
+						rid = g.road
+					}
+					c.SetBlock(x, min+y, z, 0, rid)
+				}
+				c.SetBiome(x, min+y, z, g.biome)
+			}
+			if road && g.borderColumn(mx, mz) && n <= max-min {
+				c.SetBlock(x, min+n, z, 0, g.border)
+			}
+		}
+	}
+}
+
+// borderColumn checks if the column of a road at the offsets passed from the start of a plot is directly next to,
+// or diagonal to, a plot, which is where the border is placed.
+func (g Generator) borderColumn(mx, mz int) bool {
+	size, cell := g.layout.size(), g.layout.cell()
+	edge := func(m int) bool { return m == size || m == cell-1 }
+	plot := func(m int) bool { return m < size }
+	return (edge(mx) && (plot(mz) || edge(mz))) || (edge(mz) && (plot(mx) || edge(mx)))
+}
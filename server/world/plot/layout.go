@@ -0,0 +1,119 @@
+package plot
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/mathutil"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Pos is the position of a plot in the grid of plots of a Layout. Plot 0, 0 starts at the origin of the world, and
+// the X and Z of a Pos increase in the same directions as the X and Z coordinates of the world.
+type Pos [2]int
+
+// X returns the X coordinate of the plot in the grid.
+func (p Pos) X() int {
+	return p[0]
+}
+
+// Z returns the Z coordinate of the plot in the grid.
+func (p Pos) Z() int {
+	return p[1]
+}
+
+// Add adds two plot positions together, returning the resulting Pos.
+func (p Pos) Add(other Pos) Pos {
+	return Pos{p[0] + other[0], p[1] + other[1]}
+}
+
+// String returns the Pos as a string in the format 'x;z', which may be used as a key to store plots with.
+func (p Pos) String() string {
+	return fmt.Sprintf("%v;%v", p[0], p[1])
+}
+
+// Layout is the layout of the plots of a world: The size of every plot and the width of the roads between them.
+type Layout struct {
+	// Size is the length in blocks of the sides of every plot. If set to 0, plots are 32x32 blocks.
+	Size int
+	// RoadWidth is the width in blocks of the roads between plots. If set to 0, roads are 7 blocks wide.
+	RoadWidth int
+}
+
+// size returns the Size of the Layout, taking the default into account.
+func (l Layout) size() int {
+	if l.Size <= 0 {
+		return 32
+	}
+	return l.Size
+}
+
+// roadWidth returns the RoadWidth of the Layout, taking the default into account.
+func (l Layout) roadWidth() int {
+	if l.RoadWidth <= 0 {
+		return 7
+	}
+	return l.RoadWidth
+}
+
+// cell returns the length of a single plot together with the road on one side of it.
+func (l Layout) cell() int {
+	return l.size() + l.roadWidth()
+}
+
+// PlotAt returns the Pos of the plot that the block position passed is part of. False is returned if the position
+// is on a road. The Y coordinate of the position is not taken into account.
+func (l Layout) PlotAt(pos cube.Pos) (Pos, bool) {
+	cell := l.cell()
+	if mod(pos[0], cell) >= l.size() || mod(pos[2], cell) >= l.size() {
+		return Pos{}, false
+	}
+	return Pos{mathutil.FloorDiv(pos[0], cell), mathutil.FloorDiv(pos[2], cell)}, true
+}
+
+// Road checks if the block position passed is on a road rather than part of a plot.
+func (l Layout) Road(pos cube.Pos) bool {
+	_, ok := l.PlotAt(pos)
+	return !ok
+}
+
+// Bounds returns the minimum and maximum X and Z coordinates of the blocks that are part of the plot at the Pos
+// passed. The Y coordinates of the positions returned are always 0.
+func (l Layout) Bounds(p Pos) (min, max cube.Pos) {
+	cell, size := l.cell(), l.size()
+	min = cube.Pos{p[0] * cell, 0, p[1] * cell}
+	return min, cube.Pos{min[0] + size - 1, 0, min[2] + size - 1}
+}
+
+// Box returns a cube.BBox that spans the plot at the Pos passed between the Y coordinates passed, which may be
+// passed to a region.Config to detect players entering and leaving the plot.
+func (l Layout) Box(p Pos, minY, maxY int) cube.BBox {
+	min, max := l.Bounds(p)
+	return cube.Box(float64(min[0]), float64(minY), float64(min[2]), float64(max[0]+1), float64(maxY+1), float64(max[2]+1))
+}
+
+// Contains checks if the block position passed is part of the plot at the Pos passed.
+func (l Layout) Contains(p Pos, pos cube.Pos) bool {
+	other, ok := l.PlotAt(pos)
+	return ok && other == p
+}
+
+// Centre returns the centre of the plot at the Pos passed at the Y coordinate passed.
+func (l Layout) Centre(p Pos, y float64) mgl64.Vec3 {
+	min, _ := l.Bounds(p)
+	half := float64(l.size()) / 2
+	return mgl64.Vec3{float64(min[0]) + half, y, float64(min[2]) + half}
+}
+
+// Entrance returns the position on the road in front of the plot at the Pos passed, at the Y coordinate passed,
+// and the rotation with which an entity at that position faces the plot. The position is not within the plot.
+func (l Layout) Entrance(p Pos, y float64) (mgl64.Vec3, cube.Rotation) {
+	min, _ := l.Bounds(p)
+	// The entrance is on the north side of the plot, so the plot is in the positive Z direction, which is
+	// faced with a yaw of 0.
+	return mgl64.Vec3{float64(min[0]) + float64(l.size())/2, y, float64(min[2]) - float64(l.roadWidth())/2}, cube.Rotation{}
+}
+
+// mod returns a modulo b, always returning a positive value for a positive b.
+func mod(a, b int) int {
+	return ((a % b) + b) % b
+}
@@ -58,8 +58,8 @@ func (p SubChunkPos) Z() int32 {
 // those of the Vec3 divided by 16, then rounded down.
 func chunkPosFromVec3(vec3 mgl64.Vec3) ChunkPos {
 	return ChunkPos{
-		int32(math.Floor(vec3[0])) >> 4,
-		int32(math.Floor(vec3[2])) >> 4,
+		int32(int64(math.Floor(vec3[0])) >> 4),
+		int32(int64(math.Floor(vec3[2])) >> 4),
 	}
 }
 
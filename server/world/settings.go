@@ -3,7 +3,9 @@ package world
 import (
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"math"
 	"sync"
+	"time"
 )
 
 // Settings holds the settings of a World. These are typically saved to a level.dat file. It is safe to pass the same
@@ -14,8 +16,15 @@ type Settings struct {
 
 	// Name is the display name of the World.
 	Name string
+	// Seed is the seed of the World. Generators may use it to generate the same terrain every time the World is
+	// loaded.
+	Seed int64
 	// Spawn is the spawn position of the World. New players that join the world will be spawned here.
 	Spawn cube.Pos
+	// SpawnRadius is the radius in blocks around Spawn in which players without a spawn of their own are spawned.
+	// A random position within this radius is selected for every player. If set to 0, players always spawn
+	// exactly at Spawn.
+	SpawnRadius int32
 	// Time is the current time of the World. It advances every tick if TimeCycle is set to true.
 	Time int64
 	// TimeCycle specifies if the time should advance every tick. If set to false, time won't change.
@@ -38,6 +47,12 @@ type Settings struct {
 	// Difficulty is the difficulty of the World. Behaviour of hunger, regeneration and monsters differs based on the
 	// difficulty of the world.
 	Difficulty Difficulty
+	// FireTick specifies if fire should spread, burn away blocks and burn out naturally. If set to false, fire
+	// remains where it is until it is extinguished.
+	FireTick bool
+	// RespawnBlocksExplode specifies if respawn blocks, such as beds, explode when used in a dimension they cannot
+	// set the spawn point in, such as beds in the nether and the end.
+	RespawnBlocksExplode bool
 	// TickRange is the radius in chunks around a Viewer that has its blocks and entities ticked when the world is
 	// ticked. If set to 0, blocks and entities will never be ticked.
 	TickRange int32
@@ -46,11 +61,16 @@ type Settings struct {
 // defaultSettings returns the default Settings for a new World.
 func defaultSettings() *Settings {
 	return &Settings{
-		Name:            "World",
-		DefaultGameMode: GameModeSurvival,
-		Difficulty:      DifficultyNormal,
-		TimeCycle:       true,
-		WeatherCycle:    true,
-		TickRange:       6,
+		Name:                 "World",
+		Seed:                 time.Now().Unix(),
+		Spawn:                cube.Pos{0, math.MaxInt16, 0},
+		DefaultGameMode:      GameModeSurvival,
+		Difficulty:           DifficultyNormal,
+		TimeCycle:            true,
+		WeatherCycle:         true,
+		FireTick:             true,
+		RespawnBlocksExplode: true,
+		TickRange:            6,
+		SpawnRadius:          5,
 	}
 }
@@ -0,0 +1,66 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/google/uuid"
+)
+
+// Sleeper represents an entity that is able to sleep in a bed, such as a player.
+type Sleeper interface {
+	Entity
+	// UUID returns the UUID of the Sleeper. It is used to store the spawn position set by sleeping in a bed.
+	UUID() uuid.UUID
+	// Sleep makes the Sleeper start sleeping in the bed at the position passed.
+	Sleep(pos cube.Pos)
+	// Sleeping returns the position of the bed the Sleeper is sleeping in, and true if it currently is sleeping.
+	Sleeping() (cube.Pos, bool)
+	// Wake makes the Sleeper wake up if it is currently sleeping.
+	Wake()
+}
+
+// sleepDuration is the amount of ticks that all Sleepers in a World must have been sleeping for before the night
+// is skipped.
+const sleepDuration = 100
+
+// tickSleeping checks if all Sleepers in the World are currently sleeping. If this has been the case for
+// sleepDuration ticks, the time is advanced to the next morning, rain and thunder are stopped and all Sleepers are
+// woken up.
+func (t ticker) tickSleeping(tim int) {
+	if !t.w.conf.Dim.TimeCycle() {
+		return
+	}
+	var sleepers []Sleeper
+
+	t.w.entityMu.RLock()
+	for e := range t.w.entities {
+		if e.Type().EncodeEntity() != "minecraft:player" {
+			// Only players need to be sleeping for the night to be skipped.
+			continue
+		}
+		if s, ok := e.(Sleeper); ok {
+			if _, sleeping := s.Sleeping(); !sleeping {
+				t.w.entityMu.RUnlock()
+				t.w.sleepTicks = 0
+				return
+			}
+			sleepers = append(sleepers, s)
+		}
+	}
+	t.w.entityMu.RUnlock()
+
+	if len(sleepers) == 0 {
+		t.w.sleepTicks = 0
+		return
+	}
+	if t.w.sleepTicks++; t.w.sleepTicks < sleepDuration {
+		return
+	}
+	t.w.sleepTicks = 0
+
+	t.w.SetTime(tim - tim%24000 + 24000)
+	t.w.StopThundering()
+	t.w.StopRaining()
+	for _, s := range sleepers {
+		s.Wake()
+	}
+}
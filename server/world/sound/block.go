@@ -51,6 +51,12 @@ type BarrelOpen struct{ sound }
 // BarrelClose is played when a barrel is closed.
 type BarrelClose struct{ sound }
 
+// ShulkerBoxOpen is played when a shulker box is opened.
+type ShulkerBoxOpen struct{ sound }
+
+// ShulkerBoxClose is played when a shulker box is closed.
+type ShulkerBoxClose struct{ sound }
+
 // Deny is a sound played when a block is placed or broken above a 'Deny' block from Education edition.
 type Deny struct{ sound }
 
@@ -114,6 +120,18 @@ type DoorCrash struct{ sound }
 // Click is a clicking sound.
 type Click struct{ sound }
 
+// PowerOn is a sound played when a redstone component, such as a lever or a button, is switched on.
+type PowerOn struct{ sound }
+
+// PowerOff is a sound played when a redstone component, such as a lever or a button, is switched off.
+type PowerOff struct{ sound }
+
+// PistonExtend is a sound played when a piston pushes out its arm.
+type PistonExtend struct{ sound }
+
+// PistonRetract is a sound played when a piston pulls back its arm.
+type PistonRetract struct{ sound }
+
 // Ignite is a sound played when using a flint & steel.
 type Ignite struct{ sound }
 
@@ -176,6 +194,12 @@ type ComposterReady struct{ sound }
 // LecternBookPlace is a sound played when a book is placed in a lectern.
 type LecternBookPlace struct{ sound }
 
+// BellRing is a sound played when a bell is rung.
+type BellRing struct{ sound }
+
+// LodestoneCompassLink is a sound played when a compass is bound to a lodestone.
+type LodestoneCompassLink struct{ sound }
+
 // sound implements the world.Sound interface.
 type sound struct{}
 
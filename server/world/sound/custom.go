@@ -0,0 +1,36 @@
+package sound
+
+import "time"
+
+// Custom is a sound defined in a resource pack, identified by its name in the sound_definitions.json file of
+// the pack. A Custom sound may be played like any other sound, for example using World.PlaySound.
+type Custom struct {
+	// Name is the name of the sound, such as 'mypack.boss.roar'.
+	Name string
+	// Volume is the volume of the sound. If set to 0, a volume of 1 is used.
+	Volume float64
+	// Pitch is the pitch of the sound. If set to 0, a pitch of 1 is used.
+	Pitch float64
+
+	sound
+}
+
+// Music is a music track defined in a resource pack, identified by its name in the sound_definitions.json file
+// of the pack. Music may be played to a player using player.Player.PlayMusic. Unlike other sounds, Music is not
+// positioned in the world and replaces the music of the game while it plays. Only one track of Music plays to a
+// player at a time.
+type Music struct {
+	// Name is the name of the sound of the track, such as 'mypack.music.boss'.
+	Name string
+	// Volume is the volume of the track. If set to 0, a volume of 1 is used.
+	Volume float64
+	// Loop specifies if the track should be repeated once it has finished playing.
+	Loop bool
+	// Length is the length of the track. The server has no access to the audio of the track, so it is used to
+	// find out when a track that does not loop has finished playing. If 0, the track is assumed to play until
+	// it is stopped.
+	Length time.Duration
+	// Fade is the duration over which the track fades in when it starts playing, while the music that played
+	// before it fades out. If 0, the track starts playing at full volume immediately.
+	Fade time.Duration
+}
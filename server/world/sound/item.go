@@ -44,9 +44,42 @@ type BucketEmpty struct {
 	sound
 }
 
+// PowderSnowBucketFill is a sound played when a bucket is filled with powder snow.
+type PowderSnowBucketFill struct{ sound }
+
+// PowderSnowBucketEmpty is a sound played when a bucket of powder snow is placed into the world.
+type PowderSnowBucketEmpty struct{ sound }
+
+// BottleFill is a sound played when a glass bottle is filled with water.
+type BottleFill struct{ sound }
+
+// BottleEmpty is a sound played when a bottle of water is emptied into a block, such as a cauldron.
+type BottleEmpty struct{ sound }
+
+// ShieldBlock is a sound played when a shield blocks an attack or a projectile.
+type ShieldBlock struct{ sound }
+
+// Totem is a sound played when a totem of undying is used to prevent the death of its holder.
+type Totem struct{ sound }
+
 // BowShoot is a sound played when a bow is shot.
 type BowShoot struct{ sound }
 
+// CrossbowLoad is a sound played while a crossbow is being loaded.
+type CrossbowLoad struct {
+	// Stage is the stage of loading the crossbow is in: 0 when loading starts,
+	// 1 halfway through and 2 once the crossbow is fully loaded.
+	Stage int
+	// QuickCharge specifies if the crossbow has the Quick Charge enchantment,
+	// which changes the sound played.
+	QuickCharge bool
+
+	sound
+}
+
+// CrossbowShoot is a sound played when a loaded crossbow is shot.
+type CrossbowShoot struct{ sound }
+
 // ArrowHit is a sound played when an arrow hits ground.
 type ArrowHit struct{ sound }
 
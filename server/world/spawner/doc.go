@@ -0,0 +1,15 @@
+// Package spawner implements a programmable Spawner that spawns waves of entities, independent of any block. It
+// may be used by plugins, such as arena minigames, to drive waves of mobs: A wave is spawned once the previous
+// wave was defeated, which is when all of its entities were removed from the world, for example because they
+// were killed.
+//
+//	s := spawner.Config{
+//		Waves: []spawner.Wave{
+//			{Entries: []spawner.Entry{{Type: zombieType, Count: 3}}},
+//			{Entries: []spawner.Entry{{Type: zombieType, Count: 5}}, Delay: time.Second * 10},
+//		},
+//		Positions: []mgl64.Vec3{{10, 64, 10}, {-10, 64, -10}},
+//	}.New(w)
+//	s.Handle(arenaHandler{})
+//	s.Start()
+package spawner
@@ -0,0 +1,39 @@
+package spawner
+
+import (
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Handler handles events that are called by a Spawner. Handler methods are called from the goroutine that
+// ticks the Spawner.
+type Handler interface {
+	// HandleWaveStart handles the Spawner starting to spawn the wave with the index passed. ctx.Cancel() may
+	// be called to skip the wave entirely, in which case the next wave is spawned after its delay.
+	HandleWaveStart(ctx *event.Context, s *Spawner, wave int)
+	// HandleSpawn handles an entity of a wave being spawned by the Spawner. ctx.Cancel() may be called to
+	// prevent the entity from being spawned.
+	HandleSpawn(ctx *event.Context, s *Spawner, e world.Entity)
+	// HandleDefeat handles an entity spawned by the Spawner being defeated, which is when it is no longer in
+	// the world of the Spawner, for example because it was killed or despawned.
+	HandleDefeat(s *Spawner, e world.Entity)
+	// HandleWaveDefeat handles all entities of the wave with the index passed being defeated.
+	HandleWaveDefeat(s *Spawner, wave int)
+	// HandleFinish handles the last wave of the Spawner being defeated. The Spawner stops after this handler
+	// is called.
+	HandleFinish(s *Spawner)
+}
+
+// Check to make sure NopHandler implements Handler.
+var _ Handler = NopHandler{}
+
+// NopHandler implements the Handler interface but does not execute any code when an event is called. The
+// default Handler of a Spawner is NopHandler. Users may embed NopHandler to avoid having to implement each
+// method.
+type NopHandler struct{}
+
+func (NopHandler) HandleWaveStart(*event.Context, *Spawner, int)      {}
+func (NopHandler) HandleSpawn(*event.Context, *Spawner, world.Entity) {}
+func (NopHandler) HandleDefeat(*Spawner, world.Entity)                {}
+func (NopHandler) HandleWaveDefeat(*Spawner, int)                     {}
+func (NopHandler) HandleFinish(*Spawner)                              {}
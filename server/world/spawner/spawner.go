@@ -0,0 +1,302 @@
+package spawner
+
+import (
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Config holds the configuration of a Spawner. A Spawner may be created by calling Config.New.
+type Config struct {
+	// Waves are the waves spawned by the Spawner, in order. A wave is spawned once the wave before it was
+	// defeated and its Delay has passed.
+	Waves []Wave
+	// Positions holds the positions that entities may be spawned at. Every entity spawned is spawned at a
+	// position picked randomly from Positions. Positions must not be empty.
+	Positions []mgl64.Vec3
+	// Condition is a function checked before every wave is spawned. The wave is held back until Condition
+	// returns true, for example to wait for enough players to be in an arena. If nil, waves are spawned as
+	// soon as their Delay has passed.
+	Condition func(s *Spawner) bool
+}
+
+// Wave is a single wave of entities spawned by a Spawner.
+type Wave struct {
+	// Entries holds the entities spawned in the wave.
+	Entries []Entry
+	// Delay is the time waited before the wave is spawned, starting when the previous wave was defeated or,
+	// for the first wave, when the Spawner was started.
+	Delay time.Duration
+}
+
+// Entry is an entry of a Wave, specifying an entity to spawn and how many of it.
+type Entry struct {
+	// Type is the type of entity spawned. The entity is created using world.NewEntity, so Type must implement
+	// world.SaveableEntityType. Type is not used if New is not nil.
+	Type world.EntityType
+	// New is a function that creates the entity spawned at the position passed. It may be used to spawn
+	// entities that cannot be created from only a position and rotation, or to change the entity spawned, for
+	// example to give it more health. If nil, Type is used to create the entity.
+	New func(pos mgl64.Vec3) world.Entity
+	// Count is the amount of entities spawned for the Entry. If 0 or lower, a single entity is spawned.
+	Count int
+}
+
+// newEntity creates a new entity for the Entry at the position passed. False is returned if the entity could
+// not be created.
+func (e Entry) newEntity(pos mgl64.Vec3) (world.Entity, bool) {
+	if e.New != nil {
+		ent := e.New(pos)
+		return ent, ent != nil
+	}
+	if e.Type == nil {
+		return nil, false
+	}
+	return world.NewEntity(e.Type, pos, cube.Rotation{rand.Float64() * 360})
+}
+
+// New creates a Spawner that spawns its entities in the world passed. The Spawner does not spawn any
+// entities until Spawner.Start is called.
+func (conf Config) New(w *world.World) *Spawner {
+	if len(conf.Positions) == 0 {
+		panic("spawner: Config.Positions must not be empty")
+	}
+	s := &Spawner{conf: conf, w: w}
+	s.handler.Store(Handler(NopHandler{}))
+	return s
+}
+
+// Spawner spawns waves of entities in a world. A Spawner is started using Start and runs in its own goroutine,
+// until Stop is called or until its last wave is defeated.
+type Spawner struct {
+	conf    Config
+	w       *world.World
+	handler atomic.Value[Handler]
+
+	mu sync.Mutex
+	// wave is the index of the wave currently alive or waiting to be spawned.
+	wave int
+	// spawned specifies if the current wave was spawned.
+	spawned bool
+	// next is the time at which the current wave may be spawned if it was not yet spawned.
+	next time.Time
+	// alive holds the entities of the current wave that were not yet defeated.
+	alive []world.Entity
+	// stop is closed to stop the goroutine ticking the Spawner. It is nil if the Spawner is not running.
+	stop chan struct{}
+}
+
+// Handle changes the Handler of the Spawner to h. Passing nil resets the Handler to NopHandler.
+func (s *Spawner) Handle(h Handler) {
+	if h == nil {
+		h = NopHandler{}
+	}
+	s.handler.Store(h)
+}
+
+// Handler returns the Handler of the Spawner.
+func (s *Spawner) Handler() Handler {
+	return s.handler.Load()
+}
+
+// World returns the world that the Spawner spawns its entities in.
+func (s *Spawner) World() *world.World {
+	return s.w
+}
+
+// Start starts the Spawner, or resumes it if it was stopped before. The Delay of the first wave starts when
+// Start is first called. Start does nothing if the Spawner is already running or if it is finished.
+func (s *Spawner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil || s.finished() {
+		return
+	}
+	if !s.spawned && s.next.IsZero() {
+		s.next = time.Now().Add(s.conf.Waves[0].Delay)
+	}
+	s.stop = make(chan struct{})
+	go s.run(s.stop)
+}
+
+// Stop stops the Spawner. The entities that are alive are not removed and no more waves are spawned until
+// Start is called again.
+func (s *Spawner) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+// Close stops the Spawner and removes all entities of the current wave that were not yet defeated from the
+// world.
+func (s *Spawner) Close() error {
+	s.Stop()
+	s.mu.Lock()
+	alive := s.alive
+	s.alive = nil
+	s.mu.Unlock()
+
+	for _, e := range alive {
+		if w, ok := world.OfEntity(e); ok && w == s.w {
+			s.w.RemoveEntity(e)
+			_ = e.Close()
+		}
+	}
+	return nil
+}
+
+// Running checks if the Spawner was started and was not stopped or finished since.
+func (s *Spawner) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stop != nil
+}
+
+// Wave returns the index of the wave currently alive, or of the wave that is spawned next if the current wave
+// was not yet spawned. Wave returns len(Config.Waves) if the Spawner is finished.
+func (s *Spawner) Wave() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.wave
+}
+
+// Finished checks if all waves of the Spawner were defeated.
+func (s *Spawner) Finished() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.finished()
+}
+
+// finished checks if all waves of the Spawner were defeated. s.mu must be held when calling finished.
+func (s *Spawner) finished() bool {
+	return s.wave >= len(s.conf.Waves)
+}
+
+// Entities returns the entities of the current wave that were not yet defeated.
+func (s *Spawner) Entities() []world.Entity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.alive)
+}
+
+// run ticks the Spawner every 20th of a second until the stop channel passed is closed.
+func (s *Spawner) run(stop chan struct{}) {
+	t := time.NewTicker(time.Second / 20)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			s.tick()
+		}
+	}
+}
+
+// tick spawns the current wave if it may be spawned, or checks which of its entities were defeated if it was
+// already spawned. Handlers are called without holding s.mu, so that they may call methods of the Spawner.
+func (s *Spawner) tick() {
+	s.mu.Lock()
+	if s.stop == nil || s.finished() {
+		s.mu.Unlock()
+		return
+	}
+	if !s.spawned {
+		wave := s.wave
+		ready := time.Now().After(s.next)
+		s.mu.Unlock()
+
+		if ready && (s.conf.Condition == nil || s.conf.Condition(s)) {
+			s.spawnWave(wave)
+		}
+		return
+	}
+	var defeated []world.Entity
+	s.alive = slices.DeleteFunc(s.alive, func(e world.Entity) bool {
+		if w, ok := world.OfEntity(e); !ok || w != s.w {
+			defeated = append(defeated, e)
+			return true
+		}
+		return false
+	})
+	wave, done := s.wave, len(s.alive) == 0
+	s.mu.Unlock()
+
+	h := s.Handler()
+	for _, e := range defeated {
+		h.HandleDefeat(s, e)
+	}
+	if done {
+		h.HandleWaveDefeat(s, wave)
+		s.nextWave(wave)
+	}
+}
+
+// spawnWave spawns all entities of the wave with the index passed.
+func (s *Spawner) spawnWave(wave int) {
+	h := s.Handler()
+	ctx := event.C()
+	if h.HandleWaveStart(ctx, s, wave); ctx.Cancelled() {
+		s.nextWave(wave)
+		return
+	}
+	var spawned []world.Entity
+	for _, entry := range s.conf.Waves[wave].Entries {
+		for i := 0; i < max(entry.Count, 1); i++ {
+			e, ok := entry.newEntity(s.conf.Positions[rand.Intn(len(s.conf.Positions))])
+			if !ok {
+				continue
+			}
+			ctx := event.C()
+			if h.HandleSpawn(ctx, s, e); ctx.Cancelled() {
+				_ = e.Close()
+				continue
+			}
+			s.w.AddEntity(e)
+			if w, ok := world.OfEntity(e); ok && w == s.w {
+				// The entity is only part of the wave if it was actually added to the world, which might not
+				// be the case if it exceeded the entity caps of the world.
+				spawned = append(spawned, e)
+			}
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.wave == wave {
+		s.spawned, s.alive = true, spawned
+	}
+}
+
+// nextWave moves the Spawner on to the wave after the one with the index passed. If it was the last wave, the
+// Spawner is stopped and HandleFinish is called.
+func (s *Spawner) nextWave(wave int) {
+	s.mu.Lock()
+	if s.wave != wave {
+		s.mu.Unlock()
+		return
+	}
+	s.wave, s.spawned, s.alive = wave+1, false, nil
+	finished := s.finished()
+	if finished {
+		if s.stop != nil {
+			close(s.stop)
+			s.stop = nil
+		}
+	} else {
+		s.next = time.Now().Add(s.conf.Waves[s.wave].Delay)
+	}
+	s.mu.Unlock()
+
+	if finished {
+		s.Handler().HandleFinish(s)
+	}
+}
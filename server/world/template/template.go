@@ -0,0 +1,195 @@
+// Package template implements world templates: Read-only worlds of which throwaway copies may be created in
+// memory. Copies share the data of the template world and only hold the chunks they changed themselves, so
+// that, for example, every round of a minigame may be played in a fresh arena without copying the world on
+// disk. Changes made to a copy are discarded once it is closed.
+//
+//	t := template.New(db)
+//	w := t.Instance(world.Config{Entities: entity.DefaultRegistry})
+//	// ... play the round ...
+//	_ = w.Close()
+package template
+
+import (
+	"errors"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"github.com/google/uuid"
+	"maps"
+	"sync"
+)
+
+// Template is a read-only base for any number of copies of a world. A Template never writes to the
+// world.Provider it was created with.
+type Template struct {
+	base world.Provider
+}
+
+// New creates a Template using the world.Provider passed as base. The world.Provider must be safe to read from
+// concurrently and must return a new world.Column every time LoadColumn is called, which is the case for
+// providers reading from disk, such as mcdb.DB. The world.Provider is closed when Template.Close is called.
+func New(base world.Provider) *Template {
+	return &Template{base: base}
+}
+
+// Provider returns a new Provider that holds an empty copy-on-write layer over the Template. The Provider may
+// be used as the world.Provider of a world.Config to create a copy of the template world.
+func (t *Template) Provider() *Provider {
+	base := t.base.Settings()
+	base.Lock()
+	set := &world.Settings{
+		Name:                 base.Name,
+		Seed:                 base.Seed,
+		Spawn:                base.Spawn,
+		SpawnRadius:          base.SpawnRadius,
+		Time:                 base.Time,
+		TimeCycle:            base.TimeCycle,
+		RainTime:             base.RainTime,
+		Raining:              base.Raining,
+		ThunderTime:          base.ThunderTime,
+		Thundering:           base.Thundering,
+		WeatherCycle:         base.WeatherCycle,
+		FireTick:             base.FireTick,
+		RespawnBlocksExplode: base.RespawnBlocksExplode,
+		CurrentTick:          base.CurrentTick,
+		DefaultGameMode:      base.DefaultGameMode,
+		Difficulty:           base.Difficulty,
+		TickRange:            base.TickRange,
+	}
+	base.Unlock()
+	return &Provider{t: t, set: set, columns: make(map[columnKey]storedColumn), spawns: make(map[uuid.UUID]*cube.Pos)}
+}
+
+// Instance creates a new world using the world.Config passed that is a copy of the template world. The
+// Provider field of the world.Config passed is overwritten. Closing the world returned discards all changes
+// made to it.
+func (t *Template) Instance(conf world.Config) *world.World {
+	conf.Provider = t.Provider()
+	return conf.New()
+}
+
+// Close closes the world.Provider that the Template was created with. Copies of the template world must be
+// closed before calling Close.
+func (t *Template) Close() error {
+	return t.base.Close()
+}
+
+// columnKey is the key under which a column changed by a copy of a template world is stored.
+type columnKey struct {
+	pos world.ChunkPos
+	dim world.Dimension
+}
+
+// storedColumn holds the data of a world.Column stored in a Provider. Entities are stored in their NBT
+// representation, because the world closes the entities of a column after storing it.
+type storedColumn struct {
+	chunk         *chunk.Chunk
+	blockEntities map[cube.Pos]world.Block
+	entities      []storedEntity
+}
+
+// storedEntity is the NBT representation of an entity with a world.SaveableEntityType.
+type storedEntity struct {
+	t    world.SaveableEntityType
+	data map[string]any
+}
+
+// Compile time check to make sure Provider implements world.Provider.
+var _ world.Provider = (*Provider)(nil)
+
+// Provider is a world.Provider that holds a copy-on-write layer over a Template. Chunks are read from the
+// Template until they are changed, after which they are held in memory by the Provider. Nothing is ever written
+// to disk. Provider is safe for concurrent usage.
+type Provider struct {
+	t *Template
+
+	mu      sync.Mutex
+	set     *world.Settings
+	columns map[columnKey]storedColumn
+	// spawns holds the spawn positions of players set in the copy. A nil position means the spawn position of
+	// the player was cleared.
+	spawns map[uuid.UUID]*cube.Pos
+}
+
+// Settings returns the world.Settings of the copy, which start out as a copy of those of the Template.
+func (p *Provider) Settings() *world.Settings {
+	return p.set
+}
+
+// SaveSettings does nothing: The world.Settings returned by Settings are already kept in memory.
+func (p *Provider) SaveSettings(*world.Settings) {}
+
+// LoadPlayerSpawnPosition returns the spawn position of a player stored in the Provider. If none was stored, the
+// spawn position stored in the Template is returned.
+func (p *Provider) LoadPlayerSpawnPosition(id uuid.UUID) (cube.Pos, bool, error) {
+	p.mu.Lock()
+	pos, ok := p.spawns[id]
+	p.mu.Unlock()
+	if ok {
+		if pos == nil {
+			return cube.Pos{}, false, nil
+		}
+		return *pos, true, nil
+	}
+	return p.t.base.LoadPlayerSpawnPosition(id)
+}
+
+// SavePlayerSpawnPosition stores the spawn position of a player in memory.
+func (p *Provider) SavePlayerSpawnPosition(id uuid.UUID, pos cube.Pos) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.spawns[id] = &pos
+	return nil
+}
+
+// ClearPlayerSpawnPosition removes the spawn position of a player in memory, including the one stored in the
+// Template.
+func (p *Provider) ClearPlayerSpawnPosition(id uuid.UUID) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.spawns[id] = nil
+	return nil
+}
+
+// LoadColumn loads a world.Column changed by the copy if it exists, or reads it from the Template otherwise.
+func (p *Provider) LoadColumn(pos world.ChunkPos, dim world.Dimension) (*world.Column, error) {
+	p.mu.Lock()
+	stored, ok := p.columns[columnKey{pos: pos, dim: dim}]
+	p.mu.Unlock()
+	if !ok {
+		return p.t.base.LoadColumn(pos, dim)
+	}
+	col := &world.Column{Chunk: stored.chunk, BlockEntities: maps.Clone(stored.blockEntities)}
+	for _, e := range stored.entities {
+		if ent := e.t.DecodeNBT(maps.Clone(e.data)); ent != nil {
+			col.Entities = append(col.Entities, ent)
+		}
+	}
+	return col, nil
+}
+
+// StoreColumn stores a world.Column in memory. It is not written to the Template.
+func (p *Provider) StoreColumn(pos world.ChunkPos, dim world.Dimension, col *world.Column) error {
+	if col == nil || col.Chunk == nil {
+		return errors.New("store column: column has no chunk")
+	}
+	stored := storedColumn{chunk: col.Chunk, blockEntities: maps.Clone(col.BlockEntities)}
+	for _, e := range col.Entities {
+		if t, ok := e.Type().(world.SaveableEntityType); ok {
+			stored.entities = append(stored.entities, storedEntity{t: t, data: t.EncodeNBT(e)})
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.columns[columnKey{pos: pos, dim: dim}] = stored
+	return nil
+}
+
+// Close discards all data held by the Provider. The Template is not closed.
+func (p *Provider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clear(p.columns)
+	clear(p.spawns)
+	return nil
+}
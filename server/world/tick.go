@@ -34,6 +34,20 @@ func (t ticker) tickLoop() {
 
 // tick performs a tick on the World and updates the time, weather, blocks and entities that require updates.
 func (t ticker) tick() {
+	var tm tickTimings
+	start := time.Now()
+	t.w.tickStart.Store(start.UnixNano())
+	defer t.w.tickStart.Store(0)
+
+	// lap stores the time passed since the previous call to lap in the duration passed.
+	last := start
+	lap := func(d *time.Duration) {
+		now := time.Now()
+		*d, last = now.Sub(last), now
+	}
+
+	t.applyAsync()
+	lap(&tm.async)
 	viewers, loaders := t.w.allViewers()
 
 	t.w.set.Lock()
@@ -67,11 +81,21 @@ func (t ticker) tick() {
 	if thunder {
 		t.w.tickLightning()
 	}
+	t.w.tickSnow()
 
-	t.tickEntities(tick)
+	t.tickEntities(loaders, tick)
+	lap(&tm.entities)
+	t.tickSleeping(tim)
+	lap(&tm.sleeping)
 	t.tickBlocksRandomly(loaders, tick)
+	lap(&tm.randomTicks)
 	t.tickScheduledBlocks(tick)
+	lap(&tm.scheduledUpdates)
 	t.performNeighbourUpdates()
+	lap(&tm.neighbourUpdates)
+
+	tm.total = time.Since(start)
+	t.updateWatchdog(tm, tick)
 }
 
 // tickScheduledBlocks executes scheduled block updates in chunks that are currently loaded.
@@ -123,6 +147,7 @@ func (t ticker) performNeighbourUpdates() {
 func (t ticker) tickBlocksRandomly(loaders []*Loader, tick int64) {
 	var (
 		r             = int32(t.w.tickRange())
+		speed         = t.w.RandomTickSpeed()
 		g             randUint4
 		blockEntities []cube.Pos
 		randomBlocks  []cube.Pos
@@ -153,7 +178,7 @@ func (t ticker) tickBlocksRandomly(loaders []*Loader, tick int64) {
 		cx, cz := int(pos[0]<<4), int(pos[1]<<4)
 
 		// We generate up to j random positions for every sub chunk.
-		for j := 0; j < t.w.conf.RandomTickSpeed; j++ {
+		for j := 0; j < speed; j++ {
 			x, y, z := g.uint4(t.w.r), g.uint4(t.w.r), g.uint4(t.w.r)
 
 			for i, sub := range c.Sub() {
@@ -204,8 +229,9 @@ func (t ticker) anyWithinDistance(pos ChunkPos, loaded []ChunkPos, r int32) bool
 }
 
 // tickEntities ticks all entities in the world, making sure they are still located in the correct chunks and
-// updating where necessary.
-func (t ticker) tickEntities(tick int64) {
+// updating where necessary. If the World is overloaded, entities far away from the loaders passed are ticked less
+// often.
+func (t ticker) tickEntities(loaders []*Loader, tick int64) {
 	type entityToMove struct {
 		e             Entity
 		after         *Column
@@ -214,7 +240,18 @@ func (t ticker) tickEntities(tick int64) {
 	var (
 		entitiesToMove []entityToMove
 		entitiesToTick []TickerEntity
+		loaderPos      []ChunkPos
+		budget         = t.w.budget.Load()
+		overloaded     = t.w.overloaded.Load()
 	)
+	if overloaded {
+		loaderPos = make([]ChunkPos, 0, len(loaders))
+		for _, l := range loaders {
+			l.mu.RLock()
+			loaderPos = append(loaderPos, l.pos)
+			l.mu.RUnlock()
+		}
+	}
 
 	t.w.chunkMu.Lock()
 	t.w.entityMu.Lock()
@@ -230,7 +267,7 @@ func (t ticker) tickEntities(tick int64) {
 		v := len(c.viewers)
 		c.Unlock()
 
-		if v > 0 {
+		if v > 0 && (!overloaded || budget.shouldTick(chunkPos, loaderPos, tick)) {
 			if ticker, ok := e.(TickerEntity); ok {
 				entitiesToTick = append(entitiesToTick, ticker)
 			}
@@ -239,7 +276,7 @@ func (t ticker) tickEntities(tick int64) {
 		if lastPos != chunkPos {
 			// The entity was stored using an outdated chunk position. We update it and make sure it is ready
 			// for loaders to view it.
-			t.w.entities[e] = chunkPos
+			t.w.trackEntity(e, chunkPos)
 			var viewers []Viewer
 
 			// When changing an entity's world, then teleporting it immediately, we could end up in a situation
@@ -278,6 +315,7 @@ func (t ticker) tickEntities(tick int64) {
 			}
 		}
 	}
+	start := time.Now()
 	for _, ticker := range entitiesToTick {
 		// Make sure the entity is still in world and has not been closed.
 		if ticker.World() == t.w {
@@ -286,6 +324,7 @@ func (t ticker) tickEntities(tick int64) {
 			ticker.Tick(t.w, tick)
 		}
 	}
+	t.updateOverloaded(budget, time.Since(start), tick)
 }
 
 // randUint4 is a structure used to generate random uint4s.
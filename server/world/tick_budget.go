@@ -0,0 +1,116 @@
+package world
+
+import (
+	"time"
+)
+
+// EntityTickBudget holds settings that limit the time a World spends ticking entities every tick. When the
+// budget is exceeded, the World becomes overloaded and degrades gracefully rather than letting the tick rate
+// collapse: entities far away from any viewer are ticked less often and item entities stop merging. The World
+// is no longer overloaded once it has stayed within budget for a while. The zero value of EntityTickBudget
+// disables the budget.
+type EntityTickBudget struct {
+	// Budget is the maximum amount of time that may be spent ticking entities in a single tick. If set to 0 or
+	// lower, no budget is enforced and the World is never overloaded.
+	Budget time.Duration
+	// NearRadius is the radius in chunks around a viewer within which entities are always ticked, even if the
+	// World is overloaded. If set to 0, a radius of 2 chunks is used.
+	NearRadius int
+	// DistantInterval is the amount of ticks between two ticks of entities outside the NearRadius of every
+	// viewer while the World is overloaded. If set to 0, these entities are ticked every 4 ticks.
+	DistantInterval int
+	// Recovery is the amount of ticks that the World must stay within the Budget before it is no longer
+	// overloaded. If set to 0, the World recovers after 100 ticks (5 seconds).
+	Recovery int
+}
+
+// nearRadius returns the NearRadius of the EntityTickBudget, taking the default into account.
+func (b EntityTickBudget) nearRadius() int32 {
+	if b.NearRadius <= 0 {
+		return 2
+	}
+	return int32(b.NearRadius)
+}
+
+// distantInterval returns the DistantInterval of the EntityTickBudget, taking the default into account.
+func (b EntityTickBudget) distantInterval() int64 {
+	if b.DistantInterval <= 0 {
+		return 4
+	}
+	return int64(b.DistantInterval)
+}
+
+// recovery returns the Recovery of the EntityTickBudget, taking the default into account.
+func (b EntityTickBudget) recovery() int64 {
+	if b.Recovery <= 0 {
+		return 100
+	}
+	return int64(b.Recovery)
+}
+
+// shouldTick checks if an entity in the chunk passed should be ticked during the current tick while the World
+// is overloaded. Entities near one of the loader positions passed are always ticked, while distant entities
+// are only ticked once every DistantInterval ticks. The ticks of distant entities are spread out over chunks so
+// that they do not all happen during the same tick.
+func (b EntityTickBudget) shouldTick(pos ChunkPos, loaders []ChunkPos, tick int64) bool {
+	r := b.nearRadius()
+	for _, l := range loaders {
+		if abs32(pos[0]-l[0]) <= r && abs32(pos[1]-l[1]) <= r {
+			return true
+		}
+	}
+	interval := b.distantInterval()
+	return ((tick+int64(pos[0])+int64(pos[1]))%interval+interval)%interval == 0
+}
+
+// abs32 returns the absolute value of an int32.
+func abs32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// EntityTickBudget returns the EntityTickBudget that limits the time the World spends ticking entities.
+func (w *World) EntityTickBudget() EntityTickBudget {
+	if w == nil {
+		return EntityTickBudget{}
+	}
+	return w.budget.Load()
+}
+
+// SetEntityTickBudget changes the EntityTickBudget that limits the time the World spends ticking entities. It
+// takes effect from the next tick.
+func (w *World) SetEntityTickBudget(b EntityTickBudget) {
+	if w == nil {
+		return
+	}
+	w.budget.Store(b)
+}
+
+// Overloaded checks if the World exceeded its EntityTickBudget recently. While overloaded, distant entities
+// are ticked less often and expensive entity behaviour, such as the merging of item entities, should be
+// skipped.
+func (w *World) Overloaded() bool {
+	if w == nil {
+		return false
+	}
+	return w.overloaded.Load()
+}
+
+// updateOverloaded updates whether the World is overloaded, using the time d that was spent ticking entities
+// during the current tick.
+func (t ticker) updateOverloaded(b EntityTickBudget, d time.Duration, tick int64) {
+	if b.Budget <= 0 {
+		t.w.overloaded.Store(false)
+		return
+	}
+	if d > b.Budget {
+		t.w.overloadedUntil = tick + b.recovery()
+		if !t.w.overloaded.Swap(true) {
+			t.w.conf.Log.Debugf("world %v exceeded its entity tick budget (%v > %v), degrading entity ticking", t.w.Name(), d, b.Budget)
+		}
+	} else if tick >= t.w.overloadedUntil && t.w.overloaded.Swap(false) {
+		t.w.conf.Log.Debugf("world %v recovered from exceeding its entity tick budget", t.w.Name())
+	}
+}
@@ -0,0 +1,197 @@
+package world
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+)
+
+// Watchdog holds settings that make a World monitor the duration of its ticks. Ticks that take longer than
+// the Threshold are logged together with the time spent on each part of the tick and a dump of all
+// goroutines. If the World keeps exceeding the Threshold, it may enter an emergency mode in which it takes
+// measures to protect itself, such as pausing the generation of new chunks and capping the amount of entities
+// that may be spawned. The zero value of Watchdog disables it.
+type Watchdog struct {
+	// Threshold is the maximum duration of a single tick. If a tick takes longer, it is considered slow. If set
+	// to 0 or lower, the Watchdog is disabled.
+	Threshold time.Duration
+	// Stall is the duration after which a tick that is still running is considered stalled. When a tick
+	// stalls, a dump is created while the tick is still running, so that deadlocks and ticks that never
+	// finish may be investigated. If set to 0, stalled ticks are not detected.
+	Stall time.Duration
+	// DumpDir is the directory that dumps of slow and stalled ticks are written to. If left empty, dumps are
+	// written to the Logger of the World instead.
+	DumpDir string
+	// DumpInterval is the minimum duration between two dumps, so that an overloaded World does not produce a
+	// dump every tick. If set to 0, at most one dump is created every minute.
+	DumpInterval time.Duration
+	// EmergencyTicks is the amount of consecutive slow ticks after which the World enters emergency mode. If
+	// set to 0, the World never enters emergency mode.
+	EmergencyTicks int
+	// Recovery is the amount of ticks that the World must stay within the Threshold before it leaves
+	// emergency mode. If set to 0, the World recovers after 100 ticks (5 seconds).
+	Recovery int
+	// PauseGeneration specifies if chunks that are not yet loaded are no longer loaded or generated for
+	// viewers while the World is in emergency mode. Chunks that are already loaded are still sent.
+	PauseGeneration bool
+	// MaxEntities is the maximum amount of entities that may be in the World while it is in emergency mode.
+	// Entities added using World.AddEntity beyond this amount are discarded, with the exception of players and
+	// entities added using World.AddEntityUncapped.
+	// If set to 0, the amount of entities is not capped.
+	MaxEntities int
+}
+
+// dumpInterval returns the DumpInterval of the Watchdog, taking the default into account.
+func (wd Watchdog) dumpInterval() time.Duration {
+	if wd.DumpInterval <= 0 {
+		return time.Minute
+	}
+	return wd.DumpInterval
+}
+
+// recovery returns the Recovery of the Watchdog, taking the default into account.
+func (wd Watchdog) recovery() int64 {
+	if wd.Recovery <= 0 {
+		return 100
+	}
+	return int64(wd.Recovery)
+}
+
+// tickTimings holds the time spent on each part of a single tick of a World.
+type tickTimings struct {
+	async, entities, sleeping, randomTicks, scheduledUpdates, neighbourUpdates, total time.Duration
+}
+
+// String ...
+func (tm tickTimings) String() string {
+	return fmt.Sprintf("async %v, entities %v, sleeping %v, random ticks %v, scheduled updates %v, neighbour updates %v", tm.async, tm.entities, tm.sleeping, tm.randomTicks, tm.scheduledUpdates, tm.neighbourUpdates)
+}
+
+// Watchdog returns the Watchdog that monitors the duration of the ticks of the World.
+func (w *World) Watchdog() Watchdog {
+	if w == nil {
+		return Watchdog{}
+	}
+	return w.watchdog.Load()
+}
+
+// SetWatchdog changes the Watchdog that monitors the duration of the ticks of the World. It takes effect from
+// the next tick.
+func (w *World) SetWatchdog(wd Watchdog) {
+	if w == nil {
+		return
+	}
+	w.watchdog.Store(wd)
+}
+
+// Emergency checks if the World is in emergency mode, which it enters after exceeding the Threshold of its
+// Watchdog for Watchdog.EmergencyTicks consecutive ticks.
+func (w *World) Emergency() bool {
+	if w == nil {
+		return false
+	}
+	return w.emergency.Load()
+}
+
+// generationPaused checks if the World is in emergency mode and should not load or generate new chunks.
+func (w *World) generationPaused() bool {
+	return w.emergency.Load() && w.watchdog.Load().PauseGeneration
+}
+
+// entityCapped checks if the World is in emergency mode and holds too many entities for the Entity passed to
+// be added to it.
+func (w *World) entityCapped(e Entity) bool {
+	if !w.emergency.Load() {
+		return false
+	}
+	max := w.watchdog.Load().MaxEntities
+	if max <= 0 || e.Type().EncodeEntity() == "minecraft:player" {
+		return false
+	}
+	w.entityMu.RLock()
+	defer w.entityMu.RUnlock()
+	return len(w.entities) >= max
+}
+
+// updateWatchdog checks the timings of the current tick against the Watchdog of the World, dumping the state
+// of the World if the tick was slow and entering or leaving emergency mode where needed.
+func (t ticker) updateWatchdog(tm tickTimings, tick int64) {
+	wd := t.w.watchdog.Load()
+	if wd.Threshold <= 0 {
+		t.w.slowTicks = 0
+		t.w.emergency.Store(false)
+		return
+	}
+	if tm.total <= wd.Threshold {
+		t.w.slowTicks = 0
+		if tick >= t.w.emergencyUntil && t.w.emergency.Swap(false) {
+			t.w.conf.Log.Debugf("world %v recovered from slow ticks, leaving emergency mode", t.w.Name())
+		}
+		return
+	}
+	t.w.slowTicks++
+	t.w.dump(wd, fmt.Sprintf("tick %v took %v (threshold %v): %v", tick, tm.total, wd.Threshold, tm))
+
+	if wd.EmergencyTicks > 0 && t.w.slowTicks >= wd.EmergencyTicks {
+		t.w.emergencyUntil = tick + wd.recovery()
+		if !t.w.emergency.Swap(true) {
+			t.w.conf.Log.Errorf("world %v exceeded its tick threshold for %v consecutive ticks, entering emergency mode", t.w.Name(), t.w.slowTicks)
+		}
+	}
+}
+
+// watchdogLoop checks if the current tick of the World has been running for longer than the Stall duration of
+// its Watchdog, until the World is closed.
+func (w *World) watchdogLoop() {
+	tc := time.NewTicker(time.Second / 4)
+	defer tc.Stop()
+
+	var stalled int64
+	for {
+		select {
+		case <-tc.C:
+			wd := w.watchdog.Load()
+			start := w.tickStart.Load()
+			if wd.Threshold <= 0 || wd.Stall <= 0 || start == 0 || start == stalled {
+				continue
+			}
+			if d := time.Since(time.Unix(0, start)); d > wd.Stall {
+				stalled = start
+				w.dump(wd, fmt.Sprintf("tick has been running for %v (stall %v)", d, wd.Stall))
+			}
+		case <-w.closing:
+			return
+		}
+	}
+}
+
+// dump writes the reason passed with a dump of all goroutines to the DumpDir of the Watchdog passed, or to the
+// Logger of the World if it has none. At most one dump is created every Watchdog.DumpInterval. Slow ticks in
+// between are only logged as debug messages.
+func (w *World) dump(wd Watchdog, reason string) {
+	now, last := time.Now(), w.lastDump.Load()
+	if (last != 0 && now.Sub(time.Unix(0, last)) < wd.dumpInterval()) || !w.lastDump.CAS(last, now.UnixNano()) {
+		w.conf.Log.Debugf("world %v: %v", w.Name(), reason)
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	_ = pprof.Lookup("goroutine").WriteTo(buf, 2)
+
+	if wd.DumpDir == "" {
+		w.conf.Log.Errorf("world %v: %v\n%s", w.Name(), reason, buf.Bytes())
+		return
+	}
+	file := filepath.Join(wd.DumpDir, "watchdog-"+now.Format("20060102-150405.000000000")+".txt")
+	if err := os.MkdirAll(wd.DumpDir, 0755); err != nil {
+		w.conf.Log.Errorf("world %v: %v (create dump directory: %v)", w.Name(), reason, err)
+		return
+	}
+	if err := os.WriteFile(file, append([]byte(reason+"\n\n"), buf.Bytes()...), 0644); err != nil {
+		w.conf.Log.Errorf("world %v: %v (write dump: %v)", w.Name(), reason, err)
+		return
+	}
+	w.conf.Log.Errorf("world %v: %v (goroutine dump written to %v)", w.Name(), reason, file)
+}
@@ -172,6 +172,64 @@ func (w weather) tickLightning() {
 	}
 }
 
+// tickSnow iterates over all loaded chunks in the World, picking a random column in each of them with a 1/16
+// chance. Water at the top of the column freezes if the biome is cold enough, and snow is laid on top of the
+// column while it is snowing.
+func (w weather) tickSnow() {
+	if !w.w.Dimension().WeatherCycle() {
+		return
+	}
+	w.w.chunkMu.Lock()
+	positions := make([]cube.Pos, 0, len(w.w.chunks)/16)
+	for pos := range w.w.chunks {
+		if w.w.r.Intn(16) == 0 {
+			v := w.w.r.Int31()
+			positions = append(positions, cube.Pos{int(pos[0]<<4 + v&0xf), 0, int(pos[1]<<4 + (v>>8)&0xf)})
+		}
+	}
+	w.w.chunkMu.Unlock()
+
+	for _, pos := range positions {
+		pos[1] = w.w.HighestBlock(pos[0], pos[2])
+		w.freezeWater(pos)
+		w.laySnow(pos.Side(cube.FaceUp))
+	}
+}
+
+// freezeWater turns the water source at the position passed into ice if the temperature at the position is low
+// enough and if it is not lit too brightly by blocks.
+func (w weather) freezeWater(pos cube.Pos) {
+	if w.w.Temperature(pos) > 0.15 || w.w.BlockLight(pos.Side(cube.FaceUp)) >= 10 {
+		return
+	}
+	if l, ok := w.w.Block(pos).(Liquid); !ok || l.LiquidType() != "water" || l.LiquidDepth() != 8 || l.LiquidFalling() {
+		return
+	}
+	if ice, ok := BlockByName("minecraft:ice", nil); ok {
+		w.w.SetBlock(pos, ice, nil)
+	}
+}
+
+// laySnow places a layer of snow at the position passed if it is snowing there, if the position is not lit too
+// brightly by blocks and if the block below has a solid top face. Snow is never laid on ice.
+func (w weather) laySnow(pos cube.Pos) {
+	if !w.SnowingAt(pos) || w.w.BlockLight(pos) >= 10 || w.w.Block(pos) != air() {
+		return
+	}
+	below := pos.Side(cube.FaceDown)
+	b := w.w.Block(below)
+	if !b.Model().FaceSolid(below, cube.FaceUp, w.w) {
+		return
+	}
+	switch name, _ := b.EncodeBlock(); name {
+	case "minecraft:ice", "minecraft:packed_ice", "minecraft:blue_ice":
+		return
+	}
+	if snow, ok := BlockByName("minecraft:snow_layer", map[string]any{"height": int32(0), "covered_bit": false}); ok {
+		w.w.SetBlock(pos, snow, nil)
+	}
+}
+
 // strikeLightning attempts to strike lightning in the world at a specific ChunkPos. The final position is influenced by
 // living entities that might be near the lightning strike. If there is no rain at the final position selected, the
 // lightning strike will fail.
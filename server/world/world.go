@@ -35,6 +35,27 @@ type World struct {
 	set     *Settings
 	handler atomic.Value[Handler]
 
+	immutable atomic.Bool
+	combat    atomic.Value[CombatConfig]
+	despawn   atomic.Value[DespawnConfig]
+	generator atomic.Value[Generator]
+	griefing  atomic.Value[GriefingConfig]
+
+	caps atomic.Value[EntityCaps]
+	// rejected holds the amount of entities of each EntityCategory rejected because of the EntityCaps.
+	rejected [EntityCategoryProjectile + 1]atomic.Int64
+
+	budget     atomic.Value[EntityTickBudget]
+	overloaded atomic.Bool
+
+	watchdog  atomic.Value[Watchdog]
+	emergency atomic.Bool
+	// tickStart is the time in Unix nanoseconds at which the current tick started, or 0 if the World is not
+	// currently ticking. lastDump is the time at which the last Watchdog dump was created.
+	tickStart, lastDump atomic.Int64
+
+	randomTickSpeed atomic.Int32
+
 	weather
 	ticker
 
@@ -53,6 +74,10 @@ type World struct {
 	// entities holds a map of entities currently loaded and the last ChunkPos that the Entity was in.
 	// These are tracked so that a call to RemoveEntity can find the correct entity.
 	entities map[Entity]ChunkPos
+	// counts and chunkCounts hold the amount of entities of each EntityCategory in the World and in each chunk,
+	// based on the chunk positions in entities. They are used to enforce the EntityCaps.
+	counts      entityCounts
+	chunkCounts map[ChunkPos]*entityCounts
 
 	r *rand.Rand
 
@@ -63,6 +88,22 @@ type World struct {
 	scheduledUpdates map[cube.Pos]int64
 	neighbourUpdates []neighbourUpdate
 
+	// sleepTicks is the amount of ticks that all Sleepers in the World have been sleeping for. It is only accessed
+	// by the ticker of the World.
+	sleepTicks int
+	// overloadedUntil is the tick until which the World remains overloaded, unless it exceeds its EntityTickBudget
+	// again. It is only accessed by the ticker of the World.
+	overloadedUntil int64
+	// slowTicks is the amount of consecutive ticks that exceeded the Threshold of the Watchdog and
+	// emergencyUntil is the tick until which the World remains in emergency mode. They are only accessed by the
+	// ticker of the World.
+	slowTicks      int
+	emergencyUntil int64
+
+	asyncMu sync.Mutex
+	// asyncResults holds functions returned by functions passed to Async that are yet to be applied to the World.
+	asyncResults []func(w *World)
+
 	viewersMu sync.Mutex
 	viewers   map[*Loader]Viewer
 }
@@ -93,7 +134,8 @@ func (w *World) Dimension() Dimension {
 	return w.conf.Dim
 }
 
-// Range returns the range in blocks of the World (min and max). It is equivalent to calling World.Dimension().Range().
+// Range returns the range in blocks of the World (min and max). Unless a narrower range was set in Config.Range,
+// it is equivalent to calling World.Dimension().Range().
 func (w *World) Range() cube.Range {
 	if w == nil {
 		return cube.Range{}
@@ -101,6 +143,36 @@ func (w *World) Range() cube.Range {
 	return w.ra
 }
 
+// HorizontalBound returns the maximum absolute X and Z block coordinate of the World, as set in
+// Config.HorizontalBound.
+func (w *World) HorizontalBound() int {
+	if w == nil {
+		return 0
+	}
+	return w.conf.HorizontalBound
+}
+
+// OutOfBounds checks if the cube.Pos passed is outside the World, either below or above its Range or beyond its
+// HorizontalBound.
+func (w *World) OutOfBounds(pos cube.Pos) bool {
+	if w == nil {
+		return true
+	}
+	b := w.conf.HorizontalBound
+	return pos.OutOfBounds(w.ra) || pos[0] < -b || pos[0] > b || pos[2] < -b || pos[2] > b
+}
+
+// ClampPosition returns the position passed with its X and Z coordinates clamped so that they do not exceed the
+// HorizontalBound of the World.
+func (w *World) ClampPosition(pos mgl64.Vec3) mgl64.Vec3 {
+	if w == nil {
+		return pos
+	}
+	b := float64(w.conf.HorizontalBound)
+	pos[0], pos[2] = mgl64.Clamp(pos[0], -b, b), mgl64.Clamp(pos[2], -b, b)
+	return pos
+}
+
 // EntityRegistry returns the EntityRegistry that was passed to the World's
 // Config upon construction.
 func (w *World) EntityRegistry() EntityRegistry {
@@ -111,7 +183,7 @@ func (w *World) EntityRegistry() EntityRegistry {
 // loaded, or generated if it could not be found in the world save, and the block returned. Chunks will be
 // loaded synchronously.
 func (w *World) Block(pos cube.Pos) Block {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		// Fast way out.
 		return air()
 	}
@@ -133,7 +205,7 @@ func (w *World) Block(pos cube.Pos) Block {
 // loaded, or generated if it could not be found in the world save, and the biome returned. Chunks will be
 // loaded synchronously.
 func (w *World) Biome(pos cube.Pos) Biome {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		// Fast way out.
 		return ocean()
 	}
@@ -151,7 +223,7 @@ func (w *World) Biome(pos cube.Pos) Biome {
 // blockInChunk reads a block from the world at the position passed. The block is assumed to be in the chunk
 // passed, which is also assumed to be locked already or otherwise not yet accessible.
 func (w *World) blockInChunk(c *Column, pos cube.Pos) Block {
-	if pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		// Fast way out.
 		return air()
 	}
@@ -214,6 +286,9 @@ type SetOpts struct {
 	// layer, if it already was on the second layer). Disabling this is not strongly recommended unless performance is
 	// very important or where it is known no liquid can be present anyway.
 	DisableLiquidDisplacement bool
+	// DisableScheduledUpdates cancels any block update scheduled at the position using ScheduleBlockUpdate, so
+	// that the block set is not ticked as a result of an update scheduled for the block previously there.
+	DisableScheduledUpdates bool
 }
 
 // SetBlock writes a block to the position passed. If a chunk is not yet loaded at that position, the chunk is
@@ -227,8 +302,9 @@ type SetOpts struct {
 //
 // SetBlock should be avoided in situations where performance is critical when needing to set a lot of blocks
 // to the world. BuildStructure may be used instead.
+// SetBlock does nothing if the World is immutable.
 func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) || w.Immutable() {
 		// Fast way out.
 		return
 	}
@@ -272,6 +348,14 @@ func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
 				c.SetBlock(x, y, z, 1, before)
 				secondLayer = l
 			}
+		} else if li := c.Block(x, y, z, 1); liquidBlocks[li] {
+			// The liquid on the second layer was displaced by the block previously at this position. If the new
+			// block is not able to displace it, the liquid is removed.
+			l, _ := BlockByRuntimeID(li)
+			if !liquidDisplacingBlocks[rid] || !b.(LiquidDisplacer).CanDisplace(l.(Liquid)) {
+				c.SetBlock(x, y, z, 1, airRID)
+				secondLayer = air()
+			}
 		}
 		c.Unlock()
 
@@ -288,15 +372,29 @@ func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
 		viewer.ViewBlockUpdate(pos, b, 0)
 	}
 
+	if opts.DisableScheduledUpdates {
+		w.updateMu.Lock()
+		delete(w.scheduledUpdates, pos)
+		w.updateMu.Unlock()
+	}
 	if !opts.DisableBlockUpdates {
 		w.doBlockUpdatesAround(pos)
 	}
 }
 
+// SetBlockWithoutPhysics writes a block to the position passed like SetBlock, but without any physics: Blocks
+// around it are not updated, liquids are not displaced and block updates scheduled at the position are
+// cancelled. The block set thus stays exactly as it is, even if it would normally break or fall, such as sand
+// floating in the air, until it or a block next to it is updated. SetBlockWithoutPhysics is mostly useful for
+// world editing and decorations. UpdateNeighbours may be called afterwards to apply physics after all.
+func (w *World) SetBlockWithoutPhysics(pos cube.Pos, b Block) {
+	w.SetBlock(pos, b, &SetOpts{DisableBlockUpdates: true, DisableLiquidDisplacement: true, DisableScheduledUpdates: true})
+}
+
 // SetBiome sets the biome at the position passed. If a chunk is not yet loaded at that position, the chunk is
 // first loaded or generated if it could not be found in the world save.
 func (w *World) SetBiome(pos cube.Pos, b Biome) {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		// Fast way out.
 		return
 	}
@@ -313,8 +411,9 @@ func (w *World) SetBiome(pos cube.Pos, b Biome) {
 // will do so within much less time than separate SetBlock calls would.
 // The method operates on a per-chunk basis, setting all blocks within a single chunk part of the structure
 // before moving on to the next chunk.
+// BuildStructure does nothing if the World is immutable.
 func (w *World) BuildStructure(pos cube.Pos, s Structure) {
-	if w == nil {
+	if w == nil || w.Immutable() {
 		return
 	}
 	dim := s.Dimensions()
@@ -403,7 +502,7 @@ func (w *World) BuildStructure(pos cube.Pos, s Structure) {
 // in any other layer.
 // If found, the liquid is returned. If not, the bool returned is false and the liquid is nil.
 func (w *World) Liquid(pos cube.Pos) (Liquid, bool) {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		// Fast way out.
 		return nil, false
 	}
@@ -435,8 +534,9 @@ func (w *World) Liquid(pos cube.Pos) (Liquid, bool) {
 // overwrite any existing blocks. It will instead be in the same position as a block currently there, unless
 // there already is a liquid at that position, in which case it will be overwritten.
 // If nil is passed for the liquid, any liquid currently present will be removed.
+// SetLiquid does nothing if the World is immutable.
 func (w *World) SetLiquid(pos cube.Pos, b Liquid) {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) || w.Immutable() {
 		// Fast way out.
 		return
 	}
@@ -516,7 +616,7 @@ func (w *World) removeLiquidOnLayer(c *chunk.Chunk, x uint8, y int16, z, layer u
 // additionalLiquid checks if the block at a position has additional liquid on another layer and returns the
 // liquid if so.
 func (w *World) additionalLiquid(pos cube.Pos) (Liquid, bool) {
-	if pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		// Fast way out.
 		return nil, false
 	}
@@ -566,6 +666,18 @@ func (w *World) SkyLight(pos cube.Pos) uint8 {
 	return c.SkyLight(uint8(pos[0]), int16(pos[1]), uint8(pos[2]))
 }
 
+// BlockLight returns the light level at the position passed that is emitted by blocks, such as torches or
+// glowstone. Unlike Light, this light level is not influenced by the skylight at the position.
+func (w *World) BlockLight(pos cube.Pos) uint8 {
+	if w == nil || pos[1] < w.Range()[0] || pos[1] > w.Range()[1] {
+		// Fast way out.
+		return 0
+	}
+	c := w.chunk(chunkPosFromBlockPos(pos))
+	defer c.Unlock()
+	return c.BlockLight(uint8(pos[0]), int16(pos[1]), uint8(pos[2]))
+}
+
 // Time returns the current time of the world. The time is incremented every 1/20th of a second, unless
 // World.StopTime() is called.
 func (w *World) Time() int {
@@ -577,6 +689,17 @@ func (w *World) Time() int {
 	return int(w.set.Time)
 }
 
+// CurrentTick returns the current tick of the world. Unlike the time, the current tick is incremented every
+// 1/20th of a second regardless of whether the time is stopped, and is used to schedule block updates.
+func (w *World) CurrentTick() int64 {
+	if w == nil {
+		return 0
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.CurrentTick
+}
+
 // SetTime sets the new time of the world. SetTime will always work, regardless of whether the time is stopped
 // or not.
 func (w *World) SetTime(new int) {
@@ -666,21 +789,52 @@ var (
 // all viewers of the world that have the chunk of the entity loaded.
 // If the chunk that the entity is in is not yet loaded, it will first be loaded.
 // If the entity passed to AddEntity is currently in a world, it is first removed from that world.
-func (w *World) AddEntity(e Entity) {
+// AddEntity returns false if the entity was discarded because it would exceed the EntityCaps of the World or
+// because the World holds too many entities while its Watchdog is in emergency mode. Callers that consume items
+// or other resources to create the entity should only do so if true is returned.
+func (w *World) AddEntity(e Entity) bool {
+	if w == nil || w.entityCapped(e) {
+		return false
+	}
+	return w.addEntity(e, chunkPosFromVec3(e.Position()), true)
+}
+
+// AddEntityUncapped adds an entity to the World like AddEntity, but never discards it because of the EntityCaps
+// of the World or the entity limit of its Watchdog. It should be used for entities that replace entities already
+// in the World, such as item entities that are split or merged, so that their contents are never lost.
+func (w *World) AddEntityUncapped(e Entity) {
 	if w == nil {
 		return
 	}
+	w.addEntity(e, chunkPosFromVec3(e.Position()), false)
+}
 
-	// Remove the Entity from any previous World it might be in.
-	e.World().RemoveEntity(e)
-
-	add(e, w)
+// addEntity adds an entity to the chunk at the position passed. If capped is true, the entity is discarded and
+// false is returned if it would exceed the EntityCaps of the World.
+func (w *World) addEntity(e Entity, chunkPos ChunkPos, capped bool) bool {
+	prev := e.World()
+	if prev == w {
+		// The Entity was already counted in this World, so re-adding it can never exceed the caps.
+		w.RemoveEntity(e)
+		capped = false
+	}
 
-	chunkPos := chunkPosFromVec3(e.Position())
+	// The caps are checked and the Entity is counted in the same critical section, so that concurrent calls
+	// cannot exceed the caps together.
 	w.entityMu.Lock()
-	w.entities[e] = chunkPos
+	if capped && w.exceedsCaps(e, chunkPos) {
+		w.entityMu.Unlock()
+		return false
+	}
+	w.trackEntity(e, chunkPos)
 	w.entityMu.Unlock()
 
+	// Remove the Entity from any previous World it might be in.
+	if prev != w {
+		prev.RemoveEntity(e)
+	}
+	add(e, w)
+
 	c := w.chunk(chunkPos)
 	c.Entities = append(c.Entities, e)
 	viewers := slices.Clone(c.viewers)
@@ -692,6 +846,7 @@ func (w *World) AddEntity(e Entity) {
 	}
 
 	w.Handler().HandleEntitySpawn(e)
+	return true
 }
 
 // add maps an Entity to a World in the entityWorlds map.
@@ -712,7 +867,7 @@ func (w *World) RemoveEntity(e Entity) {
 		return
 	}
 	w.entityMu.Lock()
-	chunkPos, found := w.entities[e]
+	chunkPos, found := w.untrackEntity(e)
 	w.entityMu.Unlock()
 	if !found {
 		// The entity currently isn't in this world.
@@ -734,10 +889,6 @@ func (w *World) RemoveEntity(e Entity) {
 	viewers := slices.Clone(c.viewers)
 	c.Unlock()
 
-	w.entityMu.Lock()
-	delete(w.entities, e)
-	w.entityMu.Unlock()
-
 	for _, v := range viewers {
 		v.HideEntity(e)
 	}
@@ -801,6 +952,17 @@ func OfEntity(e Entity) (*World, bool) {
 	return w, ok
 }
 
+// Seed returns the seed of the World, which generators may use to generate the same terrain every time the World
+// is loaded.
+func (w *World) Seed() int64 {
+	if w == nil {
+		return 0
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.Seed
+}
+
 // Spawn returns the spawn of the world. Every new player will by default spawn on this position in the world
 // when joining.
 func (w *World) Spawn() cube.Pos {
@@ -832,7 +994,30 @@ func (w *World) SetSpawn(pos cube.Pos) {
 	}
 }
 
-// PlayerSpawn returns the spawn position of a player with a UUID in this World.
+// SpawnRadius returns the radius in blocks around the spawn of the World in which players without a spawn of
+// their own are spawned.
+func (w *World) SpawnRadius() int {
+	if w == nil {
+		return 0
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return int(w.set.SpawnRadius)
+}
+
+// SetSpawnRadius sets the radius in blocks around the spawn of the World in which players without a spawn of their
+// own are spawned. If set to 0, these players always spawn exactly at the spawn of the World.
+func (w *World) SetSpawnRadius(v int) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.SpawnRadius = int32(max(v, 0))
+}
+
+// PlayerSpawn returns the spawn position of a player with a UUID in this World. If the player does not have a
+// spawn of its own, a spawn position is selected within the spawn radius of the World.
 func (w *World) PlayerSpawn(uuid uuid.UUID) cube.Pos {
 	if w == nil {
 		return cube.Pos{}
@@ -840,14 +1025,28 @@ func (w *World) PlayerSpawn(uuid uuid.UUID) cube.Pos {
 	pos, exist, err := w.conf.Provider.LoadPlayerSpawnPosition(uuid)
 	if err != nil {
 		w.conf.Log.Errorf("failed to get player spawn: %v", err)
-		return w.Spawn()
+		return w.selectSpawn(uuid)
 	}
 	if !exist {
-		return w.Spawn()
+		return w.selectSpawn(uuid)
 	}
 	return pos
 }
 
+// selectSpawn selects a spawn position for a player with a UUID that does not have a spawn of its own. A random
+// column within the spawn radius of the World is picked, after which the player is placed on top of the highest
+// block in that column. Handler.HandleSpawnSelection is called with the position selected.
+func (w *World) selectSpawn(uuid uuid.UUID) cube.Pos {
+	pos := w.Spawn()
+	if r := w.SpawnRadius(); r > 0 {
+		pos[0] += rand.Intn(r*2+1) - r
+		pos[2] += rand.Intn(r*2+1) - r
+		pos[1] = w.highestObstructingBlock(pos[0], pos[2]) + 1
+	}
+	w.Handler().HandleSpawnSelection(uuid, &pos)
+	return pos
+}
+
 // SetPlayerSpawn sets the spawn position of a player with a UUID in this World. If the player has a spawn in the world,
 // the player will be teleported to this location on respawn.
 func (w *World) SetPlayerSpawn(uuid uuid.UUID, pos cube.Pos) {
@@ -859,6 +1058,34 @@ func (w *World) SetPlayerSpawn(uuid uuid.UUID, pos cube.Pos) {
 	}
 }
 
+// HasPlayerSpawn checks if a player with a UUID has a spawn position of its own in this World, such as one set by
+// sleeping in a bed.
+func (w *World) HasPlayerSpawn(uuid uuid.UUID) bool {
+	if w == nil {
+		return false
+	}
+	_, exist, err := w.conf.Provider.LoadPlayerSpawnPosition(uuid)
+	return exist && err == nil
+}
+
+// ClearPlayerSpawn removes the spawn position of a player with a UUID in this World, so that a spawn position
+// around the spawn of the World is selected for the player again. ClearPlayerSpawn does nothing if the Provider
+// of the World is unable to remove spawn positions.
+func (w *World) ClearPlayerSpawn(id uuid.UUID) {
+	if w == nil {
+		return
+	}
+	c, ok := w.conf.Provider.(interface {
+		ClearPlayerSpawnPosition(id uuid.UUID) error
+	})
+	if !ok {
+		return
+	}
+	if err := c.ClearPlayerSpawnPosition(id); err != nil {
+		w.conf.Log.Errorf("failed to clear player spawn: %v", err)
+	}
+}
+
 // DefaultGameMode returns the default game mode of the world. When players join, they are given this game
 // mode.
 // The default game mode may be changed using SetDefaultGameMode().
@@ -882,6 +1109,26 @@ func (w *World) SetTickRange(v int) {
 	w.set.TickRange = int32(v)
 }
 
+// RandomTickSpeed returns the amount of blocks that are randomly ticked in every sub chunk near a Viewer each
+// tick. Random ticks power, among others, crop growth, grass spread, ice melting and leaf decay. If 0 is
+// returned, random ticking is disabled.
+func (w *World) RandomTickSpeed() int {
+	if w == nil {
+		return 0
+	}
+	return int(w.randomTickSpeed.Load())
+}
+
+// SetRandomTickSpeed sets the amount of blocks that are randomly ticked in every sub chunk near a Viewer each
+// tick. The vanilla random tick speed is 3. Setting it to 0 or lower disables random ticking altogether, while
+// setting it higher results in faster ticking.
+func (w *World) SetRandomTickSpeed(v int) {
+	if w == nil {
+		return
+	}
+	w.randomTickSpeed.Store(int32(max(v, 0)))
+}
+
 // tickRange returns the tick range around each Viewer.
 func (w *World) tickRange() int {
 	w.set.Lock()
@@ -921,10 +1168,54 @@ func (w *World) SetDifficulty(d Difficulty) {
 	w.set.Difficulty = d
 }
 
+// FireTick checks if fire spreads and burns out naturally in the World, which is the case unless disabled by a
+// call to World.SetFireTick.
+func (w *World) FireTick() bool {
+	if w == nil {
+		return false
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.FireTick
+}
+
+// SetFireTick changes if fire spreads and burns out naturally in the World. If set to false, fire neither
+// spreads to or burns away nearby blocks, nor is it ignited by lava, but existing fire also does not burn out.
+func (w *World) SetFireTick(v bool) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.FireTick = v
+}
+
+// RespawnBlocksExplode checks if respawn blocks, such as beds, explode when used in a dimension they cannot set
+// the spawn point in, which is the case unless disabled by a call to World.SetRespawnBlocksExplode.
+func (w *World) RespawnBlocksExplode() bool {
+	if w == nil {
+		return false
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.RespawnBlocksExplode
+}
+
+// SetRespawnBlocksExplode changes if respawn blocks, such as beds, explode when used in a dimension they cannot
+// set the spawn point in. If set to false, using them in such a dimension has no effect.
+func (w *World) SetRespawnBlocksExplode(v bool) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.RespawnBlocksExplode = v
+}
+
 // ScheduleBlockUpdate schedules a block update at the position passed after a specific delay. If the block at
 // that position does not handle block updates, nothing will happen.
 func (w *World) ScheduleBlockUpdate(pos cube.Pos, delay time.Duration) {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		return
 	}
 	w.updateMu.Lock()
@@ -939,9 +1230,17 @@ func (w *World) ScheduleBlockUpdate(pos cube.Pos, delay time.Duration) {
 	w.scheduledUpdates[pos] = t + delay.Nanoseconds()/int64(time.Second/20)
 }
 
+// UpdateNeighbours updates the block at the position passed and all blocks directly around it in the next tick,
+// as happens when a block is set using SetBlock. Blocks implementing NeighbourUpdateTicker have their
+// NeighbourUpdateTick method called, so that, for example, unsupported blocks break and gravity blocks fall.
+// UpdateNeighbours may be used to apply physics to blocks set using SetBlockWithoutPhysics.
+func (w *World) UpdateNeighbours(pos cube.Pos) {
+	w.doBlockUpdatesAround(pos)
+}
+
 // doBlockUpdatesAround schedules block updates directly around and on the position passed.
 func (w *World) doBlockUpdatesAround(pos cube.Pos) {
-	if w == nil || pos.OutOfBounds(w.Range()) {
+	if w.OutOfBounds(pos) {
 		return
 	}
 
@@ -978,6 +1277,113 @@ func (w *World) Handle(h Handler) {
 	w.handler.Store(h)
 }
 
+// Immutable checks if blocks in the World may not be modified. If true, calls to SetBlock, SetLiquid and
+// BuildStructure do nothing, so that block changes by players, liquids, fire and explosions are rejected.
+func (w *World) Immutable() bool {
+	if w == nil {
+		return false
+	}
+	return w.immutable.Load()
+}
+
+// SetImmutable changes if blocks in the World may be modified. If set to true, all block changes are rejected
+// until SetImmutable is called with false. Immutable worlds may be used for lobbies and showcase worlds.
+func (w *World) SetImmutable(immutable bool) {
+	if w == nil {
+		return
+	}
+	w.immutable.Store(immutable)
+}
+
+// Combat returns the CombatConfig that influences combat between entities in the World.
+func (w *World) Combat() CombatConfig {
+	if w == nil {
+		return CombatConfig{}
+	}
+	return w.combat.Load()
+}
+
+// SetCombat changes the CombatConfig that influences combat between entities in the World. It takes effect
+// immediately for all entities in the World.
+func (w *World) SetCombat(c CombatConfig) {
+	if w == nil {
+		return
+	}
+	w.combat.Store(c)
+}
+
+// Despawn returns the DespawnConfig that influences how long entities exist in the World before despawning.
+func (w *World) Despawn() DespawnConfig {
+	if w == nil {
+		return DespawnConfig{}
+	}
+	return w.despawn.Load()
+}
+
+// SetDespawn changes the DespawnConfig that influences how long entities exist in the World before
+// despawning. It takes effect immediately for all entities in the World.
+func (w *World) SetDespawn(c DespawnConfig) {
+	if w == nil {
+		return
+	}
+	w.despawn.Store(c)
+}
+
+// Griefing returns the GriefingConfig that decides if explosions, mobs and fire may destroy or change blocks in
+// the World.
+func (w *World) Griefing() GriefingConfig {
+	if w == nil {
+		return GriefingConfig{}
+	}
+	return w.griefing.Load()
+}
+
+// SetGriefing changes the GriefingConfig that decides if explosions, mobs and fire may destroy or change blocks
+// in the World.
+func (w *World) SetGriefing(c GriefingConfig) {
+	if w == nil {
+		return
+	}
+	w.griefing.Store(c)
+}
+
+// SetBlockByEntity sets the block at the position passed to b on behalf of the Entity e, which is not a player,
+// such as a falling block landing or a mob trampling farmland. Handler.HandleEntityBlockChange is called before
+// the block is changed. Mobs changing blocks on their own accord should only call SetBlockByEntity if
+// GriefingConfig.DisableMobs is not set. SetBlockByEntity returns true if the block was changed.
+func (w *World) SetBlockByEntity(e Entity, pos cube.Pos, b Block) bool {
+	if w.OutOfBounds(pos) || w.Immutable() {
+		return false
+	}
+	ctx := event.C()
+	if w.Handler().HandleEntityBlockChange(ctx, e, pos, w.Block(pos), b); ctx.Cancelled() {
+		return false
+	}
+	w.SetBlock(pos, b, nil)
+	return true
+}
+
+// Generator returns the Generator used to generate chunks in the World that were not previously generated or saved.
+func (w *World) Generator() Generator {
+	if w == nil {
+		return NopGenerator{}
+	}
+	return w.generator.Load()
+}
+
+// SetGenerator changes the Generator used to generate chunks in the World. Chunks that were already generated or
+// loaded are not affected: Only chunks generated after the call to SetGenerator are generated using the new
+// Generator. If nil is passed, NopGenerator is used.
+func (w *World) SetGenerator(g Generator) {
+	if w == nil {
+		return
+	}
+	if g == nil {
+		g = NopGenerator{}
+	}
+	w.generator.Store(g)
+}
+
 // Viewers returns a list of all viewers viewing the position passed. A viewer will be assumed to be watching
 // if the position is within one of the chunks that the viewer is watching.
 func (w *World) Viewers(pos mgl64.Vec3) (viewers []Viewer) {
@@ -1151,6 +1557,14 @@ func (w *World) chunkFromCache(pos ChunkPos) (*Column, bool) {
 	return c, ok
 }
 
+// chunkCached checks if the chunk at the position passed is currently in the chunk cache of the World.
+func (w *World) chunkCached(pos ChunkPos) bool {
+	w.chunkMu.Lock()
+	defer w.chunkMu.Unlock()
+	_, ok := w.chunks[pos]
+	return ok
+}
+
 // showEntity shows an entity to a viewer of the world. It makes sure everything of the entity, including the
 // items held, is shown.
 func showEntity(e Entity, viewer Viewer) {
@@ -1219,6 +1633,7 @@ func (w *World) loadChunk(pos ChunkPos) (*Column, error) {
 	col, err := w.provider().LoadColumn(pos, w.conf.Dim)
 	switch {
 	case err == nil:
+		w.fitColumn(col)
 		w.chunks[pos] = col
 		// Iterate through the entities twice and make sure they're added to all relevant maps. Note that this iteration
 		// happens twice to avoid having to lock both worldsMu and entityMu. This is intentional, to avoid deadlocks.
@@ -1230,7 +1645,7 @@ func (w *World) loadChunk(pos ChunkPos) (*Column, error) {
 
 		w.entityMu.Lock()
 		for _, e := range col.Entities {
-			w.entities[e] = pos
+			w.trackEntity(e, pos)
 		}
 		w.entityMu.Unlock()
 
@@ -1245,7 +1660,10 @@ func (w *World) loadChunk(pos ChunkPos) (*Column, error) {
 		col.Lock()
 		w.chunkMu.Unlock()
 
-		w.conf.Generator.GenerateChunk(pos, col.Chunk)
+		if minX, minZ := int(pos[0])<<4, int(pos[1])<<4; w.chunkInBounds(minX, minZ) {
+			w.Generator().GenerateChunk(pos, col.Chunk)
+			w.clearOutOfBounds(minX, minZ, col.Chunk)
+		}
 		return col, nil
 	default:
 		col = newColumn(chunk.New(airRID, w.Range()))
@@ -1254,6 +1672,54 @@ func (w *World) loadChunk(pos ChunkPos) (*Column, error) {
 	}
 }
 
+// fitColumn resizes the chunk of a Column loaded from the provider to the range of the World. The blocks and
+// block entities outside that range are kept in the Column, so that they are written back unchanged when the
+// Column is saved.
+func (w *World) fitColumn(col *Column) {
+	if col.Chunk.Range() == w.ra {
+		return
+	}
+	col.full, col.Chunk = col.Chunk, col.Chunk.Resize(w.ra)
+	for pos, b := range col.BlockEntities {
+		if pos.OutOfBounds(w.ra) {
+			if col.outside == nil {
+				col.outside = map[cube.Pos]Block{}
+			}
+			col.outside[pos] = b
+			delete(col.BlockEntities, pos)
+		}
+	}
+}
+
+// chunkInBounds checks if any of the blocks in the chunk with the minimum X and Z block coordinates passed are
+// within the HorizontalBound of the World.
+func (w *World) chunkInBounds(minX, minZ int) bool {
+	b := w.conf.HorizontalBound
+	return minX+15 >= -b && minX <= b && minZ+15 >= -b && minZ <= b
+}
+
+// clearOutOfBounds removes all blocks generated in the chunk with the minimum X and Z block coordinates passed
+// that are beyond the HorizontalBound of the World.
+func (w *World) clearOutOfBounds(minX, minZ int, c *chunk.Chunk) {
+	b := w.conf.HorizontalBound
+	if minX >= -b && minX+15 <= b && minZ >= -b && minZ+15 <= b {
+		// Fast way out: The chunk is fully within the bounds of the World.
+		return
+	}
+	r := w.Range()
+	for x := 0; x < 16; x++ {
+		for z := 0; z < 16; z++ {
+			if bx, bz := minX+x, minZ+z; bx >= -b && bx <= b && bz >= -b && bz <= b {
+				continue
+			}
+			for y := r[0]; y <= r[1]; y++ {
+				c.SetBlock(uint8(x), int16(y), uint8(z), 0, airRID)
+				c.SetBlock(uint8(x), int16(y), uint8(z), 1, airRID)
+			}
+		}
+	}
+}
+
 // calculateLight calculates the light in the chunk passed and spreads the light of any of the surrounding
 // neighbours if they have all chunks loaded around it as a result of the one passed.
 func (w *World) calculateLight(centre ChunkPos) {
@@ -1305,7 +1771,18 @@ func (w *World) saveChunk(pos ChunkPos, c *Column) {
 	c.Lock()
 	if !w.conf.ReadOnly && (len(c.BlockEntities) > 0 || len(c.Entities) > 0 || c.modified) {
 		c.Compact()
-		if err := w.provider().StoreColumn(pos, w.conf.Dim, c); err != nil {
+		// Providers store chunks with the range of the Dimension, so the chunk is resized if the World has a
+		// narrower range. Chunks loaded from the provider are stored with the blocks and block entities outside
+		// the range of the World that they were loaded with.
+		col := &Column{Chunk: c.Chunk.Resize(w.conf.Dim.Range()), Entities: c.Entities, BlockEntities: c.BlockEntities}
+		if c.full != nil {
+			col.Chunk = c.full
+		}
+		if len(c.outside) > 0 {
+			col.BlockEntities = maps.Clone(c.outside)
+			maps.Copy(col.BlockEntities, c.BlockEntities)
+		}
+		if err := w.provider().StoreColumn(pos, w.conf.Dim, col); err != nil {
 			w.conf.Log.Errorf("save chunk: %v", err)
 		}
 	}
@@ -1364,6 +1841,12 @@ type Column struct {
 	Entities      []Entity
 	BlockEntities map[cube.Pos]Block
 
+	// full is the chunk as loaded from the provider if the World has a narrower range than its Dimension. The
+	// sub chunks of full inside the range of the World are shared with Chunk. outside holds the block entities
+	// of the chunk outside the range of the World.
+	full    *chunk.Chunk
+	outside map[cube.Pos]Block
+
 	viewers []Viewer
 	loaders []*Loader
 }